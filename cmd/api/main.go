@@ -2,22 +2,32 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"finance-assistant/internal/infrastructure/kafka"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"finance-assistant/config"
 	_ "finance-assistant/docs"
+	domaincurrency "finance-assistant/internal/domain/currency"
+	"finance-assistant/internal/domain/extractor"
+	"finance-assistant/internal/domain/messaging"
 	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/infrastructure/currency"
 	"finance-assistant/internal/infrastructure/database"
+	"finance-assistant/internal/infrastructure/mail"
+	"finance-assistant/internal/infrastructure/memory"
 	repo "finance-assistant/internal/infrastructure/repository"
+	"finance-assistant/internal/infrastructure/storage"
 	"finance-assistant/internal/interface/api/handler"
 	"finance-assistant/internal/interface/http"
+	"finance-assistant/internal/interface/worker"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
@@ -41,6 +51,11 @@ import (
 // @securityDefinitions.apikey  BearerAuth
 // @in                          header
 // @name                        Authorization
+
+// @securityDefinitions.apikey  ApiKeyAuth
+// @in                          header
+// @name                        Authorization
+// @description                 Chave de API pessoal no formato "ApiKey <chave>"
 func main() {
 	// Carregar a configuração
 	cfg := config.LoadConfig()
@@ -71,39 +86,137 @@ func main() {
 
 	log.Println("Migrações aplicadas com sucesso")
 
-	// Inicializar produtor Kafka
-	kafkaProducer, err := kafka.NewProducer(cfg)
+	// Inicializar armazenamento de arquivos
+	blobStore, err := storage.NewBlobStore(cfg)
+	if err != nil {
+		log.Fatalf("Falha ao inicializar armazenamento de arquivos: %v", err)
+	}
+
+	// Inicializar cotações de câmbio
+	rateProvider, err := currency.NewRateProvider(cfg)
+	if err != nil {
+		log.Fatalf("Falha ao carregar cotações de câmbio: %v", err)
+	}
+	converter := domaincurrency.NewConverter(rateProvider)
+
+	// Inicializar envio de emails
+	mailer, err := mail.NewMailer(cfg)
 	if err != nil {
-		log.Printf("Aviso: Falha ao conectar ao Kafka: %v", err)
-		log.Println("Continuando sem suporte ao Kafka - os documentos serão salvos mas não processados")
-		kafkaProducer = nil
-	} else {
+		log.Fatalf("Falha ao inicializar envio de emails: %v", err)
+	}
+
+	// Inicializar repositórios
+	userRepo := repo.NewPostgresUserRepository(db)
+	documentRepo := repo.NewPostgresDocumentRepository(db)
+	transactionRepo := repo.NewPostgresTransactionRepository(db)
+	importProfileRepo := repo.NewPostgresImportProfileRepository(db)
+	outboxRepo := repo.NewPostgresOutboxRepository(db)
+	authSessionRepo := repo.NewPostgresAuthSessionRepository(db)
+	apiKeyRepo := repo.NewPostgresAPIKeyRepository(db)
+	householdRepo := repo.NewPostgresHouseholdRepository(db)
+	householdInvitationRepo := repo.NewPostgresHouseholdInvitationRepository(db)
+	categoryRepo := repo.NewPostgresCategoryRepository(db)
+	categoryRuleRepo := repo.NewPostgresCategoryRuleRepository(db)
+	merchantRepo := repo.NewPostgresMerchantRepository(db)
+	installmentRepo := repo.NewPostgresInstallmentPlanRepository(db)
+	recurringRepo := repo.NewPostgresRecurringSeriesRepository(db)
+	accountRepo := repo.NewPostgresAccountRepository(db)
+	statementRepo := repo.NewPostgresAccountStatementRepository(db)
+	transferRepo := repo.NewPostgresTransferRepository(db)
+	duplicateRepo := repo.NewPostgresTransactionDuplicateRepository(db)
+	transactor := database.NewPostgresTransactor(db)
+
+	// Inicializar o broker de mensagens
+	var publisher messaging.DocumentPublisher
+	var kafkaProducer *kafka.Producer
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	var background sync.WaitGroup
+
+	switch cfg.Broker {
+	case config.BrokerMemory:
+		// Sem broker externo: os documentos são processados neste mesmo processo
+		broker := memory.NewBroker(0)
+		defer broker.Close()
+		publisher = broker
+
+		registry := extractor.NewRegistry(
+			extractor.NewOFXExtractor(),
+			extractor.NewCSVExtractor(importProfileRepo, accountRepo),
+		)
+		registry.SetFallback(extractor.NewPassthroughExtractor())
+		processingService := service.NewDocumentProcessingService(documentRepo, transactionRepo, categoryRepo, categoryRuleRepo, merchantRepo, installmentRepo, recurringRepo, householdRepo, accountRepo, statementRepo, transferRepo, duplicateRepo, transactor, blobStore, registry, cfg.TransferWindowDays)
+		documentWorker := worker.NewDocumentWorker(processingService)
+
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			if err := broker.Run(backgroundCtx, cfg.WorkerConcurrency, documentWorker.Handle, documentWorker.Fail); err != nil {
+				log.Printf("Erro no processamento de documentos: %v", err)
+			}
+		}()
+	case config.BrokerKafka:
+		// NewProducer só falha com configuração inválida; com o broker indisponível
+		// o librdkafka tenta se reconectar e os eventos aguardam no outbox
+		kafkaProducer, err = kafka.NewProducer(cfg)
+		if err != nil {
+			log.Fatalf("Falha ao criar produtor Kafka: %v", err)
+		}
+		defer kafkaProducer.Close()
+		publisher = kafkaProducer
+
 		// Verificar conexão com Kafka
 		if err := kafkaProducer.CheckKafkaConnection(); err != nil {
 			log.Printf("Aviso: Verificação de conexão Kafka falhou: %v", err)
-			log.Println("Continuando sem suporte ao Kafka - os documentos serão salvos mas não processados")
-			kafkaProducer.Close()
-			kafkaProducer = nil
-		} else {
-			defer kafkaProducer.Close()
 		}
+	default:
+		log.Fatalf("Broker de mensagens desconhecido: %s", cfg.Broker)
 	}
 
-	// Inicializar repositórios
-	userRepo := repo.NewPostgresUserRepository(db)
-	documentRepo := repo.NewPostgresDocumentRepository(db)
+	// Iniciar o relay do outbox, que publica os eventos no broker
+	relay := service.NewOutboxRelay(outboxRepo, transactor, publisher, cfg.OutboxBatchSize, cfg.OutboxPollInterval)
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		relay.Run(backgroundCtx)
+	}()
 
 	// Inicializar serviços
+	authService := service.NewAuthService(userRepo, authSessionRepo, jwtSecret(cfg), cfg.AccessTokenTTL, cfg.RefreshTokenTTL)
 	userService := service.NewUserService(userRepo)
-	documentService := service.NewDocumentService(documentRepo, userRepo, kafkaProducer)
+	documentService := service.NewDocumentService(documentRepo, userRepo, importProfileRepo, householdRepo, categoryRepo, accountRepo, outboxRepo, transactor, blobStore, cfg.AllowedContentTypes)
+	transactionService := service.NewTransactionService(transactionRepo, userRepo, documentRepo, householdRepo, categoryRepo)
+	importProfileService := service.NewImportProfileService(importProfileRepo, userRepo)
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo, userRepo)
+	householdService := service.NewHouseholdService(householdRepo, householdInvitationRepo, userRepo, transactor, mailer)
+	categoryService := service.NewCategoryService(categoryRepo, userRepo)
+	categoryRuleService := service.NewCategoryRuleService(categoryRuleRepo, userRepo, categoryRepo, transactionRepo)
+	merchantService := service.NewMerchantService(merchantRepo, userRepo, transactionRepo, transactor, converter)
+	installmentService := service.NewInstallmentService(installmentRepo, userRepo, converter)
+	subscriptionService := service.NewSubscriptionService(recurringRepo, userRepo, transactionRepo, transactor, converter)
+	accountService := service.NewAccountService(accountRepo, userRepo, statementRepo, transactionRepo)
+	transferService := service.NewTransferService(transferRepo, userRepo, transactionRepo, transactor, cfg.TransferWindowDays)
+	transactionDuplicateService := service.NewTransactionDuplicateService(duplicateRepo, userRepo, transactor)
 
 	// Inicializar handlers
+	authHandler := handler.NewAuthHandler(authService)
 	userHandler := handler.NewUserHandler(userService)
 	documentHandler := handler.NewDocumentHandler(documentService)
+	transactionHandler := handler.NewTransactionHandler(transactionService)
+	importProfileHandler := handler.NewImportProfileHandler(importProfileService)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
+	householdHandler := handler.NewHouseholdHandler(householdService)
+	categoryHandler := handler.NewCategoryHandler(categoryService)
+	categoryRuleHandler := handler.NewCategoryRuleHandler(categoryRuleService)
+	merchantHandler := handler.NewMerchantHandler(merchantService)
+	installmentHandler := handler.NewInstallmentHandler(installmentService)
+	subscriptionHandler := handler.NewSubscriptionHandler(subscriptionService)
+	accountHandler := handler.NewAccountHandler(accountService)
+	transferHandler := handler.NewTransferHandler(transferService)
+	transactionDuplicateHandler := handler.NewTransactionDuplicateHandler(transactionDuplicateService)
 	systemHandler := handler.NewSystemHandler(kafkaProducer)
 
 	// Configurar o router
-	router := inhttp.SetupRouter(userHandler, documentHandler, systemHandler)
+	router := inhttp.SetupRouter(authService, apiKeyService, authHandler, userHandler, documentHandler, transactionHandler, importProfileHandler, apiKeyHandler, householdHandler, categoryHandler, categoryRuleHandler, merchantHandler, installmentHandler, subscriptionHandler, accountHandler, transferHandler, transactionDuplicateHandler, systemHandler)
 
 	// Iniciar servidor HTTP
 	srv := &http.Server{
@@ -131,5 +244,25 @@ func main() {
 		log.Fatalf("Erro ao desligar servidor: %v", err)
 	}
 
+	// Aguardar o relay registrar o envio em andamento e os documentos em
+	// processamento antes de fechar o broker
+	stopBackground()
+	background.Wait()
+
 	log.Println("Servidor encerrado com sucesso")
 }
+
+// jwtSecret retorna a chave de assinatura dos access tokens. Sem JWT_SECRET é
+// gerada uma chave aleatória, e as sessões abertas deixam de valer ao reiniciar.
+func jwtSecret(cfg *config.Config) []byte {
+	if cfg.JWTSecret != "" {
+		return []byte(cfg.JWTSecret)
+	}
+
+	log.Println("Aviso: JWT_SECRET não definido; usando uma chave aleatória válida apenas até o próximo reinício")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Fatalf("Falha ao gerar chave de assinatura: %v", err)
+	}
+	return secret
+}
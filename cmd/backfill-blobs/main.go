@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"log"
+
+	"finance-assistant/config"
+	"finance-assistant/internal/domain/entity"
+	domainstorage "finance-assistant/internal/domain/storage"
+	"finance-assistant/internal/infrastructure/database"
+	repo "finance-assistant/internal/infrastructure/repository"
+	"finance-assistant/internal/infrastructure/storage"
+)
+
+// Comando único que move o conteúdo base64 da coluna documents.file_content
+// para o BlobStore configurado. Pode ser executado novamente com segurança:
+// apenas documentos ainda sem storage_key são considerados.
+func main() {
+	batchSize := flag.Int("batch-size", 100, "Quantidade de documentos lidos por lote")
+	dryRun := flag.Bool("dry-run", false, "Apenas lista os documentos que seriam migrados")
+	flag.Parse()
+
+	// Carregar a configuração
+	cfg := config.LoadConfig()
+
+	// Conectar ao banco de dados
+	db, err := database.NewPostgresConnection(cfg)
+	if err != nil {
+		log.Fatalf("Falha ao conectar ao banco de dados: %v", err)
+	}
+	defer db.Close()
+
+	// Inicializar armazenamento de arquivos
+	blobStore, err := storage.NewBlobStore(cfg)
+	if err != nil {
+		log.Fatalf("Falha ao inicializar armazenamento de arquivos: %v", err)
+	}
+
+	documentRepo := repo.NewPostgresDocumentRepository(db)
+	ctx := context.Background()
+
+	migrated, failed := 0, 0
+	skipped := make(map[int64]bool)
+	for {
+		documents, err := documentRepo.FindLegacyContent(ctx, *batchSize+len(skipped))
+		if err != nil {
+			log.Fatalf("Erro ao buscar documentos: %v", err)
+		}
+
+		pending := 0
+		for _, document := range documents {
+			// Documentos com falha continuam pendentes e não são reprocessados nesta execução
+			if skipped[document.ID] {
+				continue
+			}
+			pending++
+
+			if *dryRun {
+				log.Printf("Documento %s seria migrado", document.ExternalID)
+				skipped[document.ID] = true
+				continue
+			}
+
+			if err := migrate(ctx, blobStore, documentRepo, document); err != nil {
+				log.Printf("Erro ao migrar documento %s: %v", document.ExternalID, err)
+				skipped[document.ID] = true
+				failed++
+				continue
+			}
+			migrated++
+		}
+
+		if pending == 0 {
+			break
+		}
+	}
+
+	log.Printf("Backfill concluído: %d documentos migrados, %d com falha", migrated, failed)
+	if failed > 0 {
+		log.Fatal("Alguns documentos não foram migrados; execute o comando novamente após corrigir os erros")
+	}
+}
+
+// migrate grava o conteúdo no BlobStore e só então remove o base64 do banco
+func migrate(ctx context.Context, blobStore domainstorage.BlobStore, documentRepo *repo.PostgresDocumentRepository, document repo.LegacyDocumentContent) error {
+	content, err := base64.StdEncoding.DecodeString(document.FileContent)
+	if err != nil {
+		return fmt.Errorf("conteúdo base64 inválido: %w", err)
+	}
+
+	checksum := sha256.Sum256(content)
+	key := entity.DocumentStorageKey(document.UserID, document.ExternalID)
+	size := int64(len(content))
+
+	if err := blobStore.Put(ctx, key, bytes.NewReader(content), size, document.ContentType); err != nil {
+		return fmt.Errorf("erro ao armazenar arquivo: %w", err)
+	}
+
+	return documentRepo.CompleteBlobMigration(ctx, document.ID, key, size, hex.EncodeToString(checksum[:]))
+}
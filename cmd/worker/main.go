@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"finance-assistant/config"
+	"finance-assistant/internal/domain/extractor"
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/infrastructure/database"
+	"finance-assistant/internal/infrastructure/kafka"
+	repo "finance-assistant/internal/infrastructure/repository"
+	"finance-assistant/internal/infrastructure/storage"
+	"finance-assistant/internal/interface/worker"
+)
+
+func main() {
+	// Carregar a configuração
+	cfg := config.LoadConfig()
+
+	// Conectar ao banco de dados
+	db, err := database.NewPostgresConnection(cfg)
+	if err != nil {
+		log.Fatalf("Falha ao conectar ao banco de dados: %v", err)
+	}
+	defer db.Close()
+
+	// Com o broker em memória os documentos são processados pelo próprio processo da API
+	if cfg.Broker != config.BrokerKafka {
+		log.Fatalf("O worker requer BROKER=%s (configurado: %s)", config.BrokerKafka, cfg.Broker)
+	}
+
+	// Inicializar consumidor Kafka
+	consumer, err := kafka.NewConsumer(cfg)
+	if err != nil {
+		log.Fatalf("Falha ao criar consumidor Kafka: %v", err)
+	}
+	defer consumer.Close()
+
+	// Inicializar armazenamento de arquivos
+	blobStore, err := storage.NewBlobStore(cfg)
+	if err != nil {
+		log.Fatalf("Falha ao inicializar armazenamento de arquivos: %v", err)
+	}
+
+	// Inicializar repositórios
+	documentRepo := repo.NewPostgresDocumentRepository(db)
+	transactionRepo := repo.NewPostgresTransactionRepository(db)
+	importProfileRepo := repo.NewPostgresImportProfileRepository(db)
+	categoryRepo := repo.NewPostgresCategoryRepository(db)
+	categoryRuleRepo := repo.NewPostgresCategoryRuleRepository(db)
+	merchantRepo := repo.NewPostgresMerchantRepository(db)
+	installmentRepo := repo.NewPostgresInstallmentPlanRepository(db)
+	recurringRepo := repo.NewPostgresRecurringSeriesRepository(db)
+	householdRepo := repo.NewPostgresHouseholdRepository(db)
+	accountRepo := repo.NewPostgresAccountRepository(db)
+	statementRepo := repo.NewPostgresAccountStatementRepository(db)
+	transferRepo := repo.NewPostgresTransferRepository(db)
+	duplicateRepo := repo.NewPostgresTransactionDuplicateRepository(db)
+	transactor := database.NewPostgresTransactor(db)
+
+	// Registrar extratores
+	registry := extractor.NewRegistry(
+		extractor.NewOFXExtractor(),
+		extractor.NewCSVExtractor(importProfileRepo, accountRepo),
+	)
+	registry.SetFallback(extractor.NewPassthroughExtractor())
+
+	// Inicializar serviços
+	processingService := service.NewDocumentProcessingService(documentRepo, transactionRepo, categoryRepo, categoryRuleRepo, merchantRepo, installmentRepo, recurringRepo, householdRepo, accountRepo, statementRepo, transferRepo, duplicateRepo, transactor, blobStore, registry, cfg.TransferWindowDays)
+	documentWorker := worker.NewDocumentWorker(processingService)
+
+	// Iniciar o consumo em uma goroutine
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+
+	done := make(chan error, 1)
+	go func() {
+		log.Println("Worker de documentos iniciado")
+		done <- consumer.Run(ctx, cfg.WorkerConcurrency, documentWorker.Handle, documentWorker.Fail)
+	}()
+
+	// Configurar graceful shutdown
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case <-quit:
+	case err := <-done:
+		log.Fatalf("Erro no consumidor: %v", err)
+	}
+	log.Println("Desligando worker...")
+	stop()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			log.Fatalf("Erro ao desligar worker: %v", err)
+		}
+	case <-time.After(30 * time.Second):
+		log.Fatalf("Timeout ao aguardar mensagens em processamento")
+	}
+
+	log.Println("Worker encerrado com sucesso")
+}
@@ -4,10 +4,18 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// Brokers de mensagens suportados
+const (
+	BrokerKafka  = "kafka"
+	BrokerMemory = "memory" // Em processo; a API também processa os documentos
+)
+
 type Config struct {
 	DBHost       string
 	DBPort       int
@@ -15,8 +23,42 @@ type Config struct {
 	DBPassword   string
 	DBName       string
 	ServerPort   int
+	Broker       string
 	KafkaBrokers []string
 	KafkaTopic   string
+	KafkaGroupID string
+
+	WorkerConcurrency int
+
+	OutboxBatchSize    int
+	OutboxPollInterval time.Duration
+
+	TransferWindowDays int
+
+	FXRatesFile    string
+	FXBaseCurrency string
+
+	StorageDriver    string
+	StorageLocalPath string
+	S3Endpoint       string
+	S3Region         string
+	S3Bucket         string
+	S3AccessKey      string
+	S3SecretKey      string
+	S3PathStyle      bool
+
+	AllowedContentTypes []string
+
+	MailDriver   string
+	MailFrom     string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+
+	JWTSecret       string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
 }
 
 func LoadConfig() *Config {
@@ -26,6 +68,14 @@ func LoadConfig() *Config {
 
 	dbPort, _ := strconv.Atoi(getEnv("DB_PORT", "5432"))
 	serverPort, _ := strconv.Atoi(getEnv("SERVER_PORT", "8080"))
+	workerConcurrency, _ := strconv.Atoi(getEnv("WORKER_CONCURRENCY", "4"))
+	s3PathStyle, _ := strconv.ParseBool(getEnv("S3_PATH_STYLE", "true"))
+	outboxBatchSize, _ := strconv.Atoi(getEnv("OUTBOX_BATCH_SIZE", "50"))
+	outboxPollInterval, _ := time.ParseDuration(getEnv("OUTBOX_POLL_INTERVAL", "1s"))
+	transferWindowDays, _ := strconv.Atoi(getEnv("TRANSFER_WINDOW_DAYS", "3"))
+	accessTokenTTL, _ := time.ParseDuration(getEnv("ACCESS_TOKEN_TTL", "15m"))
+	refreshTokenTTL, _ := time.ParseDuration(getEnv("REFRESH_TOKEN_TTL", "720h"))
+	smtpPort, _ := strconv.Atoi(getEnv("SMTP_PORT", "587"))
 
 	return &Config{
 		DBHost:       getEnv("DB_HOST", "localhost"),
@@ -34,8 +84,52 @@ func LoadConfig() *Config {
 		DBPassword:   getEnv("DB_PASSWORD", "postgres"),
 		DBName:       getEnv("DB_NAME", "finance"),
 		ServerPort:   serverPort,
+		Broker:       getEnv("BROKER", BrokerKafka),
 		KafkaBrokers: []string{getEnv("KAFKA_BROKER", "localhost:9092")},
 		KafkaTopic:   getEnv("KAFKA_TOPIC_DOCUMENTS", "documents"),
+		KafkaGroupID: getEnv("KAFKA_GROUP_ID", "finance-assistant-worker"),
+
+		WorkerConcurrency: workerConcurrency,
+
+		OutboxBatchSize:    outboxBatchSize,
+		OutboxPollInterval: outboxPollInterval,
+
+		TransferWindowDays: transferWindowDays,
+
+		FXRatesFile:    getEnv("FX_RATES_FILE", ""),
+		FXBaseCurrency: getEnv("FX_BASE_CURRENCY", "BRL"),
+
+		StorageDriver:    getEnv("STORAGE_DRIVER", "local"),
+		StorageLocalPath: getEnv("STORAGE_LOCAL_PATH", "./data/blobs"),
+		S3Endpoint:       getEnv("S3_ENDPOINT", ""),
+		S3Region:         getEnv("S3_REGION", "us-east-1"),
+		S3Bucket:         getEnv("S3_BUCKET", "finance-assistant"),
+		S3AccessKey:      getEnv("S3_ACCESS_KEY", ""),
+		S3SecretKey:      getEnv("S3_SECRET_KEY", ""),
+		S3PathStyle:      s3PathStyle,
+
+		AllowedContentTypes: getEnvList("ALLOWED_CONTENT_TYPES", []string{
+			"application/pdf",
+			"application/msword",
+			"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+			"application/vnd.ms-excel",
+			"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+			"image/png",
+			"image/jpeg",
+			"application/x-ofx",
+			"text/csv",
+		}),
+
+		MailDriver:   getEnv("MAIL_DRIVER", "log"),
+		MailFrom:     getEnv("MAIL_FROM", ""),
+		SMTPHost:     getEnv("SMTP_HOST", ""),
+		SMTPPort:     smtpPort,
+		SMTPUsername: getEnv("SMTP_USERNAME", ""),
+		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+
+		JWTSecret:       getEnv("JWT_SECRET", ""),
+		AccessTokenTTL:  accessTokenTTL,
+		RefreshTokenTTL: refreshTokenTTL,
 	}
 }
 
@@ -45,3 +139,19 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvList lê uma lista separada por vírgulas
+func getEnvList(key string, defaultValue []string) []string {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -24,53 +24,17 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/documents": {
+        "/accounts/{id}/reconciliation": {
             "get": {
-                "description": "Retorna uma lista paginada de todos os documentos",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "documents"
-                ],
-                "summary": "Listar documentos",
-                "parameters": [
+                "security": [
                     {
-                        "type": "integer",
-                        "description": "Página atual (padrão: 1)",
-                        "name": "page",
-                        "in": "query"
+                        "BearerAuth": []
                     },
                     {
-                        "type": "integer",
-                        "description": "Limite de itens por página (padrão: 10)",
-                        "name": "limit",
-                        "in": "query"
+                        "ApiKeyAuth": []
                     }
                 ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.DocumentListResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    }
-                }
-            }
-        },
-        "/documents/{id}": {
-            "get": {
-                "description": "Retorna um documento pelo seu ID",
+                "description": "Confere o saldo final de cada extrato importado para a conta com o saldo calculado a partir do saldo inicial e das transações, indicando extratos que conferem, com diferença de valor, sobrepostos ou com lacuna em relação ao anterior",
                 "consumes": [
                     "application/json"
                 ],
@@ -78,13 +42,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "documents"
+                    "accounts"
                 ],
-                "summary": "Buscar documento por ID",
+                "summary": "Conciliar conta",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do documento",
+                        "description": "ID da conta",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -94,34 +58,45 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.DocumentResponse"
+                            "$ref": "#/definitions/dto.ReconciliationResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove um documento do sistema",
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Verifica email e senha e retorna um access token (JWT) e um refresh token",
                 "consumes": [
                     "application/json"
                 ],
@@ -129,49 +104,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "documents"
+                    "auth"
                 ],
-                "summary": "Excluir documento",
+                "summary": "Entrar",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID do documento",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Credenciais do usuário",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TokenResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/documents/{id}/status": {
-            "put": {
-                "description": "Atualiza o status de processamento de um documento",
+        "/auth/logout": {
+            "post": {
+                "description": "Revoga a sessão do refresh token; os access tokens emitidos para ela deixam de ser aceitos",
                 "consumes": [
                     "application/json"
                 ],
@@ -179,61 +156,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "documents"
+                    "auth"
                 ],
-                "summary": "Atualizar status do documento",
+                "summary": "Sair",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID do documento",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Novo status",
-                        "name": "status",
+                        "description": "Refresh token",
+                        "name": "token",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.DocumentStatusUpdateRequest"
+                            "$ref": "#/definitions/dto.RefreshTokenRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.DocumentResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/users": {
-            "get": {
-                "description": "Retorna uma lista paginada de usuários",
+        "/auth/password": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Altera a senha do usuário autenticado e revoga as suas demais sessões",
                 "consumes": [
                     "application/json"
                 ],
@@ -241,41 +210,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "auth"
                 ],
-                "summary": "Listar usuários",
+                "summary": "Alterar senha",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Página atual (padrão: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limite de itens por página (padrão: 10)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Senha atual e nova senha",
+                        "name": "password",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChangePasswordRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserListResponse"
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/refresh": {
             "post": {
-                "description": "Cria um novo usuário no sistema",
+                "description": "Troca um refresh token por um novo par de tokens. Cada refresh token só pode ser usado uma vez; a reutilização revoga a sessão",
                 "consumes": [
                     "application/json"
                 ],
@@ -283,54 +259,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "auth"
                 ],
-                "summary": "Criar usuário",
+                "summary": "Renovar tokens",
                 "parameters": [
                     {
-                        "description": "Dados do usuário",
-                        "name": "user",
+                        "description": "Refresh token",
+                        "name": "token",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UserRequest"
+                            "$ref": "#/definitions/dto.RefreshTokenRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserResponse"
+                            "$ref": "#/definitions/dto.TokenResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/users/{id}": {
+        "/documents": {
             "get": {
-                "description": "Retorna um usuário pelo seu ID externo",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma lista paginada dos documentos de todos os usuários. Restrito a suporte e administradores.",
                 "consumes": [
                     "application/json"
                 ],
@@ -338,34 +316,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "documents"
                 ],
-                "summary": "Buscar usuário por ID",
+                "summary": "Listar documentos",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID do usuário",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Página atual (padrão: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite de itens por página (padrão: 10)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserResponse"
+                            "$ref": "#/definitions/dto.DocumentListResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -379,9 +362,19 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Atualiza os dados de um usuário existente",
+            }
+        },
+        "/documents/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna um documento pelo seu ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -389,32 +382,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "documents"
                 ],
-                "summary": "Atualizar usuário",
+                "summary": "Buscar documento por ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do usuário",
+                        "description": "ID do documento",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Dados para atualização",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserRequest"
-                        }
+                        "type": "boolean",
+                        "description": "Se verdadeiro, inclui o conteúdo do arquivo na resposta (default: false)",
+                        "name": "detailed",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Quando detailed=true",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserResponse"
+                            "$ref": "#/definitions/dto.DocumentDetailResponse"
                         }
                     },
                     "400": {
@@ -424,15 +414,22 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -448,7 +445,15 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Remove um usuário do sistema",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove um documento do sistema",
                 "consumes": [
                     "application/json"
                 ],
@@ -456,13 +461,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "documents"
                 ],
-                "summary": "Excluir usuário",
+                "summary": "Excluir documento",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do usuário",
+                        "description": "ID do documento",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -479,6 +484,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -496,47 +515,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}/documents": {
+        "/documents/{id}/download": {
             "get": {
-                "description": "Retorna uma lista paginada de documentos de um usuário específico",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna o arquivo do documento para download em streaming. Suporta requisições parciais (Range) e requisições condicionais pelo ETag (If-None-Match), que corresponde ao hash SHA-256 do conteúdo. Indisponível para o suporte.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
                     "documents"
                 ],
-                "summary": "Listar documentos de um usuário",
+                "summary": "Download do documento",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do usuário",
+                        "description": "ID do documento",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Página atual (padrão: 1)",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Trecho do arquivo (ex: bytes=0-1023)",
+                        "name": "Range",
+                        "in": "header"
                     },
                     {
-                        "type": "integer",
-                        "description": "Limite de itens por página (padrão: 10)",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "ETag de uma cópia já obtida",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.DocumentListResponse"
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Conteúdo parcial",
+                        "schema": {
+                            "type": "file"
                         }
                     },
+                    "304": {
+                        "description": "Conteúdo não modificado"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -544,6 +580,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -551,6 +601,9 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "416": {
+                        "description": "Trecho solicitado inválido"
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -559,9 +612,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Envia um novo documento para um usuário",
+            }
+        },
+        "/documents/{id}/status": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza o status de processamento de um documento. Restrito a administradores.",
                 "consumes": [
                     "application/json"
                 ],
@@ -571,28 +631,28 @@ const docTemplate = `{
                 "tags": [
                     "documents"
                 ],
-                "summary": "Criar documento",
+                "summary": "Atualizar status do documento",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do usuário",
+                        "description": "ID do documento",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Dados do documento",
-                        "name": "document",
+                        "description": "Novo status",
+                        "name": "status",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.DocumentRequest"
+                            "$ref": "#/definitions/dto.DocumentStatusUpdateRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/dto.DocumentResponse"
                         }
@@ -604,6 +664,107 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/documents/{id}/transactions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma lista paginada das transações extraídas de um documento",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Listar transações de um documento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do documento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página atual (padrão: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite de itens por página (padrão: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -620,121 +781,6789 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.DocumentListResponse": {
+        },
+        "/household-invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os convites pendentes enviados para o email do usuário autenticado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Listar meus convites",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.HouseholdInvitationResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/household-invitations/{id}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Aceita um convite enviado para o email do usuário autenticado, que passa a participar da família",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Aceitar convite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do convite",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HouseholdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Convite já respondido ou expirado",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/household-invitations/{id}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Recusa um convite enviado para o email do usuário autenticado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Recusar convite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do convite",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Convite já respondido ou expirado",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/households": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as famílias de que o usuário autenticado participa",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Listar famílias",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.HouseholdResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma família para compartilhar documentos e transações; o usuário autenticado se torna o proprietário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Criar família",
+                "parameters": [
+                    {
+                        "description": "Dados da família",
+                        "name": "household",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.HouseholdRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HouseholdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/households/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna a família e os seus membros",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Buscar família",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da família",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HouseholdDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Exclui a família; os documentos compartilhados voltam a pertencer apenas a quem os enviou. Restrito aos proprietários",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Excluir família",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da família",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/households/{id}/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os convites pendentes da família",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Listar convites da família",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da família",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.HouseholdInvitationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Convida um email para a família com o papel informado e envia o convite por email; o convidado aceita ou recusa o convite a partir da própria conta. Restrito aos proprietários",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Convidar para a família",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da família",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do convite",
+                        "name": "invitation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.HouseholdInvitationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HouseholdInvitationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Convite pendente ou membro já existente",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/households/{id}/invitations/{invitationId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancela um convite pendente da família. Restrito aos proprietários",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Revogar convite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da família",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do convite",
+                        "name": "invitationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Convite já respondido ou expirado",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/households/{id}/members/{userId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Altera o papel de um membro da família. Restrito aos proprietários",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Alterar papel de membro",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da família",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novo papel",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.HouseholdMemberRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HouseholdMemberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A família ficaria sem proprietário",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove um membro da família. Os proprietários removem qualquer membro; os demais podem apenas sair da família",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "Remover membro",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da família",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A família ficaria sem proprietário",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transaction-duplicates/{id}/dismiss": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Indica que as transações são diferentes; as duas são mantidas e o par sai da fila de revisão",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction-duplicates"
+                ],
+                "summary": "Descartar possível duplicata",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da possível duplicata",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionDuplicateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transaction-duplicates/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Confirma que as transações são a mesma: a importada por último é excluída e seu documento passa a ser uma origem da mantida, que é retornada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction-duplicates"
+                ],
+                "summary": "Unir transações duplicadas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da possível duplicata",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/category": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Define a categoria da transação. A correção é usada para sugerir categorias e é mantida ao reaplicar as regras de categorização",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Corrigir categoria de uma transação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Nova categoria",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/category-suggestions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Sugere categorias com um classificador treinado com as categorias que o dono da transação informou nos arquivos importados ou corrigiu. Retorna uma lista vazia enquanto não houver exemplos suficientes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Sugerir categorias para uma transação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número máximo de sugestões (padrão: 3, máximo: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CategorySuggestionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/sources": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Lista os documentos que informaram a transação: o que a criou e os de períodos sobrepostos em que ela se repetiu e foi descartada como duplicada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Listar origens de uma transação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TransactionSourceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Confirma uma transferência sugerida ou volta a ligar uma rejeitada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Confirmar transferência",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transferência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Desfaz a ligação entre as transações, que voltam a contar como gastos e receitas; o par não volta a ser sugerido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Rejeitar transferência",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transferência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma lista paginada de todos os usuários. Restrito a suporte e administradores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Listar usuários",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Página atual (padrão: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite de itens por página (padrão: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cria um novo usuário no sistema",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Criar usuário",
+                "parameters": [
+                    {
+                        "description": "Dados do usuário",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna um usuário pelo seu ID externo",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Buscar usuário por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de um usuário existente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Atualizar usuário",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados para atualização",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove um usuário do sistema",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Excluir usuário",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/accounts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna as contas do usuário em ordem alfabética",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Listar contas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.AccountResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cadastra uma conta corrente, poupança, cartão de crédito, investimento ou carteira do usuário. Do número informado apenas os 4 últimos dígitos são guardados; eles identificam a conta dos extratos OFX importados sem conta informada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Criar conta",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da conta",
+                        "name": "account",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/accounts/{accountId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma conta do usuário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Buscar conta",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da conta",
+                        "name": "accountId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Substitui os dados de uma conta do usuário; sem número informado, os dígitos já cadastrados são mantidos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Atualizar conta",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da conta",
+                        "name": "accountId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da conta",
+                        "name": "account",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove uma conta do usuário; os documentos e as transações importados são mantidos, sem conta",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Excluir conta",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da conta",
+                        "name": "accountId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as chaves de API do usuário, incluindo as revogadas e expiradas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "api-keys"
+                ],
+                "summary": "Listar chaves de API",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.APIKeyResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera uma chave de API pessoal com os escopos informados. O valor da chave só é exibido nesta resposta",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "api-keys"
+                ],
+                "summary": "Criar chave de API",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da chave",
+                        "name": "key",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateAPIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatedAPIKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/api-keys/{keyId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoga uma chave de API do usuário; a chave deixa de ser aceita imediatamente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "api-keys"
+                ],
+                "summary": "Revogar chave de API",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da chave",
+                        "name": "keyId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/categories": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna as categorias padrão e as do usuário em árvore, com as subcategorias dentro das categorias de primeiro nível",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Listar categorias",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CategoryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cria uma categoria do usuário, opcionalmente como subcategoria de uma categoria padrão ou do usuário. Nomes que diferem apenas por maiúsculas, acentos ou plural são considerados iguais",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Criar categoria",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da categoria",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/categories/{categoryId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma categoria padrão ou do usuário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Buscar categoria",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da categoria",
+                        "name": "categoryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Substitui os dados de uma categoria do usuário; as categorias padrão não podem ser alteradas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Atualizar categoria",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da categoria",
+                        "name": "categoryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da categoria",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove uma categoria do usuário. Os documentos deixam de referenciá-la e as transações ficam sem categoria; categorias com subcategorias ou usadas por regras de categorização não podem ser excluídas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Excluir categoria",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da categoria",
+                        "name": "categoryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/category-rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna as regras de categorização do usuário na ordem em que são avaliadas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "category-rules"
+                ],
+                "summary": "Listar regras de categorização",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CategoryRuleResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cadastra uma regra que atribui uma categoria às transações que atendem às suas condições. As regras do usuário são avaliadas antes das regras padrão",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "category-rules"
+                ],
+                "summary": "Criar regra de categorização",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da regra",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/category-rules/apply": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Aplica as regras do usuário e as regras padrão a todas as transações já importadas, atualizando as categorias que mudaram",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "category-rules"
+                ],
+                "summary": "Reaplicar regras de categorização",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategorizationReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/category-rules/test": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Aplica as regras do usuário e as regras padrão ao histórico de transações sem alterá-lo, retornando as categorias que mudariam. Uma regra ainda não salva pode ser incluída na simulação",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "category-rules"
+                ],
+                "summary": "Simular regras de categorização",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Regra candidata e limite",
+                        "name": "test",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryRuleTestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategorizationReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/category-rules/{ruleId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Substitui os dados de uma regra. As transações já categorizadas só mudam ao reaplicar as regras",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "category-rules"
+                ],
+                "summary": "Atualizar regra de categorização",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da regra",
+                        "name": "ruleId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da regra",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove uma regra de categorização do usuário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "category-rules"
+                ],
+                "summary": "Excluir regra de categorização",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da regra",
+                        "name": "ruleId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/documents": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma lista paginada de documentos de um usuário específico. Com o parâmetro hash, retorna apenas os documentos com esse conteúdo, permitindo verificar duplicatas antes do upload.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "documents"
+                ],
+                "summary": "Listar documentos de um usuário",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página atual (padrão: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite de itens por página (padrão: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hash SHA-256 do conteúdo do arquivo (hexadecimal)",
+                        "name": "hash",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DocumentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Envia um novo documento para um usuário. O arquivo é gravado no armazenamento em streaming, sem ser carregado inteiro em memória, e seu tipo é identificado pelo conteúdo, que precisa corresponder à extensão e estar entre os tipos permitidos na configuração.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "documents"
+                ],
+                "summary": "Criar documento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tipo de documento (ex: bank_statement, invoice, receipt)",
+                        "name": "document_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "IDs das categorias do documento, padrão ou do usuário (opcional)",
+                        "name": "categories",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do perfil de importação CSV (opcional)",
+                        "name": "import_profile",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da família com que o documento é compartilhado (opcional)",
+                        "name": "household",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da conta do usuário a que o documento pertence (opcional; se ausente é identificada pelos dados do extrato)",
+                        "name": "account_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Aceita o arquivo mesmo que o mesmo conteúdo já tenha sido enviado (default: false)",
+                        "name": "force",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Arquivo do documento (PDF, DOC, DOCX, XLS, XLSX, PNG, JPEG, OFX, QFX, CSV)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DocumentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conteúdo já enviado pelo usuário",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DocumentConflictResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Tipo de arquivo não suportado ou diferente da extensão",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/import-profiles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma lista paginada dos perfis de importação do usuário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-profiles"
+                ],
+                "summary": "Listar perfis de importação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página atual (padrão: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite de itens por página (padrão: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportProfileListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cadastra o layout de um arquivo CSV de extrato para o usuário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-profiles"
+                ],
+                "summary": "Criar perfil de importação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Layout do arquivo",
+                        "name": "profile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/import-profiles/detect": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Analisa um arquivo CSV de exemplo e sugere um perfil de importação (não salvo)",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-profiles"
+                ],
+                "summary": "Detectar layout de CSV",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome sugerido para o perfil",
+                        "name": "name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Arquivo CSV de exemplo",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/import-profiles/{profileId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna um perfil de importação do usuário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-profiles"
+                ],
+                "summary": "Buscar perfil de importação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do perfil",
+                        "name": "profileId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Substitui o layout de um perfil de importação do usuário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-profiles"
+                ],
+                "summary": "Atualizar perfil de importação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do perfil",
+                        "name": "profileId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Layout do arquivo",
+                        "name": "profile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove um perfil de importação do usuário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-profiles"
+                ],
+                "summary": "Excluir perfil de importação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do perfil",
+                        "name": "profileId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/installments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna as compras parceladas no cartão identificadas nas transações do usuário (\"LOJA X 03/10\"), com o saldo restante, a data prevista da última parcela e as parcelas futuras, uma por mês a partir da última importada. Com currency, o saldo restante de cada compra também é informado convertido para a moeda informada pela cotação da data da última parcela importada.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installments"
+                ],
+                "summary": "Listar compras parceladas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Incluir as compras já quitadas (padrão: false)",
+                        "name": "include_finished",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Moeda do relatório (código ISO 4217)",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.InstallmentPlanResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/merchants": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Soma as transações do usuário por estabelecimento e moeda, do maior para o menor gasto, opcionalmente em um período. Com currency, os totais de cada estabelecimento são convertidos para a moeda informada pela cotação do dia de cada transação.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "merchants"
+                ],
+                "summary": "Listar gastos por estabelecimento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial, inclusiva (AAAA-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final, inclusiva (AAAA-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Moeda do relatório (código ISO 4217)",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.MerchantSpendingResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/merchants/apply": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Reconhece o estabelecimento das transações do usuário que ainda não têm um, como as importadas antes do diretório de estabelecimentos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "merchants"
+                ],
+                "summary": "Reconhecer estabelecimentos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MerchantAssignmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/merchants/{merchantId}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Mescla os estabelecimentos informados no estabelecimento da URL, que passa a reconhecer os seus nomes e recebe as suas transações",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "merchants"
+                ],
+                "summary": "Mesclar estabelecimentos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do estabelecimento de destino",
+                        "name": "merchantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Estabelecimentos mesclados",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MerchantMergeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MerchantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/role": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Define o papel de um usuário (user, support ou admin). Restrito a administradores, que não podem alterar o próprio papel.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Alterar papel do usuário",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novo papel",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChangeRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/subscriptions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna as assinaturas e demais cobranças recorrentes (semanais, mensais ou anuais) detectadas nas transações do usuário, com o valor e a data esperados da próxima cobrança, indicando mudanças de preço e cobranças atrasadas, e o custo mensal total por moeda, ou convertido para a moeda informada em currency, o de cada assinatura pela cotação da data da sua última cobrança. A detecção é refeita a cada documento processado.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Listar assinaturas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Moeda do relatório (código ISO 4217)",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SubscriptionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/subscriptions/detect": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Refaz a detecção das cobranças recorrentes com o histórico atual de transações do usuário, por exemplo após corrigir estabelecimentos, e retorna o resultado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Detectar assinaturas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SubscriptionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/transaction-duplicates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna as transações importadas que podem ser as mesmas que outras já importadas de extratos de períodos sobrepostos, com data ou descrição diferentes demais para serem descartadas automaticamente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction-duplicates"
+                ],
+                "summary": "Listar possíveis duplicatas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TransactionDuplicateResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/transactions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna uma lista paginada das transações extraídas dos documentos de um usuário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Listar transações de um usuário",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Página atual (padrão: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite de itens por página (padrão: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/transfers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retorna as transferências entre contas do usuário, como o pagamento da fatura do cartão a partir da conta corrente, das mais recentes para as mais antigas. As transferências sugeridas e confirmadas não contam como gastos.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Listar transferências",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra pelo status (suggested, confirmed ou rejected)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TransferResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Liga manualmente um débito e um crédito do usuário, de mesmo valor e moeda e em contas diferentes, como uma transferência confirmada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Ligar transferência",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transações da transferência",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransferLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/transfers/detect": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Procura em todo o histórico do usuário débitos e créditos de mesmo valor em contas diferentes, com datas próximas e descrições de Pix, TED, DOC ou pagamento de fatura, e retorna as novas sugestões. A detecção também é feita a cada documento processado.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Detectar transferências",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TransferResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "expires_at": {
+                    "description": "Data de expiração",
+                    "type": "string",
+                    "example": "2027-01-01T00:00:00Z"
+                },
+                "id": {
+                    "description": "ID externo da chave",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "last_used_at": {
+                    "description": "Data do último uso",
+                    "type": "string",
+                    "example": "2026-01-01T00:00:00Z"
+                },
+                "name": {
+                    "description": "Nome da chave",
+                    "type": "string",
+                    "example": "Script de upload"
+                },
+                "prefix": {
+                    "description": "Início da chave, para identificá-la",
+                    "type": "string",
+                    "example": "fa_q1w2e3r4"
+                },
+                "revoked_at": {
+                    "description": "Data de revogação",
+                    "type": "string",
+                    "example": "2026-01-01T00:00:00Z"
+                },
+                "scopes": {
+                    "description": "Escopos concedidos",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "documents:write",
+                        "transactions:read"
+                    ]
+                }
+            }
+        },
+        "dto.AccountRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "type"
+            ],
+            "properties": {
+                "currency": {
+                    "description": "Código ISO 4217 da moeda (padrão: BRL)",
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "institution": {
+                    "description": "Banco ou instituição (opcional)",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Itaú"
+                },
+                "name": {
+                    "description": "Nome da conta",
+                    "type": "string",
+                    "maxLength": 60,
+                    "example": "Conta corrente Itaú"
+                },
+                "number": {
+                    "description": "Número da conta ou do cartão; apenas os 4 últimos dígitos são guardados (opcional)",
+                    "type": "string",
+                    "maxLength": 40,
+                    "example": "12345-6"
+                },
+                "opening_balance": {
+                    "description": "Saldo inicial em centavos",
+                    "type": "integer",
+                    "example": 150000
+                },
+                "type": {
+                    "description": "Tipo da conta",
+                    "type": "string",
+                    "enum": [
+                        "checking",
+                        "savings",
+                        "credit_card",
+                        "investment",
+                        "wallet"
+                    ],
+                    "example": "checking"
+                }
+            }
+        },
+        "dto.AccountResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "currency": {
+                    "description": "Código ISO 4217 da moeda",
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "id": {
+                    "description": "ID externo da conta",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "institution": {
+                    "description": "Banco ou instituição",
+                    "type": "string",
+                    "example": "Itaú"
+                },
+                "linked": {
+                    "description": "Se a conta já foi associada a um extrato importado",
+                    "type": "boolean",
+                    "example": true
+                },
+                "masked_number": {
+                    "description": "Últimos dígitos do número",
+                    "type": "string",
+                    "example": "****3456"
+                },
+                "name": {
+                    "description": "Nome da conta",
+                    "type": "string",
+                    "example": "Conta corrente Itaú"
+                },
+                "opening_balance": {
+                    "description": "Saldo inicial em centavos",
+                    "type": "integer",
+                    "example": 150000
+                },
+                "type": {
+                    "description": "Tipo da conta",
+                    "type": "string",
+                    "example": "checking"
+                },
+                "updated_at": {
+                    "description": "Data de última atualização",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.AccountStatementResponse": {
+            "type": "object",
+            "properties": {
+                "closing_balance": {
+                    "description": "Saldo final informado pelo extrato em centavos; null quando não informado",
+                    "type": "integer",
+                    "example": 182550
+                },
+                "difference": {
+                    "description": "Saldo informado menos o calculado, em centavos",
+                    "type": "integer",
+                    "example": 0
+                },
+                "document_id": {
+                    "description": "ID externo do documento do extrato",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "end_date": {
+                    "description": "Fim do período do extrato",
+                    "type": "string",
+                    "example": "2024-01-31T00:00:00Z"
+                },
+                "expected_balance": {
+                    "description": "Saldo calculado na data do saldo final, em centavos",
+                    "type": "integer",
+                    "example": 182550
+                },
+                "opening_balance": {
+                    "description": "Saldo anterior ao período informado pelo extrato em centavos; null quando não informado",
+                    "type": "integer",
+                    "example": 150000
+                },
+                "start_date": {
+                    "description": "Início do período do extrato",
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "status": {
+                    "description": "Resultado da conciliação",
+                    "type": "string",
+                    "enum": [
+                        "matched",
+                        "amount_mismatch",
+                        "overlap",
+                        "gap",
+                        "unverified"
+                    ],
+                    "example": "matched"
+                }
+            }
+        },
+        "dto.CategorizationReportResponse": {
+            "type": "object",
+            "properties": {
+                "changed": {
+                    "description": "Transações cuja categoria muda",
+                    "type": "integer",
+                    "example": 12
+                },
+                "changes": {
+                    "description": "Amostra das alterações (apenas na simulação)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryChangeResponse"
+                    }
+                },
+                "evaluated": {
+                    "description": "Transações avaliadas",
+                    "type": "integer",
+                    "example": 120
+                },
+                "matched": {
+                    "description": "Transações atendidas por alguma regra",
+                    "type": "integer",
+                    "example": 85
+                }
+            }
+        },
+        "dto.CategoryChangeResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Valor em centavos",
+                    "type": "integer",
+                    "example": -4590
+                },
+                "category": {
+                    "description": "Categoria atribuída pela regra",
+                    "type": "string",
+                    "example": "Alimentação"
+                },
+                "date": {
+                    "description": "Data da transação (AAAA-MM-DD)",
+                    "type": "string",
+                    "example": "2023-01-15"
+                },
+                "description": {
+                    "description": "Descrição da transação",
+                    "type": "string",
+                    "example": "IFOOD *RESTAURANTE"
+                },
+                "previous_category": {
+                    "description": "Categoria atual",
+                    "type": "string",
+                    "example": "Outros"
+                },
+                "rule_id": {
+                    "description": "Regra salva do usuário (ausente para regras padrão e candidatas)",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "rule_name": {
+                    "description": "Nome da regra aplicada",
+                    "type": "string",
+                    "example": "Delivery"
+                },
+                "transaction_id": {
+                    "description": "ID externo da transação",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "dto.CategoryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "description": "Cor no formato #RRGGBB (opcional)",
+                    "type": "string",
+                    "example": "#F97316"
+                },
+                "icon": {
+                    "description": "Nome do ícone ou emoji (opcional)",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "utensils"
+                },
+                "name": {
+                    "description": "Nome da categoria",
+                    "type": "string",
+                    "maxLength": 60,
+                    "example": "Restaurantes"
+                },
+                "parent_id": {
+                    "description": "Categoria pai, padrão ou do usuário (opcional)",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "dto.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "description": "Subcategorias (apenas na listagem)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryResponse"
+                    }
+                },
+                "color": {
+                    "description": "Cor da categoria",
+                    "type": "string",
+                    "example": "#F97316"
+                },
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "icon": {
+                    "description": "Ícone da categoria",
+                    "type": "string",
+                    "example": "utensils"
+                },
+                "id": {
+                    "description": "ID externo da categoria",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "description": "Nome da categoria",
+                    "type": "string",
+                    "example": "Restaurantes"
+                },
+                "parent_id": {
+                    "description": "Categoria pai",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "system": {
+                    "description": "Se é uma categoria padrão, que não pode ser alterada",
+                    "type": "boolean",
+                    "example": false
+                },
+                "updated_at": {
+                    "description": "Data de última atualização",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.CategoryRuleConditionsDTO": {
+            "type": "object",
+            "properties": {
+                "counterparty": {
+                    "description": "Trecho da contraparte",
+                    "type": "string",
+                    "example": "iFood"
+                },
+                "day_of_month": {
+                    "description": "Dia do mês da transação",
+                    "type": "integer",
+                    "maximum": 31,
+                    "minimum": 0,
+                    "example": 5
+                },
+                "description_contains": {
+                    "description": "Trecho da descrição, sem diferenciar maiúsculas",
+                    "type": "string",
+                    "example": "ifood"
+                },
+                "description_regex": {
+                    "description": "Expressão regular aplicada à descrição, sem diferenciar maiúsculas",
+                    "type": "string",
+                    "example": "uber|99 ?pop"
+                },
+                "max_amount": {
+                    "description": "Valor máximo em centavos, inclusivo",
+                    "type": "integer",
+                    "example": -1
+                },
+                "min_amount": {
+                    "description": "Valor mínimo em centavos, inclusivo",
+                    "type": "integer",
+                    "example": -10000
+                },
+                "source_account": {
+                    "description": "Conta de origem exata",
+                    "type": "string",
+                    "example": "0001/12345-6"
+                }
+            }
+        },
+        "dto.CategoryRuleRequest": {
+            "type": "object",
+            "required": [
+                "category_id",
+                "conditions",
+                "name"
+            ],
+            "properties": {
+                "category_id": {
+                    "description": "Categoria atribuída, padrão ou do usuário",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "conditions": {
+                    "description": "Condições da regra",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.CategoryRuleConditionsDTO"
+                        }
+                    ]
+                },
+                "enabled": {
+                    "description": "Se a regra está habilitada (padrão: true)",
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "description": "Nome da regra",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Delivery"
+                },
+                "priority": {
+                    "description": "Regras de menor prioridade são avaliadas primeiro",
+                    "type": "integer",
+                    "maximum": 1000000,
+                    "minimum": 0,
+                    "example": 10
+                }
+            }
+        },
+        "dto.CategoryRuleResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Nome da categoria atribuída",
+                    "type": "string",
+                    "example": "Alimentação"
+                },
+                "category_id": {
+                    "description": "ID externo da categoria atribuída",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "conditions": {
+                    "description": "Condições da regra",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.CategoryRuleConditionsDTO"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "enabled": {
+                    "description": "Se a regra está habilitada",
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "description": "ID externo da regra",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "description": "Nome da regra",
+                    "type": "string",
+                    "example": "Delivery"
+                },
+                "priority": {
+                    "description": "Prioridade de avaliação",
+                    "type": "integer",
+                    "example": 10
+                },
+                "updated_at": {
+                    "description": "Data de última atualização",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.CategoryRuleTestRequest": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Número máximo de alterações listadas (padrão: 50)",
+                    "type": "integer",
+                    "maximum": 500,
+                    "minimum": 0,
+                    "example": 50
+                },
+                "rule": {
+                    "description": "Regra candidata, ainda não salva, incluída na simulação (opcional)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.CategoryRuleRequest"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.CategorySuggestionResponse": {
+            "description": "Categoria sugerida com base nas categorias já informadas pelo usuário",
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Nome da categoria",
+                    "type": "string",
+                    "example": "Alimentação"
+                },
+                "category_id": {
+                    "description": "ID externo da categoria",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "confidence": {
+                    "description": "Confiança estimada, entre 0 e 1",
+                    "type": "number",
+                    "example": 0.92
+                }
+            }
+        },
+        "dto.CategorySummaryResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID externo da categoria",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "description": "Nome da categoria",
+                    "type": "string",
+                    "example": "Alimentação"
+                }
+            }
+        },
+        "dto.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "current_password",
+                "new_password"
+            ],
+            "properties": {
+                "current_password": {
+                    "description": "Senha atual",
+                    "type": "string",
+                    "example": "s3nh@Segura"
+                },
+                "new_password": {
+                    "description": "Nova senha (8 a 72 caracteres)",
+                    "type": "string",
+                    "maxLength": 72,
+                    "minLength": 8,
+                    "example": "n0v@Senha123"
+                }
+            }
+        },
+        "dto.ChangeRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "description": "Novo papel (user, support ou admin)",
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "support",
+                        "admin"
+                    ],
+                    "example": "support"
+                }
+            }
+        },
+        "dto.CreateAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "Data de expiração (opcional; sem expiração quando ausente)",
+                    "type": "string",
+                    "example": "2027-01-01T00:00:00Z"
+                },
+                "name": {
+                    "description": "Nome para identificar a chave",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Script de upload"
+                },
+                "scopes": {
+                    "description": "Escopos concedidos à chave",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "documents:write",
+                        "transactions:read"
+                    ]
+                }
+            }
+        },
+        "dto.CreatedAPIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "expires_at": {
+                    "description": "Data de expiração",
+                    "type": "string",
+                    "example": "2027-01-01T00:00:00Z"
+                },
+                "id": {
+                    "description": "ID externo da chave",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "key": {
+                    "description": "Valor da chave, a ser enviado no cabeçalho \"Authorization: ApiKey \u003cchave\u003e\"",
+                    "type": "string",
+                    "example": "fa_q1w2e3r4t5y6u7i8o9p0a1s2d3f4g5h6j7k8l9z0x1c"
+                },
+                "last_used_at": {
+                    "description": "Data do último uso",
+                    "type": "string",
+                    "example": "2026-01-01T00:00:00Z"
+                },
+                "name": {
+                    "description": "Nome da chave",
+                    "type": "string",
+                    "example": "Script de upload"
+                },
+                "prefix": {
+                    "description": "Início da chave, para identificá-la",
+                    "type": "string",
+                    "example": "fa_q1w2e3r4"
+                },
+                "revoked_at": {
+                    "description": "Data de revogação",
+                    "type": "string",
+                    "example": "2026-01-01T00:00:00Z"
+                },
+                "scopes": {
+                    "description": "Escopos concedidos",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "documents:write",
+                        "transactions:read"
+                    ]
+                }
+            }
+        },
+        "dto.DocumentConflictResponse": {
+            "description": "Erro de documento duplicado, com o ID do documento existente",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Mensagem de erro",
+                    "type": "string",
+                    "example": "Documento com o mesmo conteúdo já enviado"
+                },
+                "existing_document_id": {
+                    "description": "ID externo do documento existente",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "dto.DocumentDetailResponse": {
+            "description": "Informações detalhadas de um documento, incluindo seu conteúdo",
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "description": "Conta do usuário a que o documento pertence",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "categories": {
+                    "description": "Categorias do documento",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategorySummaryResponse"
+                    }
+                },
+                "content_type": {
+                    "description": "Tipo MIME do arquivo",
+                    "type": "string",
+                    "example": "application/pdf"
+                },
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "document_type": {
+                    "description": "Tipo de documento",
+                    "type": "string",
+                    "example": "bank_statement"
+                },
+                "duplicate_allowed": {
+                    "description": "Indica que o documento repete um conteúdo já enviado e foi aceito com force=true",
+                    "type": "boolean",
+                    "example": false
+                },
+                "file_content": {
+                    "description": "Conteúdo do arquivo em Base64",
+                    "type": "string",
+                    "example": "JVBERi0xLjUKJYCBgoMKMSAwIG9iago8..."
+                },
+                "file_size": {
+                    "description": "Tamanho do arquivo em bytes",
+                    "type": "integer",
+                    "example": 125000
+                },
+                "filename": {
+                    "description": "Nome do arquivo",
+                    "type": "string",
+                    "example": "extrato_janeiro.pdf"
+                },
+                "household_id": {
+                    "description": "Família com que o documento é compartilhado",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "id": {
+                    "description": "ID externo do documento",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "sha256": {
+                    "description": "Hash SHA-256 do conteúdo",
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "status": {
+                    "description": "Status de processamento (pending, processing, processed, failed, unreconciled)",
+                    "type": "string",
+                    "example": "processing"
+                },
+                "updated_at": {
+                    "description": "Data de última atualização",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.DocumentListResponse": {
+            "description": "Lista paginada de documentos",
+            "type": "object",
+            "properties": {
+                "documents": {
+                    "description": "Lista de documentos",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DocumentResponse"
+                    }
+                },
+                "limit": {
+                    "description": "Limite de itens por página",
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "description": "Página atual",
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "description": "Número total de documentos",
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.DocumentResponse": {
+            "description": "Informações de um documento armazenado",
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "description": "Conta do usuário a que o documento pertence",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "categories": {
+                    "description": "Categorias do documento",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategorySummaryResponse"
+                    }
+                },
+                "content_type": {
+                    "description": "Tipo MIME do arquivo",
+                    "type": "string",
+                    "example": "application/pdf"
+                },
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "document_type": {
+                    "description": "Tipo de documento",
+                    "type": "string",
+                    "example": "bank_statement"
+                },
+                "duplicate_allowed": {
+                    "description": "Indica que o documento repete um conteúdo já enviado e foi aceito com force=true",
+                    "type": "boolean",
+                    "example": false
+                },
+                "file_size": {
+                    "description": "Tamanho do arquivo em bytes",
+                    "type": "integer",
+                    "example": 125000
+                },
+                "filename": {
+                    "description": "Nome do arquivo",
+                    "type": "string",
+                    "example": "extrato_janeiro.pdf"
+                },
+                "household_id": {
+                    "description": "Família com que o documento é compartilhado",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "id": {
+                    "description": "ID externo do documento",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "sha256": {
+                    "description": "Hash SHA-256 do conteúdo",
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "status": {
+                    "description": "Status de processamento (pending, processing, processed, failed, unreconciled)",
+                    "type": "string",
+                    "example": "processing"
+                },
+                "updated_at": {
+                    "description": "Data de última atualização",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.DocumentStatusUpdateRequest": {
+            "description": "Requisição para mudar o status de um documento",
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "description": "Novo status do documento",
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "processing",
+                        "processed",
+                        "failed",
+                        "unreconciled"
+                    ],
+                    "example": "processed"
+                }
+            }
+        },
+        "dto.ErrorDetail": {
+            "description": "Detalhes adicionais sobre um erro",
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "Campo com erro",
+                    "type": "string",
+                    "example": "email"
+                },
+                "message": {
+                    "description": "Mensagem descritiva do erro",
+                    "type": "string",
+                    "example": "O campo email é obrigatório"
+                }
+            }
+        },
+        "dto.ErrorResponse": {
+            "description": "Resposta padrão para erros na API",
+            "type": "object",
+            "properties": {
+                "details": {
+                    "description": "Detalhes do erro (opcional)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ErrorDetail"
+                    }
+                },
+                "error": {
+                    "description": "Mensagem de erro",
+                    "type": "string",
+                    "example": "Erro de validação"
+                }
+            }
+        },
+        "dto.HouseholdDetailResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "id": {
+                    "description": "ID externo da família",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "members": {
+                    "description": "Membros da família",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.HouseholdMemberResponse"
+                    }
+                },
+                "name": {
+                    "description": "Nome da família",
+                    "type": "string",
+                    "example": "Família Silva"
+                },
+                "updated_at": {
+                    "description": "Data de última atualização",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.HouseholdInvitationRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "description": "Email do convidado",
+                    "type": "string",
+                    "example": "maria.silva@example.com"
+                },
+                "role": {
+                    "description": "Papel oferecido na família",
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "member",
+                        "viewer"
+                    ],
+                    "example": "member"
+                }
+            }
+        },
+        "dto.HouseholdInvitationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "email": {
+                    "description": "Email do convidado",
+                    "type": "string",
+                    "example": "maria.silva@example.com"
+                },
+                "expires_at": {
+                    "description": "Data de expiração do convite",
+                    "type": "string",
+                    "example": "2023-01-08T00:00:00Z"
+                },
+                "household_id": {
+                    "description": "ID externo da família",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "household_name": {
+                    "description": "Nome da família",
+                    "type": "string",
+                    "example": "Família Silva"
+                },
+                "id": {
+                    "description": "ID externo do convite",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "role": {
+                    "description": "Papel oferecido na família",
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "member",
+                        "viewer"
+                    ],
+                    "example": "member"
+                },
+                "status": {
+                    "description": "Situação do convite",
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "accepted",
+                        "declined",
+                        "revoked"
+                    ],
+                    "example": "pending"
+                }
+            }
+        },
+        "dto.HouseholdMemberResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Email do usuário",
+                    "type": "string",
+                    "example": "joao.silva@example.com"
+                },
+                "joined_at": {
+                    "description": "Data de entrada na família",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "name": {
+                    "description": "Nome do usuário",
+                    "type": "string",
+                    "example": "João Silva"
+                },
+                "role": {
+                    "description": "Papel na família",
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "member",
+                        "viewer"
+                    ],
+                    "example": "owner"
+                },
+                "user_id": {
+                    "description": "ID externo do usuário",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "dto.HouseholdMemberRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "description": "Novo papel na família",
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "member",
+                        "viewer"
+                    ],
+                    "example": "member"
+                }
+            }
+        },
+        "dto.HouseholdRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Nome da família",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Família Silva"
+                }
+            }
+        },
+        "dto.HouseholdResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "id": {
+                    "description": "ID externo da família",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "description": "Nome da família",
+                    "type": "string",
+                    "example": "Família Silva"
+                },
+                "updated_at": {
+                    "description": "Data de última atualização",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.ImportColumnsDTO": {
+            "description": "Mapeamento de colunas; cada valor é o nome da coluna no cabeçalho ou sua posição (a partir de 1)",
+            "type": "object",
+            "required": [
+                "date",
+                "description"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Coluna do valor com sinal",
+                    "type": "string",
+                    "example": "Valor"
+                },
+                "balance": {
+                    "description": "Coluna do saldo (opcional)",
+                    "type": "string",
+                    "example": "Saldo"
+                },
+                "category": {
+                    "description": "Coluna da categoria (opcional)",
+                    "type": "string",
+                    "example": "Categoria"
+                },
+                "counterparty": {
+                    "description": "Coluna da contraparte (opcional)",
+                    "type": "string",
+                    "example": "Favorecido"
+                },
+                "credit": {
+                    "description": "Coluna de créditos (quando separada)",
+                    "type": "string",
+                    "example": "Crédito"
+                },
+                "date": {
+                    "description": "Coluna da data",
+                    "type": "string",
+                    "example": "Data"
+                },
+                "debit": {
+                    "description": "Coluna de débitos (quando separada)",
+                    "type": "string",
+                    "example": "Débito"
+                },
+                "description": {
+                    "description": "Coluna da descrição",
+                    "type": "string",
+                    "example": "Histórico"
+                }
+            }
+        },
+        "dto.ImportProfileListResponse": {
+            "description": "Lista paginada de perfis de importação",
+            "type": "object",
+            "properties": {
+                "import_profiles": {
+                    "description": "Lista de perfis",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImportProfileResponse"
+                    }
+                },
+                "limit": {
+                    "description": "Limite de itens por página",
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "description": "Página atual",
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "description": "Número total de perfis",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "dto.ImportProfileRequest": {
+            "description": "Layout de um arquivo CSV de extrato",
+            "type": "object",
+            "required": [
+                "columns",
+                "date_format",
+                "decimal_separator",
+                "delimiter",
+                "name"
+            ],
+            "properties": {
+                "columns": {
+                    "description": "Mapeamento de colunas",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ImportColumnsDTO"
+                        }
+                    ]
+                },
+                "currency": {
+                    "description": "Moeda das transações (padrão: BRL)",
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "date_format": {
+                    "description": "Formato das datas",
+                    "type": "string",
+                    "example": "dd/mm/yyyy"
+                },
+                "decimal_separator": {
+                    "description": "Separador decimal",
+                    "type": "string",
+                    "enum": [
+                        "",
+                        "",
+                        "."
+                    ],
+                    "example": ","
+                },
+                "delimiter": {
+                    "description": "Delimitador de colunas (, ; | ou tab)",
+                    "type": "string",
+                    "example": ";"
+                },
+                "encoding": {
+                    "description": "Codificação do arquivo (padrão: utf-8)",
+                    "type": "string",
+                    "enum": [
+                        "utf-8",
+                        "windows-1252",
+                        "iso-8859-1"
+                    ],
+                    "example": "windows-1252"
+                },
+                "has_header": {
+                    "description": "Se o arquivo possui linha de cabeçalho",
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "description": "Nome do perfil",
+                    "type": "string",
+                    "example": "Itaú conta corrente"
+                },
+                "skip_rows": {
+                    "description": "Linhas ignoradas antes do cabeçalho/dados",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                }
+            }
+        },
+        "dto.ImportProfileResponse": {
+            "description": "Perfil de importação de arquivos CSV",
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "description": "Mapeamento de colunas",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ImportColumnsDTO"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "currency": {
+                    "description": "Moeda das transações",
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "date_format": {
+                    "description": "Formato das datas",
+                    "type": "string",
+                    "example": "dd/mm/yyyy"
+                },
+                "decimal_separator": {
+                    "description": "Separador decimal",
+                    "type": "string",
+                    "example": ","
+                },
+                "delimiter": {
+                    "description": "Delimitador de colunas",
+                    "type": "string",
+                    "example": ";"
+                },
+                "encoding": {
+                    "description": "Codificação do arquivo",
+                    "type": "string",
+                    "example": "windows-1252"
+                },
+                "has_header": {
+                    "description": "Se o arquivo possui cabeçalho",
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "description": "ID externo do perfil (ausente em sugestões)",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "description": "Nome do perfil",
+                    "type": "string",
+                    "example": "Itaú conta corrente"
+                },
+                "skip_rows": {
+                    "description": "Linhas ignoradas",
+                    "type": "integer",
+                    "example": 0
+                },
+                "updated_at": {
+                    "description": "Data de última atualização",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.InstallmentPlanResponse": {
+            "description": "Compra parcelada com o saldo restante e as parcelas previstas",
+            "type": "object",
+            "properties": {
+                "converted_outstanding_balance": {
+                    "description": "Saldo restante convertido para a moeda do relatório, quando informada, pela cotação da data da última parcela importada",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.MoneyResponse"
+                        }
+                    ]
+                },
+                "currency": {
+                    "description": "Código ISO 4217 da moeda",
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "description": {
+                    "description": "Nome da compra, sem a parcela",
+                    "type": "string",
+                    "example": "Loja X"
+                },
+                "end_date": {
+                    "description": "Data prevista da última parcela (AAAA-MM-DD)",
+                    "type": "string",
+                    "example": "2024-10-15"
+                },
+                "id": {
+                    "description": "ID externo da compra parcelada",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "installment_amount": {
+                    "description": "Valor de cada parcela em centavos",
+                    "type": "integer",
+                    "example": 15990
+                },
+                "installments": {
+                    "description": "Número total de parcelas",
+                    "type": "integer",
+                    "example": 10
+                },
+                "outstanding_balance": {
+                    "description": "Valor das parcelas restantes em centavos",
+                    "type": "integer",
+                    "example": 111930
+                },
+                "paid_installments": {
+                    "description": "Última parcela importada",
+                    "type": "integer",
+                    "example": 3
+                },
+                "projected": {
+                    "description": "Parcelas restantes previstas, uma por mês",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProjectedInstallmentResponse"
+                    }
+                },
+                "remaining_installments": {
+                    "description": "Parcelas restantes",
+                    "type": "integer",
+                    "example": 7
+                },
+                "source_account": {
+                    "description": "Conta de origem conforme informada no extrato",
+                    "type": "string",
+                    "example": "1234"
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "description": "Email do usuário",
+                    "type": "string",
+                    "example": "joao.silva@example.com"
+                },
+                "password": {
+                    "description": "Senha do usuário",
+                    "type": "string",
+                    "example": "s3nh@Segura"
+                }
+            }
+        },
+        "dto.MerchantAssignmentResponse": {
+            "type": "object",
+            "properties": {
+                "assigned": {
+                    "description": "Transações associadas a um estabelecimento",
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.MerchantMergeRequest": {
+            "type": "object",
+            "required": [
+                "merchant_ids"
+            ],
+            "properties": {
+                "merchant_ids": {
+                    "description": "Estabelecimentos que passam a fazer parte do estabelecimento de destino",
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.MerchantResponse": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "description": "Nomes normalizados reconhecidos nas descrições",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ifood",
+                        "ifood agencia"
+                    ]
+                },
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "id": {
+                    "description": "ID externo do estabelecimento",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "description": "Nome do estabelecimento",
+                    "type": "string",
+                    "example": "Ifood"
+                },
+                "updated_at": {
+                    "description": "Data de última atualização",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.MerchantSpendingResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "description": "Código ISO 4217 da moeda",
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "merchant_id": {
+                    "description": "ID externo do estabelecimento",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "description": "Nome do estabelecimento",
+                    "type": "string",
+                    "example": "Ifood"
+                },
+                "received": {
+                    "description": "Soma dos créditos em centavos, como estornos",
+                    "type": "integer",
+                    "example": 0
+                },
+                "spent": {
+                    "description": "Soma dos débitos em centavos",
+                    "type": "integer",
+                    "example": 45990
+                },
+                "transactions": {
+                    "description": "Número de transações",
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "dto.MoneyResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Valor em unidades mínimas da moeda (centavos, ou ienes para JPY)",
+                    "type": "integer",
+                    "example": 20870
+                },
+                "currency": {
+                    "description": "Código ISO 4217 da moeda",
+                    "type": "string",
+                    "example": "USD"
+                }
+            }
+        },
+        "dto.ProjectedInstallmentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Valor da parcela em centavos",
+                    "type": "integer",
+                    "example": 15990
+                },
+                "due_date": {
+                    "description": "Data prevista (AAAA-MM-DD)",
+                    "type": "string",
+                    "example": "2024-04-15"
+                },
+                "number": {
+                    "description": "Número da parcela",
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "dto.ReconciliationResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "description": "ID externo da conta",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "currency": {
+                    "description": "Código ISO 4217 da moeda",
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "current_balance": {
+                    "description": "Saldo atual em centavos: saldo inicial mais as transações importadas",
+                    "type": "integer",
+                    "example": 182550
+                },
+                "opening_balance": {
+                    "description": "Saldo inicial em centavos",
+                    "type": "integer",
+                    "example": 150000
+                },
+                "reconciled": {
+                    "description": "Se nenhum extrato tem diferença de valor",
+                    "type": "boolean",
+                    "example": true
+                },
+                "statements": {
+                    "description": "Extratos em ordem de período",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AccountStatementResponse"
+                    }
+                }
+            }
+        },
+        "dto.RefreshTokenRequest": {
             "type": "object",
+            "required": [
+                "refresh_token"
+            ],
             "properties": {
-                "documents": {
-                    "description": "Lista de documentos",
+                "refresh_token": {
+                    "description": "Refresh token recebido no login ou na última renovação",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000.q1w2e3r4t5y6"
+                }
+            }
+        },
+        "dto.SubscriptionListResponse": {
+            "type": "object",
+            "properties": {
+                "monthly_total": {
+                    "description": "Custo mensal total em unidades mínimas da moeda, por moeda ou na moeda do relatório (cada assinatura pela cotação da sua última cobrança)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    },
+                    "example": {
+                        "BRL": 24480
+                    }
+                },
+                "subscriptions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.DocumentResponse"
+                        "$ref": "#/definitions/dto.SubscriptionResponse"
                     }
+                }
+            }
+        },
+        "dto.SubscriptionResponse": {
+            "description": "Assinatura ou conta recorrente com o valor e a data esperados da próxima cobrança",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Valor esperado em centavos (o da última cobrança)",
+                    "type": "integer",
+                    "example": 4490
                 },
-                "limit": {
-                    "description": "Limite de itens por página",
+                "currency": {
+                    "description": "Código ISO 4217 da moeda",
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "description": {
+                    "description": "Nome do estabelecimento",
+                    "type": "string",
+                    "example": "Netflix"
+                },
+                "frequency": {
+                    "description": "Periodicidade: weekly, monthly ou yearly",
+                    "type": "string",
+                    "example": "monthly"
+                },
+                "id": {
+                    "description": "ID externo da série",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "last_date": {
+                    "description": "Data da última cobrança (AAAA-MM-DD)",
+                    "type": "string",
+                    "example": "2024-06-15"
+                },
+                "merchant_id": {
+                    "description": "ID externo do estabelecimento, quando reconhecido",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "missed": {
+                    "description": "A próxima cobrança já deveria ter ocorrido",
+                    "type": "boolean",
+                    "example": false
+                },
+                "monthly_cost": {
+                    "description": "Custo mensal equivalente em centavos",
                     "type": "integer",
-                    "example": 10
+                    "example": 4490
                 },
-                "page": {
-                    "description": "Página atual",
+                "next_date": {
+                    "description": "Data esperada da próxima cobrança (AAAA-MM-DD)",
+                    "type": "string",
+                    "example": "2024-07-15"
+                },
+                "occurrences": {
+                    "description": "Cobranças encontradas",
                     "type": "integer",
-                    "example": 1
+                    "example": 6
                 },
-                "total": {
-                    "description": "Número total de documentos",
+                "previous_amount": {
+                    "description": "Valor da cobrança anterior em centavos",
                     "type": "integer",
-                    "example": 42
+                    "example": 3990
+                },
+                "price_changed": {
+                    "description": "O valor mudou na última cobrança",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.TokenResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "description": "Access token (JWT) a ser enviado no cabeçalho Authorization",
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "expires_in": {
+                    "description": "Validade do access token em segundos",
+                    "type": "integer",
+                    "example": 900
+                },
+                "refresh_token": {
+                    "description": "Refresh token de uso único para obter novos tokens",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000.q1w2e3r4t5y6"
+                },
+                "token_type": {
+                    "description": "Tipo do token",
+                    "type": "string",
+                    "example": "Bearer"
                 }
             }
         },
-        "dto.DocumentRequest": {
+        "dto.TransactionCategoryRequest": {
+            "description": "Nova categoria da transação",
             "type": "object",
             "required": [
-                "content_type",
-                "document_type",
-                "file_content",
-                "filename"
+                "category_id"
             ],
             "properties": {
-                "categories": {
-                    "description": "Categorias para classificação (opcional)",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"banco\"",
-                        "\"mensal\"]"
+                "category_id": {
+                    "description": "ID externo da categoria padrão ou do dono da transação",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "dto.TransactionDuplicateResponse": {
+            "description": "Transação importada que pode ser a mesma que outra já importada de um extrato de período sobreposto",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "duplicate_of": {
+                    "description": "Transação importada antes; é a mantida quando as duas são unidas",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TransactionResponse"
+                        }
                     ]
                 },
-                "content_type": {
-                    "description": "Tipo MIME do arquivo",
+                "id": {
+                    "description": "ID externo da possível duplicata",
                     "type": "string",
-                    "example": "application/pdf"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "document_type": {
-                    "description": "Tipo de documento (ex: extrato bancário, nota fiscal)",
-                    "type": "string",
-                    "example": "bank_statement"
+                "score": {
+                    "description": "Semelhança entre as transações, entre 0 e 1",
+                    "type": "number",
+                    "example": 0.85
                 },
-                "file_content": {
-                    "description": "Conteúdo do arquivo em Base64",
+                "status": {
+                    "description": "Aguardando revisão ou descartada pelo usuário",
                     "type": "string",
-                    "example": "JVBERi0xLjUKJYCBgoMKMSAwIG9iago8PC9GaWx0..."
+                    "enum": [
+                        "pending",
+                        "dismissed"
+                    ],
+                    "example": "pending"
                 },
-                "filename": {
-                    "description": "Nome do arquivo original",
-                    "type": "string",
-                    "example": "extrato_janeiro.pdf"
+                "transaction": {
+                    "description": "Transação importada por último; é a excluída quando as duas são unidas",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TransactionResponse"
+                        }
+                    ]
                 }
             }
         },
-        "dto.DocumentResponse": {
+        "dto.TransactionListResponse": {
+            "description": "Lista paginada de transações",
             "type": "object",
             "properties": {
-                "categories": {
-                    "description": "Categorias do documento",
+                "limit": {
+                    "description": "Limite de itens por página",
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "description": "Página atual",
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "description": "Número total de transações",
+                    "type": "integer",
+                    "example": 42
+                },
+                "transactions": {
+                    "description": "Lista de transações",
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"banco\"",
-                        "\"mensal\"]"
-                    ]
+                        "$ref": "#/definitions/dto.TransactionResponse"
+                    }
+                }
+            }
+        },
+        "dto.TransactionResponse": {
+            "description": "Transação financeira extraída de um documento",
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "description": "ID externo da conta do usuário",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "content_type": {
-                    "description": "Tipo MIME do arquivo",
+                "amount": {
+                    "description": "Valor em centavos; negativo para débitos",
+                    "type": "integer",
+                    "example": -4590
+                },
+                "category": {
+                    "description": "Nome da categoria da transação",
                     "type": "string",
-                    "example": "application/pdf"
+                    "example": "Alimentação"
+                },
+                "category_id": {
+                    "description": "ID externo da categoria da transação",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "category_source": {
+                    "description": "Origem da categoria: arquivo, regra, sugestão ou correção do usuário",
+                    "type": "string",
+                    "enum": [
+                        "file",
+                        "rule",
+                        "suggestion",
+                        "user"
+                    ],
+                    "example": "rule"
+                },
+                "counterparty": {
+                    "description": "Contraparte da transação",
+                    "type": "string",
+                    "example": "iFood"
                 },
                 "created_at": {
                     "description": "Data de criação",
                     "type": "string",
                     "example": "2023-01-01T00:00:00Z"
                 },
-                "document_type": {
-                    "description": "Tipo de documento",
+                "currency": {
+                    "description": "Código ISO 4217 da moeda",
                     "type": "string",
-                    "example": "bank_statement"
+                    "example": "BRL"
                 },
-                "filename": {
-                    "description": "Nome do arquivo",
+                "date": {
+                    "description": "Data da transação (AAAA-MM-DD)",
                     "type": "string",
-                    "example": "extrato_janeiro.pdf"
+                    "example": "2023-01-15"
+                },
+                "description": {
+                    "description": "Descrição conforme o extrato",
+                    "type": "string",
+                    "example": "IFOOD *RESTAURANTE"
+                },
+                "document_id": {
+                    "description": "ID externo do documento de origem",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
                 "id": {
-                    "description": "ID externo do documento",
+                    "description": "ID externo da transação",
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "status": {
-                    "description": "Status de processamento (pending, processing, processed, failed)",
+                "installment_number": {
+                    "description": "Número da parcela",
+                    "type": "integer",
+                    "example": 3
+                },
+                "installment_plan_id": {
+                    "description": "ID externo da compra parcelada",
                     "type": "string",
-                    "example": "processing"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "installments": {
+                    "description": "Número total de parcelas da compra",
+                    "type": "integer",
+                    "example": 10
+                },
+                "merchant": {
+                    "description": "Nome do estabelecimento reconhecido",
+                    "type": "string",
+                    "example": "Ifood"
+                },
+                "merchant_id": {
+                    "description": "ID externo do estabelecimento reconhecido",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "transfer_id": {
+                    "description": "ID externo da transferência entre contas do usuário",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
                 "updated_at": {
                     "description": "Data de última atualização",
@@ -743,22 +7572,126 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.DocumentStatusUpdateRequest": {
+        "dto.TransactionSourceResponse": {
+            "description": "Documento de origem da transação; extratos de períodos sobrepostos informam a mesma transação mais de uma vez",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Data em que o documento informou a transação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "document_id": {
+                    "description": "ID externo do documento",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "filename": {
+                    "description": "Nome do arquivo enviado",
+                    "type": "string",
+                    "example": "extrato-marco.ofx"
+                },
+                "fitid": {
+                    "description": "Identificador da transação no documento, quando informado",
+                    "type": "string",
+                    "example": "20240310001"
+                }
+            }
+        },
+        "dto.TransferLegResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "description": "ID externo da conta, quando identificada",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "date": {
+                    "description": "Data da transação (AAAA-MM-DD)",
+                    "type": "string",
+                    "example": "2024-03-10"
+                },
+                "description": {
+                    "description": "Descrição conforme o extrato",
+                    "type": "string",
+                    "example": "PAGAMENTO FATURA CARTAO"
+                },
+                "transaction_id": {
+                    "description": "ID externo da transação",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "dto.TransferLinkRequest": {
             "type": "object",
             "required": [
-                "status"
+                "transaction_ids"
             ],
             "properties": {
+                "transaction_ids": {
+                    "description": "IDs externos do débito e do crédito, em qualquer ordem",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.TransferResponse": {
+            "description": "Débito em uma conta e o crédito correspondente em outra, que não contam como gastos",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Valor transferido em centavos",
+                    "type": "integer",
+                    "example": 235000
+                },
+                "created_at": {
+                    "description": "Data de criação",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "currency": {
+                    "description": "Código ISO 4217 da moeda",
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "id": {
+                    "description": "ID externo da transferência",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "incoming": {
+                    "description": "Crédito na conta de destino",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TransferLegResponse"
+                        }
+                    ]
+                },
+                "outgoing": {
+                    "description": "Débito na conta de origem",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.TransferLegResponse"
+                        }
+                    ]
+                },
                 "status": {
-                    "description": "Novo status do documento",
+                    "description": "Sugerida automaticamente, confirmada ou rejeitada",
                     "type": "string",
                     "enum": [
-                        "pending",
-                        "processing",
-                        "processed",
-                        "failed"
+                        "suggested",
+                        "confirmed",
+                        "rejected"
                     ],
-                    "example": "processed"
+                    "example": "suggested"
+                },
+                "updated_at": {
+                    "description": "Data de última atualização",
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
                 }
             }
         },
@@ -813,7 +7746,8 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "email",
-                "name"
+                "name",
+                "password"
             ],
             "properties": {
                 "email": {
@@ -826,6 +7760,13 @@ const docTemplate = `{
                     "type": "string",
                     "example": "João Silva"
                 },
+                "password": {
+                    "description": "Senha usada no login (8 a 72 caracteres)",
+                    "type": "string",
+                    "maxLength": 72,
+                    "minLength": 8,
+                    "example": "s3nh@Segura"
+                },
                 "phone": {
                     "description": "Telefone do usuário (opcional)",
                     "type": "string",
@@ -861,6 +7802,11 @@ const docTemplate = `{
                     "type": "string",
                     "example": "(11) 98765-4321"
                 },
+                "role": {
+                    "description": "Papel do usuário (user, support ou admin)",
+                    "type": "string",
+                    "example": "user"
+                },
                 "updated_at": {
                     "description": "Data de atualização",
                     "type": "string",
@@ -870,6 +7816,12 @@ const docTemplate = `{
         }
     },
     "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "Chave de API pessoal no formato \"ApiKey \u003cchave\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        },
         "BearerAuth": {
             "type": "apiKey",
             "name": "Authorization",
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnauthenticated = errors.New("Autenticação necessária")
+	ErrForbidden       = errors.New("Acesso negado a este recurso")
+)
+
+// Principal identifica quem está fazendo a requisição
+type Principal struct {
+	UserID         int64
+	UserExternalID uuid.UUID
+	SessionID      uuid.UUID // Vazio quando autenticado por chave de API
+	Role           entity.UserRole
+	// APIKeyID e Scopes são preenchidos quando a requisição usa uma chave de
+	// API, que só acessa as rotas cobertas pelos seus escopos
+	APIKeyID uuid.UUID
+	Scopes   []string
+}
+
+// IsAPIKey indica se o principal foi autenticado por uma chave de API
+func (p *Principal) IsAPIKey() bool {
+	return p.APIKeyID != uuid.Nil
+}
+
+// HasScope indica se a chave de API concede o escopo
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+type principalKey struct{}
+
+// WithPrincipal retorna um contexto que carrega o principal autenticado
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext retorna o principal autenticado, se houver
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// AuthorizeOwner permite alterar o recurso ao seu dono e aos administradores
+func AuthorizeOwner(ctx context.Context, ownerID int64) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if principal.UserID != ownerID && principal.Role != entity.RoleAdmin {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// AuthorizeRead permite consultar os metadados do recurso ao seu dono, ao
+// suporte e aos administradores
+func AuthorizeRead(ctx context.Context, ownerID int64) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if principal.Role == entity.RoleSupport {
+		return nil
+	}
+	return AuthorizeOwner(ctx, ownerID)
+}
+
+// AuthorizeContent permite acessar o conteúdo de um arquivo; o suporte nunca
+// tem acesso ao conteúdo, nem mesmo de documentos próprios
+func AuthorizeContent(ctx context.Context, ownerID int64) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if principal.Role == entity.RoleSupport {
+		return ErrForbidden
+	}
+	return AuthorizeOwner(ctx, ownerID)
+}
+
+// RequireRole verifica se o principal do contexto possui um dos papéis informados
+func RequireRole(ctx context.Context, roles ...entity.UserRole) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if !slices.Contains(roles, principal.Role) {
+		return ErrForbidden
+	}
+	return nil
+}
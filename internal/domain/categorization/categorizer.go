@@ -0,0 +1,109 @@
+package categorization
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+// Categorizer atribui categorias às transações com a primeira regra que as
+// atende, na ordem de prioridade
+type Categorizer struct {
+	matchers []*matcher
+}
+
+// matcher guarda as condições de uma regra prontas para avaliação
+type matcher struct {
+	rule         *entity.CategoryRule
+	contains     string
+	regex        *regexp.Regexp
+	counterparty string
+}
+
+// New cria um categorizador com as regras do usuário, ordenadas por prioridade,
+// seguidas das regras padrão, que atribuem as categorias padrão do sistema
+// informadas em system. Regras desabilitadas são ignoradas.
+func New(rules []*entity.CategoryRule, system []*entity.Category) (*Categorizer, error) {
+	ordered := slices.Clone(rules)
+	slices.SortStableFunc(ordered, func(a, b *entity.CategoryRule) int {
+		return a.Priority - b.Priority
+	})
+	ordered = append(ordered, resolveDefaults(system)...)
+
+	categorizer := &Categorizer{}
+	for _, rule := range ordered {
+		if !rule.Enabled {
+			continue
+		}
+
+		m := &matcher{
+			rule:         rule,
+			contains:     strings.ToLower(rule.DescriptionContains),
+			counterparty: strings.ToLower(rule.Counterparty),
+		}
+		if rule.DescriptionRegex != "" {
+			regex, err := regexp.Compile("(?i)" + rule.DescriptionRegex)
+			if err != nil {
+				return nil, fmt.Errorf("regra %q: %w", rule.Name, entity.ErrInvalidCategoryRuleRegex)
+			}
+			m.regex = regex
+		}
+		categorizer.matchers = append(categorizer.matchers, m)
+	}
+
+	return categorizer, nil
+}
+
+// Match retorna a primeira regra atendida pela transação, ou nil
+func (c *Categorizer) Match(transaction *entity.Transaction) *entity.CategoryRule {
+	for _, m := range c.matchers {
+		if m.matches(transaction) {
+			return m.rule
+		}
+	}
+	return nil
+}
+
+// Apply atribui à transação a categoria da primeira regra atendida e indica se a
+// categoria mudou; sem regra atendida, a categoria atual é mantida
+func (c *Categorizer) Apply(transaction *entity.Transaction) (*entity.CategoryRule, bool) {
+	rule := c.Match(transaction)
+	if rule == nil || transaction.CategoryID == rule.CategoryID {
+		return rule, false
+	}
+	transaction.CategoryID = rule.CategoryID
+	transaction.CategoryExternalID = rule.CategoryExternalID
+	transaction.Category = rule.Category
+	transaction.CategorySource = entity.CategorySourceRule
+	return rule, true
+}
+
+func (m *matcher) matches(transaction *entity.Transaction) bool {
+	rule := m.rule
+
+	if m.contains != "" && !strings.Contains(strings.ToLower(transaction.Description), m.contains) {
+		return false
+	}
+	if m.regex != nil && !m.regex.MatchString(transaction.Description) {
+		return false
+	}
+	if m.counterparty != "" && !strings.Contains(strings.ToLower(transaction.Counterparty), m.counterparty) {
+		return false
+	}
+	if rule.SourceAccount != "" && !strings.EqualFold(transaction.SourceAccount, rule.SourceAccount) {
+		return false
+	}
+	if rule.MinAmount != nil && transaction.Amount < *rule.MinAmount {
+		return false
+	}
+	if rule.MaxAmount != nil && transaction.Amount > *rule.MaxAmount {
+		return false
+	}
+	if rule.DayOfMonth != 0 && transaction.Date.Day() != rule.DayOfMonth {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,173 @@
+package categorization
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
+)
+
+// minTokenLength descarta palavras curtas demais para identificar um
+// estabelecimento, como preposições ("de", "do")
+const minTokenLength = 3
+
+// Suggestion é uma categoria sugerida pelo classificador com a confiança
+// estimada, entre 0 e 1
+type Suggestion struct {
+	CategoryID         int64
+	CategoryExternalID uuid.UUID
+	Category           string
+	Confidence         float64
+}
+
+// Classifier sugere categorias com um classificador naive Bayes multinomial
+// treinado com as palavras da descrição e da contraparte das transações já
+// categorizadas pelo usuário. Roda em memória, sem acesso à rede.
+type Classifier struct {
+	classes    map[int64]*class
+	vocabulary map[string]struct{}
+	examples   int
+}
+
+// class acumula as contagens de palavras das transações de uma categoria
+type class struct {
+	suggestion Suggestion
+	examples   int
+	tokens     map[string]int
+	total      int
+}
+
+// NewClassifier treina um classificador com as transações informadas; as que
+// não têm categoria são ignoradas
+func NewClassifier(transactions []*entity.Transaction) *Classifier {
+	classifier := &Classifier{
+		classes:    make(map[int64]*class),
+		vocabulary: make(map[string]struct{}),
+	}
+
+	for _, transaction := range transactions {
+		if transaction.CategoryID == 0 {
+			continue
+		}
+		tokens := tokenize(transaction)
+		if len(tokens) == 0 {
+			continue
+		}
+
+		c, ok := classifier.classes[transaction.CategoryID]
+		if !ok {
+			c = &class{
+				suggestion: Suggestion{
+					CategoryID:         transaction.CategoryID,
+					CategoryExternalID: transaction.CategoryExternalID,
+					Category:           transaction.Category,
+				},
+				tokens: make(map[string]int),
+			}
+			classifier.classes[transaction.CategoryID] = c
+		}
+
+		c.examples++
+		classifier.examples++
+		for _, token := range tokens {
+			c.tokens[token]++
+			c.total++
+			classifier.vocabulary[token] = struct{}{}
+		}
+	}
+
+	return classifier
+}
+
+// Suggest retorna até limit categorias para a transação, da mais para a menos
+// provável. Não há sugestões enquanto o usuário tiver menos de duas categorias
+// aprendidas ou quando nenhuma palavra da transação foi vista no treino.
+func (c *Classifier) Suggest(transaction *entity.Transaction, limit int) []Suggestion {
+	if len(c.classes) < 2 || limit <= 0 {
+		return nil
+	}
+
+	var known []string
+	for _, token := range tokenize(transaction) {
+		if _, ok := c.vocabulary[token]; ok {
+			known = append(known, token)
+		}
+	}
+	if len(known) == 0 {
+		return nil
+	}
+
+	// Log-verossimilhança com suavização de Laplace
+	vocabulary := float64(len(c.vocabulary))
+	suggestions := make([]Suggestion, 0, len(c.classes))
+	scores := make([]float64, 0, len(c.classes))
+	for _, cl := range c.classes {
+		score := math.Log(float64(cl.examples) / float64(c.examples))
+		for _, token := range known {
+			score += math.Log((float64(cl.tokens[token]) + 1) / (float64(cl.total) + vocabulary))
+		}
+		suggestions = append(suggestions, cl.suggestion)
+		scores = append(scores, score)
+	}
+
+	// Converte as pontuações em probabilidades (softmax)
+	best := slices.Max(scores)
+	var sum float64
+	for i, score := range scores {
+		scores[i] = math.Exp(score - best)
+		sum += scores[i]
+	}
+	for i := range suggestions {
+		suggestions[i].Confidence = scores[i] / sum
+	}
+
+	slices.SortFunc(suggestions, func(a, b Suggestion) int {
+		if a.Confidence != b.Confidence {
+			if a.Confidence > b.Confidence {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Category, b.Category)
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// tokenize extrai as palavras distintas da descrição e da contraparte, em
+// minúsculas e sem acentos, ignorando as curtas e as que contêm dígitos
+// (datas, números de cartão e de documento)
+func tokenize(transaction *entity.Transaction) []string {
+	text := norm.NFD.String(strings.ToLower(transaction.Description + " " + transaction.Counterparty))
+
+	var tokens []string
+	seen := make(map[string]struct{})
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.Is(unicode.Mn, r)
+	}) {
+		if strings.ContainsFunc(field, unicode.IsDigit) {
+			continue
+		}
+		token := strings.Map(func(r rune) rune {
+			if unicode.Is(unicode.Mn, r) {
+				return -1
+			}
+			return r
+		}, field)
+		if len(token) < minTokenLength {
+			continue
+		}
+		if _, ok := seen[token]; ok {
+			continue
+		}
+		seen[token] = struct{}{}
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
@@ -0,0 +1,92 @@
+package categorization
+
+import (
+	"slices"
+	"testing"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+func categorized(description string, categoryID int64, category string) *entity.Transaction {
+	return &entity.Transaction{Description: description, CategoryID: categoryID, Category: category}
+}
+
+func TestClassifierSuggest(t *testing.T) {
+	training := []*entity.Transaction{
+		categorized("PADARIA PAO QUENTE", 1, "Alimentação"),
+		categorized("PADARIA DONA MARIA", 1, "Alimentação"),
+		categorized("RESTAURANTE SABOR CASEIRO", 1, "Alimentação"),
+		categorized("POSTO AVENIDA", 2, "Combustível"),
+		categorized("POSTO CENTRAL 1234", 2, "Combustível"),
+		categorized("SEM CATEGORIA", 0, ""),
+	}
+
+	tests := []struct {
+		name        string
+		training    []*entity.Transaction
+		description string
+		limit       int
+		want        []string
+	}{
+		{name: "palavra conhecida", training: training, description: "PADARIA NOVA", limit: 3, want: []string{"Alimentação", "Combustível"}},
+		{name: "outra categoria", training: training, description: "Posto Avenida 02/03", limit: 3, want: []string{"Combustível", "Alimentação"}},
+		{name: "acentos e caixa ignorados", training: training, description: "padária", limit: 1, want: []string{"Alimentação"}},
+		{name: "limite", training: training, description: "POSTO NOVO", limit: 1, want: []string{"Combustível"}},
+		{name: "nenhuma palavra conhecida", training: training, description: "FARMACIA 1234", limit: 3},
+		{name: "apenas números", training: training, description: "1234", limit: 3},
+		{name: "limite zero", training: training, description: "PADARIA", limit: 0},
+		{name: "uma única categoria aprendida", training: training[:3], description: "PADARIA", limit: 3},
+		{name: "sem treino", description: "PADARIA", limit: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suggestions := NewClassifier(tt.training).Suggest(&entity.Transaction{Description: tt.description}, tt.limit)
+
+			var got []string
+			for _, suggestion := range suggestions {
+				got = append(got, suggestion.Category)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Suggest(%q) = %v, esperado %v", tt.description, got, tt.want)
+			}
+
+			var total float64
+			for i, suggestion := range suggestions {
+				if suggestion.Confidence <= 0 || suggestion.Confidence > 1 {
+					t.Errorf("confiança de %s = %f, esperado entre 0 e 1", suggestion.Category, suggestion.Confidence)
+				}
+				if i > 0 && suggestion.Confidence > suggestions[i-1].Confidence {
+					t.Errorf("sugestões fora de ordem: %v", suggestions)
+				}
+				total += suggestion.Confidence
+			}
+			if len(suggestions) == len(tt.want) && len(suggestions) > 1 && (total < 0.999 || total > 1.001) {
+				t.Errorf("soma das confianças = %f, esperado 1", total)
+			}
+		})
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		description  string
+		counterparty string
+		want         []string
+	}{
+		{description: "PADARIA PAO QUENTE", want: []string{"padaria", "pao", "quente"}},
+		{description: "Café da Esquina", want: []string{"cafe", "esquina"}},
+		{description: "PIX 12/03 JOAO", counterparty: "João Silva", want: []string{"pix", "joao", "silva"}},
+		{description: "UBER *TRIP 4321", want: []string{"uber", "trip"}},
+		{description: "de do 1234"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got := tokenize(&entity.Transaction{Description: tt.description, Counterparty: tt.counterparty})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("tokenize(%q, %q) = %v, esperado %v", tt.description, tt.counterparty, got, tt.want)
+			}
+		})
+	}
+}
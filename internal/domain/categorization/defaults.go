@@ -0,0 +1,95 @@
+package categorization
+
+import "finance-assistant/internal/domain/entity"
+
+// defaultRules são avaliadas depois das regras do usuário, que podem
+// sobrescrevê-las com uma regra própria para as mesmas transações. A ordem
+// importa: "mercado livre" precisa ser reconhecido antes de "mercado".
+var defaultRules = []*entity.CategoryRule{
+	defaultRule("Salário", "Salário", entity.CategoryRuleConditions{
+		DescriptionRegex: `sal[aá]rio|folha de pagamento|proventos`,
+		MinAmount:        amount(1),
+	}),
+	defaultRule("Delivery e restaurantes", "Alimentação", entity.CategoryRuleConditions{
+		DescriptionRegex: `ifood|rappi|z[eé] delivery|restaurante|lanchonete|padaria|pizzaria|mcdonald|burger king`,
+	}),
+	defaultRule("Aplicativos de transporte", "Transporte", entity.CategoryRuleConditions{
+		DescriptionRegex: `\buber\b|\b99\s?(app|pop|taxi)\b|cabify|metr[oô]|bilhete [uú]nico|sem parar|conectcar|estacionamento`,
+	}),
+	defaultRule("Postos de combustível", "Combustível", entity.CategoryRuleConditions{
+		DescriptionRegex: `\bposto\b|shell|ipiranga|petrobras|\bbr mania\b`,
+	}),
+	defaultRule("Streaming e assinaturas", "Assinaturas", entity.CategoryRuleConditions{
+		DescriptionRegex: `netflix|spotify|amazon prime|prime video|disney|\bhbo\b|\bmax\.com\b|globoplay|deezer|youtube premium|apple\.com`,
+	}),
+	defaultRule("Compras online", "Compras", entity.CategoryRuleConditions{
+		DescriptionRegex: `amazon|mercado\s?livre|mercadolivre|magalu|magazine luiza|americanas|shopee|aliexpress|shein`,
+	}),
+	defaultRule("Supermercados", "Mercado", entity.CategoryRuleConditions{
+		DescriptionRegex: `supermerc|\bmercado\b|carrefour|p[aã]o de a[cç][uú]car|assa[ií]|atacad[aã]o|hortifruti|sacol[aã]o`,
+	}),
+	defaultRule("Farmácias e saúde", "Saúde", entity.CategoryRuleConditions{
+		DescriptionRegex: `farm[aá]cia|drogaria|droga raia|drogasil|pague menos|hospital|laborat[oó]rio|cl[ií]nica|unimed|\bamil\b|hapvida`,
+	}),
+	defaultRule("Contas da casa", "Moradia", entity.CategoryRuleConditions{
+		DescriptionRegex: `aluguel|condom[ií]nio|\biptu\b|\benel\b|sabesp|cemig|\blight\b|copel|comg[aá]s|energia el[eé]trica`,
+	}),
+	defaultRule("Telefone e internet", "Telefone e internet", entity.CategoryRuleConditions{
+		DescriptionRegex: `\bvivo\b|\bclaro\b|\btim\b|\boi\b|net servi[cç]os`,
+	}),
+	defaultRule("Educação", "Educação", entity.CategoryRuleConditions{
+		DescriptionRegex: `escola|col[eé]gio|faculdade|universidade|mensalidade escolar|udemy|alura|coursera`,
+	}),
+	defaultRule("Lazer", "Lazer", entity.CategoryRuleConditions{
+		DescriptionRegex: `cinema|cinemark|ingresso|sympla|steam|playstation|xbox|nintendo`,
+	}),
+	defaultRule("Tarifas bancárias", "Tarifas e impostos", entity.CategoryRuleConditions{
+		DescriptionRegex: `tarifa|\biof\b|anuidade|juros|encargos|\bdarf\b|\bdas\b`,
+	}),
+	defaultRule("Transferências", "Transferências", entity.CategoryRuleConditions{
+		DescriptionRegex: `\bpix\b|\bted\b|\bdoc\b|transfer[eê]ncia`,
+	}),
+}
+
+// DefaultRules retorna as regras padrão, avaliadas depois das regras do usuário.
+// Elas referenciam as categorias padrão apenas pelo nome.
+func DefaultRules() []*entity.CategoryRule {
+	return defaultRules
+}
+
+// resolveDefaults copia as regras padrão com as categorias padrão do sistema
+// correspondentes; regras cuja categoria não existe são ignoradas
+func resolveDefaults(system []*entity.Category) []*entity.CategoryRule {
+	byKey := make(map[string]*entity.Category, len(system))
+	for _, category := range system {
+		byKey[category.Slug] = category
+	}
+
+	rules := make([]*entity.CategoryRule, 0, len(defaultRules))
+	for _, rule := range defaultRules {
+		category, ok := byKey[entity.CategoryKey(rule.Category)]
+		if !ok {
+			continue
+		}
+		resolved := *rule
+		resolved.CategoryID = category.ID
+		resolved.CategoryExternalID = category.ExternalID
+		resolved.Category = category.Name
+		rules = append(rules, &resolved)
+	}
+	return rules
+}
+
+func defaultRule(name, category string, conditions entity.CategoryRuleConditions) *entity.CategoryRule {
+	return &entity.CategoryRule{
+		Name:                   name,
+		Category:               category,
+		Priority:               entity.MaxCategoryRulePriority + 1,
+		Enabled:                true,
+		CategoryRuleConditions: conditions,
+	}
+}
+
+func amount(cents int64) *int64 {
+	return &cents
+}
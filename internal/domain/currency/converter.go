@@ -0,0 +1,121 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+// maxCachedRates limita as cotações diárias guardadas em memória pelo Converter
+const maxCachedRates = 4096
+
+var errConversionOverflow = errors.New("valor convertido excede o limite suportado")
+
+type rateKey struct {
+	from, to string
+	date     string
+}
+
+// Converter converte valores entre moedas pela cotação da data de cada um,
+// guardando em memória até maxCachedRates cotações diárias já consultadas
+type Converter struct {
+	provider FXRateProvider
+	capacity int
+
+	mu    sync.Mutex
+	rates map[rateKey]*big.Rat
+}
+
+func NewConverter(provider FXRateProvider) *Converter {
+	return &Converter{
+		provider: provider,
+		capacity: maxCachedRates,
+		rates:    make(map[rateKey]*big.Rat),
+	}
+}
+
+// Convert converte o valor para a moeda to pela cotação de date. A conta é
+// feita com números racionais exatos, ajustando as casas decimais das duas
+// moedas (ver entity.MinorUnits), e o resultado é arredondado para a unidade
+// mínima mais próxima, com as metades afastadas do zero.
+func (c *Converter) Convert(ctx context.Context, amount entity.Money, to string, date time.Time) (entity.Money, error) {
+	if amount.Currency == to {
+		return amount, nil
+	}
+
+	rate, err := c.rate(ctx, amount.Currency, to, date)
+	if err != nil {
+		return entity.Money{}, err
+	}
+
+	value := new(big.Rat).Mul(new(big.Rat).SetInt64(amount.Amount), rate)
+	if exponent := entity.MinorUnits(to) - entity.MinorUnits(amount.Currency); exponent != 0 {
+		scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(exponent))), nil))
+		if exponent > 0 {
+			value.Mul(value, scale)
+		} else {
+			value.Quo(value, scale)
+		}
+	}
+
+	converted, err := round(value)
+	if err != nil {
+		return entity.Money{}, fmt.Errorf("%w: %s para %s", err, amount.Currency, to)
+	}
+	return entity.Money{Amount: converted, Currency: to}, nil
+}
+
+func (c *Converter) rate(ctx context.Context, from, to string, date time.Time) (*big.Rat, error) {
+	key := rateKey{from: from, to: to, date: date.Format("2006-01-02")}
+
+	c.mu.Lock()
+	rate, ok := c.rates[key]
+	c.mu.Unlock()
+	if ok {
+		return rate, nil
+	}
+
+	rate, err := c.provider.Rate(ctx, from, to, date)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s para %s em %s", err, from, to, key.date)
+	}
+
+	c.mu.Lock()
+	// Com o cache cheio, uma cotação qualquer é descartada
+	if len(c.rates) >= c.capacity {
+		for evicted := range c.rates {
+			delete(c.rates, evicted)
+			break
+		}
+	}
+	c.rates[key] = rate
+	c.mu.Unlock()
+	return rate, nil
+}
+
+// round arredonda o valor para o inteiro mais próximo, afastando as metades do zero
+func round(value *big.Rat) (int64, error) {
+	quotient, remainder := new(big.Int).QuoRem(new(big.Int).Abs(value.Num()), value.Denom(), new(big.Int))
+	if remainder.Lsh(remainder, 1).Cmp(value.Denom()) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if value.Sign() < 0 {
+		quotient.Neg(quotient)
+	}
+	if !quotient.IsInt64() {
+		return 0, errConversionOverflow
+	}
+	return quotient.Int64(), nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
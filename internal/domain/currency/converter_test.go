@@ -0,0 +1,132 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+// fakeRateProvider tem cotações fixas, independentes da data, por par de moedas
+type fakeRateProvider struct {
+	rates map[string]string // "USD>BRL" -> "4.8513"
+	calls int
+}
+
+func (p *fakeRateProvider) Rate(ctx context.Context, from, to string, date time.Time) (*big.Rat, error) {
+	p.calls++
+	value, ok := p.rates[from+">"+to]
+	if !ok {
+		return nil, ErrRateNotFound
+	}
+	rate, _ := new(big.Rat).SetString(value)
+	return rate, nil
+}
+
+func TestConverterConvert(t *testing.T) {
+	provider := &fakeRateProvider{rates: map[string]string{
+		"USD>BRL": "4.8513",
+		"EUR>BRL": "0.5",
+		"GBP>BRL": "1",
+		"JPY>BRL": "0.0345",
+		"BRL>JPY": "28.99",
+		"KWD>BRL": "16.1234",
+		"BRL>KWD": "0.062",
+	}}
+	converter := NewConverter(provider)
+	date := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		amount entity.Money
+		to     string
+		want   int64
+	}{
+		{name: "mesma moeda", amount: entity.Money{Amount: 12345, Currency: "BRL"}, to: "BRL", want: 12345},
+		{name: "centavos para centavos", amount: entity.Money{Amount: 10000, Currency: "USD"}, to: "BRL", want: 48513},
+		{name: "metade arredondada para cima", amount: entity.Money{Amount: 3, Currency: "EUR"}, to: "BRL", want: 2},
+		{name: "metade negativa afastada do zero", amount: entity.Money{Amount: -3, Currency: "EUR"}, to: "BRL", want: -2},
+		{name: "acima da metade", amount: entity.Money{Amount: 1, Currency: "USD"}, to: "BRL", want: 5},
+		{name: "abaixo da metade", amount: entity.Money{Amount: 1, Currency: "JPY"}, to: "BRL", want: 3},
+		{name: "sem perda de precisão", amount: entity.Money{Amount: 1<<53 + 1, Currency: "GBP"}, to: "BRL", want: 1<<53 + 1},
+		{name: "sem casas decimais para centavos", amount: entity.Money{Amount: 1000, Currency: "JPY"}, to: "BRL", want: 3450},
+		{name: "centavos para sem casas decimais", amount: entity.Money{Amount: 10000, Currency: "BRL"}, to: "JPY", want: 2899},
+		{name: "três casas decimais para centavos", amount: entity.Money{Amount: 1000, Currency: "KWD"}, to: "BRL", want: 1612},
+		{name: "centavos para três casas decimais", amount: entity.Money{Amount: 10000, Currency: "BRL"}, to: "KWD", want: 6200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := converter.Convert(context.Background(), tt.amount, tt.to, date)
+			if err != nil {
+				t.Fatalf("Convert() erro = %v", err)
+			}
+			if got.Amount != tt.want || got.Currency != tt.to {
+				t.Errorf("Convert() = %d %s, esperado %d %s", got.Amount, got.Currency, tt.want, tt.to)
+			}
+		})
+	}
+}
+
+func TestConverterIdentityDoesNotQueryProvider(t *testing.T) {
+	provider := &fakeRateProvider{}
+	converter := NewConverter(provider)
+
+	got, err := converter.Convert(context.Background(), entity.Money{Amount: 100, Currency: "XYZ"}, "XYZ", time.Now())
+	if err != nil || got.Amount != 100 {
+		t.Fatalf("Convert() = %v, %v", got, err)
+	}
+	if provider.calls != 0 {
+		t.Errorf("provedor consultado %d vezes, esperado nenhuma", provider.calls)
+	}
+}
+
+func TestConverterErrors(t *testing.T) {
+	converter := NewConverter(&fakeRateProvider{rates: map[string]string{"USD>BRL": "1000000"}})
+	date := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	_, err := converter.Convert(context.Background(), entity.Money{Amount: 100, Currency: "CHF"}, "BRL", date)
+	if !errors.Is(err, ErrRateNotFound) {
+		t.Fatalf("Convert() erro = %v, esperado ErrRateNotFound", err)
+	}
+	if !strings.Contains(err.Error(), "CHF para BRL em 2024-01-05") {
+		t.Errorf("erro sem o par e a data: %v", err)
+	}
+
+	if _, err := converter.Convert(context.Background(), entity.Money{Amount: 1 << 60, Currency: "USD"}, "BRL", date); !errors.Is(err, errConversionOverflow) {
+		t.Errorf("Convert() erro = %v, esperado errConversionOverflow", err)
+	}
+}
+
+func TestConverterCache(t *testing.T) {
+	provider := &fakeRateProvider{rates: map[string]string{"USD>BRL": "5"}}
+	converter := NewConverter(provider)
+	converter.capacity = 2
+	amount := entity.Money{Amount: 100, Currency: "USD"}
+
+	day := time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC)
+	for _, date := range []time.Time{day, day.Add(2 * time.Hour), day} {
+		if _, err := converter.Convert(context.Background(), amount, "BRL", date); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if provider.calls != 1 {
+		t.Errorf("provedor consultado %d vezes para o mesmo dia, esperado 1", provider.calls)
+	}
+
+	for i := 1; i <= 3; i++ {
+		if _, err := converter.Convert(context.Background(), amount, "BRL", day.AddDate(0, 0, i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if provider.calls != 4 {
+		t.Errorf("provedor consultado %d vezes, esperado 4", provider.calls)
+	}
+	if len(converter.rates) != 2 {
+		t.Errorf("cache com %d cotações, esperado o limite de 2", len(converter.rates))
+	}
+}
@@ -0,0 +1,21 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+)
+
+var (
+	ErrRateNotFound = errors.New("Cotação não encontrada para a conversão de moedas")
+)
+
+// FXRateProvider fornece as cotações diárias de câmbio
+type FXRateProvider interface {
+	// Rate retorna quantas unidades de to valem uma unidade de from na data,
+	// usando a cotação mais recente até ela (fins de semana e feriados não
+	// têm cotação). A cotação é exata e não deve ser alterada pelo chamador.
+	// Retorna ErrRateNotFound quando não há cotação.
+	Rate(ctx context.Context, from, to string, date time.Time) (*big.Rat, error)
+}
@@ -0,0 +1,153 @@
+package dedup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/pkg/merchant"
+)
+
+const (
+	// WindowDays é a maior diferença de datas entre duas transações para que
+	// sejam consideradas possíveis duplicatas; extratos em PDF e CSV da mesma
+	// conta podem registrar a data de lançamento ou a da compra
+	WindowDays = 3
+	// minScore é a semelhança mínima para que um par vá para a revisão
+	minScore = 0.6
+)
+
+// AssignFingerprints calcula a impressão digital das transações extraídas de
+// um documento. Transações idênticas no mesmo documento, como duas compras
+// iguais no mesmo dia, são diferenciadas pela ordem em que aparecem, de forma
+// que outro extrato com as mesmas duas compras seja reconhecido.
+func AssignFingerprints(document *entity.Document, transactions []*entity.Transaction) {
+	occurrences := make(map[string]int)
+	for _, transaction := range transactions {
+		key := fingerprintKey(document, transaction)
+		occurrences[key]++
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d", key, occurrences[key])))
+		transaction.Fingerprint = hex.EncodeToString(sum[:])
+	}
+}
+
+// fingerprintKey identifica a transação pela origem, data, valor, moeda e
+// descrição normalizada
+func fingerprintKey(document *entity.Document, transaction *entity.Transaction) string {
+	return strings.Join([]string{
+		origin(document, transaction),
+		transaction.Date.Format("2006-01-02"),
+		fmt.Sprint(transaction.Amount),
+		transaction.Currency,
+		merchant.Key(transaction.Description),
+	}, "|")
+}
+
+// origin identifica o extrato de que a transação veio de forma que não mude
+// quando a conta é associada depois: a conta de origem informada pelo extrato
+// (o ACCTID do OFX), que continua a mesma antes e depois da associação; na
+// falta dela, a conta escolhida no envio; e, para um CSV sem conta, o perfil
+// de importação ou o tipo do documento.
+func origin(document *entity.Document, transaction *entity.Transaction) string {
+	switch {
+	case transaction.SourceAccount != "":
+		return "source:" + transaction.SourceAccount
+	case transaction.AccountID != 0:
+		return fmt.Sprintf("account:%d", transaction.AccountID)
+	case document.ImportProfileID != 0:
+		return fmt.Sprintf("profile:%d", document.ImportProfileID)
+	}
+	return "document:" + document.DocumentType
+}
+
+// FindCandidates procura, para cada transação importada, a transação de outro
+// documento em existing que mais se parece com ela: mesmo valor e moeda, conta
+// compatível, datas próximas e descrições semelhantes. Transações com FITID
+// diferentes na mesma conta de origem nunca são duplicatas.
+func FindCandidates(imported, existing []*entity.Transaction) []*entity.TransactionDuplicate {
+	used := make(map[int64]bool)
+	var candidates []*entity.TransactionDuplicate
+	for _, transaction := range imported {
+		var best *entity.Transaction
+		bestScore := 0.0
+		for _, other := range existing {
+			if used[other.ID] || !mayBeDuplicate(transaction, other) {
+				continue
+			}
+			if score := Score(transaction, other); score > bestScore {
+				best, bestScore = other, score
+			}
+		}
+		if best == nil || bestScore < minScore {
+			continue
+		}
+		used[best.ID] = true
+		candidates = append(candidates, entity.NewTransactionDuplicate(transaction, best, bestScore))
+	}
+	return candidates
+}
+
+// Score mede a semelhança entre duas transações de mesmo valor, entre 0 e 1,
+// pela proximidade das datas e pelas palavras em comum nas descrições
+func Score(a, b *entity.Transaction) float64 {
+	dateScore := 1 - float64(daysBetween(a, b))/float64(WindowDays+1)
+	score := (dateScore + descriptionSimilarity(a.Description, b.Description)) / 2
+	return math.Round(score*1000) / 1000
+}
+
+func mayBeDuplicate(transaction, other *entity.Transaction) bool {
+	if transaction.ID == other.ID || transaction.DocumentID == other.DocumentID ||
+		transaction.Amount != other.Amount || transaction.Currency != other.Currency ||
+		daysBetween(transaction, other) > WindowDays {
+		return false
+	}
+	if transaction.FITID != "" && other.FITID != "" &&
+		transaction.SourceAccount == other.SourceAccount && transaction.FITID != other.FITID {
+		return false
+	}
+	switch {
+	case transaction.AccountID != 0 && other.AccountID != 0:
+		return transaction.AccountID == other.AccountID
+	case transaction.SourceAccount != "" && other.SourceAccount != "":
+		return transaction.SourceAccount == other.SourceAccount
+	}
+	return true
+}
+
+// descriptionSimilarity é a fração das palavras da descrição mais curta
+// presentes na outra, de forma que descrições abreviadas ainda se pareçam
+func descriptionSimilarity(a, b string) float64 {
+	wordsA := strings.Fields(merchant.Key(a))
+	wordsB := strings.Fields(merchant.Key(b))
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+	if len(wordsA) > len(wordsB) {
+		wordsA, wordsB = wordsB, wordsA
+	}
+
+	set := make(map[string]bool, len(wordsB))
+	for _, word := range wordsB {
+		set[word] = true
+	}
+	common := 0
+	for _, word := range wordsA {
+		if set[word] {
+			common++
+			delete(set, word)
+		}
+	}
+	return float64(common) / float64(len(wordsA))
+}
+
+// daysBetween retorna a diferença absoluta, em dias, entre as datas das transações
+func daysBetween(a, b *entity.Transaction) int {
+	days := int(b.Date.Sub(a.Date).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
@@ -0,0 +1,225 @@
+package dedup
+
+import (
+	"testing"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+func transaction(id, documentID int64, day string, amount int64, description string) *entity.Transaction {
+	date, err := time.Parse("2006-01-02", day)
+	if err != nil {
+		panic(err)
+	}
+	return &entity.Transaction{
+		ID:          id,
+		DocumentID:  documentID,
+		AccountID:   7,
+		Date:        date,
+		Amount:      amount,
+		Currency:    "BRL",
+		Description: description,
+	}
+}
+
+func TestAssignFingerprints(t *testing.T) {
+	// Duas compras idênticas no mesmo dia, em documentos com períodos sobrepostos
+	january := []*entity.Transaction{
+		transaction(0, 1, "2024-01-10", -1500, "PADARIA PÃO QUENTE"),
+		transaction(0, 1, "2024-01-10", -1500, "PADARIA PÃO QUENTE"),
+		transaction(0, 1, "2024-01-11", -4200, "MERCADO CENTRAL"),
+	}
+	overlapping := []*entity.Transaction{
+		transaction(0, 2, "2024-01-09", -900, "FARMÁCIA"),
+		transaction(0, 2, "2024-01-10", -1500, "Padaria Pao Quente"),
+		transaction(0, 2, "2024-01-10", -1500, "padaria pão-quente"),
+		transaction(0, 2, "2024-01-10", -1500, "PADARIA PÃO QUENTE"),
+	}
+	AssignFingerprints(&entity.Document{ID: 1}, january)
+	AssignFingerprints(&entity.Document{ID: 2}, overlapping)
+
+	if january[0].Fingerprint == "" || january[0].Fingerprint == january[1].Fingerprint {
+		t.Fatalf("transações idênticas no mesmo documento devem ter impressões distintas: %q, %q",
+			january[0].Fingerprint, january[1].Fingerprint)
+	}
+	if january[2].Fingerprint == january[0].Fingerprint {
+		t.Error("transações diferentes com a mesma impressão digital")
+	}
+
+	// A n-ésima ocorrência em um documento corresponde à n-ésima no outro,
+	// independentemente da posição e da grafia da descrição
+	if overlapping[1].Fingerprint != january[0].Fingerprint || overlapping[2].Fingerprint != january[1].Fingerprint {
+		t.Error("as duas compras do extrato sobreposto deveriam ser reconhecidas")
+	}
+	seen := map[string]bool{january[0].Fingerprint: true, january[1].Fingerprint: true, january[2].Fingerprint: true}
+	for _, index := range []int{0, 3} {
+		if seen[overlapping[index].Fingerprint] {
+			t.Errorf("transação %d do extrato sobreposto não deveria ser reconhecida", index)
+		}
+	}
+}
+
+func TestAssignFingerprintsOrigin(t *testing.T) {
+	fingerprint := func(document *entity.Document, accountID int64, sourceAccount string) string {
+		tr := transaction(0, document.ID, "2024-01-10", -1500, "PADARIA")
+		tr.AccountID = accountID
+		tr.SourceAccount = sourceAccount
+		AssignFingerprints(document, []*entity.Transaction{tr})
+		return tr.Fingerprint
+	}
+	ofx := &entity.Document{ID: 1, DocumentType: "bank_statement"}
+	csv := &entity.Document{ID: 2, DocumentType: "bank_statement"}
+	csvWithProfile := &entity.Document{ID: 3, DocumentType: "bank_statement", ImportProfileID: 4}
+
+	tests := []struct {
+		name  string
+		a, b  string
+		equal bool
+	}{
+		{
+			name:  "extrato importado antes e depois da associação à conta",
+			a:     fingerprint(ofx, 0, "341-1234-56789"),
+			b:     fingerprint(ofx, 7, "341-1234-56789"),
+			equal: true,
+		},
+		{
+			name: "contas de origem diferentes",
+			a:    fingerprint(ofx, 7, "341-1234-56789"),
+			b:    fingerprint(ofx, 7, "237-4321-98765"),
+		},
+		{
+			name: "contas escolhidas no envio diferentes",
+			a:    fingerprint(csv, 7, ""),
+			b:    fingerprint(csv, 8, ""),
+		},
+		{
+			name:  "CSV sem conta importado de novo",
+			a:     fingerprint(csv, 0, ""),
+			b:     fingerprint(&entity.Document{ID: 5, DocumentType: "bank_statement"}, 0, ""),
+			equal: true,
+		},
+		{
+			name: "CSVs sem conta de perfis diferentes",
+			a:    fingerprint(csv, 0, ""),
+			b:    fingerprint(csvWithProfile, 0, ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.a == "" || tt.b == "" {
+				t.Fatal("toda transação deveria ter impressão digital")
+			}
+			if (tt.a == tt.b) != tt.equal {
+				t.Errorf("impressões iguais = %v, esperado %v", tt.a == tt.b, tt.equal)
+			}
+		})
+	}
+}
+
+func TestFindCandidates(t *testing.T) {
+	withFITID := func(tr *entity.Transaction, fitid string) *entity.Transaction {
+		tr.SourceAccount = "341-1234-56789"
+		tr.FITID = fitid
+		return tr
+	}
+
+	tests := []struct {
+		name      string
+		imported  *entity.Transaction
+		existing  []*entity.Transaction
+		wantID    int64
+		wantScore float64
+	}{
+		{
+			name:      "mesmo dia e descrição",
+			imported:  transaction(0, 2, "2024-01-10", -1500, "PADARIA PAO QUENTE"),
+			existing:  []*entity.Transaction{transaction(10, 1, "2024-01-10", -1500, "Padaria Pão Quente")},
+			wantID:    10,
+			wantScore: 1,
+		},
+		{
+			name:      "limite da janela de datas",
+			imported:  transaction(0, 2, "2024-01-13", -1500, "PADARIA PAO QUENTE"),
+			existing:  []*entity.Transaction{transaction(10, 1, "2024-01-10", -1500, "PADARIA PAO QUENTE")},
+			wantID:    10,
+			wantScore: 0.625,
+		},
+		{
+			name:     "fora da janela de datas",
+			imported: transaction(0, 2, "2024-01-14", -1500, "PADARIA PAO QUENTE"),
+			existing: []*entity.Transaction{transaction(10, 1, "2024-01-10", -1500, "PADARIA PAO QUENTE")},
+		},
+		{
+			name:      "descrição abreviada",
+			imported:  transaction(0, 2, "2024-01-11", -1500, "PAG*PADARIA"),
+			existing:  []*entity.Transaction{transaction(10, 1, "2024-01-10", -1500, "PADARIA PAO QUENTE")},
+			wantID:    10,
+			wantScore: 0.625,
+		},
+		{
+			name:     "descrições diferentes",
+			imported: transaction(0, 2, "2024-01-10", -1500, "FARMACIA"),
+			existing: []*entity.Transaction{transaction(10, 1, "2024-01-10", -1500, "PADARIA")},
+		},
+		{
+			name:     "valores diferentes",
+			imported: transaction(0, 2, "2024-01-10", -1501, "PADARIA"),
+			existing: []*entity.Transaction{transaction(10, 1, "2024-01-10", -1500, "PADARIA")},
+		},
+		{
+			name:     "mesmo documento",
+			imported: transaction(11, 1, "2024-01-10", -1500, "PADARIA"),
+			existing: []*entity.Transaction{transaction(10, 1, "2024-01-10", -1500, "PADARIA")},
+		},
+		{
+			name:     "FITID diferentes na mesma conta de origem",
+			imported: withFITID(transaction(0, 2, "2024-01-10", -1500, "PADARIA"), "A1"),
+			existing: []*entity.Transaction{withFITID(transaction(10, 1, "2024-01-10", -1500, "PADARIA"), "A2")},
+		},
+		{
+			name:     "mais próxima entre várias",
+			imported: transaction(0, 2, "2024-01-10", -1500, "PADARIA PAO QUENTE"),
+			existing: []*entity.Transaction{
+				transaction(10, 1, "2024-01-12", -1500, "PADARIA PAO QUENTE"),
+				transaction(11, 1, "2024-01-09", -1500, "PADARIA PAO QUENTE"),
+				transaction(12, 1, "2024-01-10", -1500, "POSTO"),
+			},
+			wantID:    11,
+			wantScore: 0.875,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := FindCandidates([]*entity.Transaction{tt.imported}, tt.existing)
+			if tt.wantID == 0 {
+				if len(candidates) != 0 {
+					t.Fatalf("FindCandidates() = %d candidatos, esperado nenhum", len(candidates))
+				}
+				return
+			}
+			if len(candidates) != 1 {
+				t.Fatalf("FindCandidates() = %d candidatos, esperado 1", len(candidates))
+			}
+			if got := candidates[0]; got.Transaction != tt.imported || got.DuplicateOf.ID != tt.wantID || got.Score != tt.wantScore {
+				t.Errorf("candidato = %d com score %v, esperado %d com score %v",
+					got.DuplicateOf.ID, got.Score, tt.wantID, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestFindCandidatesUsesExistingOnce(t *testing.T) {
+	imported := []*entity.Transaction{
+		transaction(0, 2, "2024-01-10", -1500, "PADARIA"),
+		transaction(0, 2, "2024-01-10", -1500, "PADARIA"),
+	}
+	existing := []*entity.Transaction{transaction(10, 1, "2024-01-10", -1500, "PADARIA")}
+
+	candidates := FindCandidates(imported, existing)
+	if len(candidates) != 1 || candidates[0].Transaction != imported[0] {
+		t.Fatalf("FindCandidates() = %d candidatos, esperado apenas o primeiro importado", len(candidates))
+	}
+}
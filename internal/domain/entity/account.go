@@ -0,0 +1,166 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAccountName        = errors.New("Nome da conta inválido")
+	ErrInvalidAccountInstitution = errors.New("Instituição da conta inválida")
+	ErrInvalidAccountType        = errors.New("Tipo de conta inválido, use checking, savings, credit_card, investment ou wallet")
+	ErrInvalidAccountCurrency    = errors.New("Moeda da conta inválida")
+	ErrInvalidAccountNumber      = errors.New("Número da conta inválido, informe ao menos os 4 últimos dígitos")
+)
+
+type AccountType string
+
+const (
+	AccountTypeChecking   AccountType = "checking"
+	AccountTypeSavings    AccountType = "savings"
+	AccountTypeCreditCard AccountType = "credit_card"
+	AccountTypeInvestment AccountType = "investment"
+	AccountTypeWallet     AccountType = "wallet"
+)
+
+// accountNumberDigits é a quantidade de dígitos finais guardados do número da
+// conta ou do cartão; o restante nunca é armazenado
+const accountNumberDigits = 4
+
+// IsValid verifica se o tipo de conta é suportado
+func (t AccountType) IsValid() bool {
+	switch t {
+	case AccountTypeChecking, AccountTypeSavings, AccountTypeCreditCard, AccountTypeInvestment, AccountTypeWallet:
+		return true
+	}
+	return false
+}
+
+// Account é uma conta bancária, cartão de crédito, investimento ou carteira do
+// usuário, à qual pertencem os documentos e as transações importados
+type Account struct {
+	ID             int64       `db:"id" json:"id"`
+	ExternalID     uuid.UUID   `db:"external_id" json:"external_id"`
+	UserID         int64       `db:"user_id" json:"user_id"`
+	Name           string      `db:"name" json:"name"`
+	Institution    string      `db:"institution" json:"institution"`
+	Type           AccountType `db:"account_type" json:"account_type"`
+	Currency       string      `db:"currency" json:"currency"`
+	MaskedNumber   string      `db:"masked_number" json:"masked_number"`     // Últimos dígitos do número (ex: ****1234)
+	OpeningBalance int64       `db:"opening_balance" json:"opening_balance"` // Saldo inicial em centavos
+	// SourceAccount identifica a conta nos extratos (ver Transaction.SourceAccount);
+	// é aprendido na primeira importação associada à conta
+	SourceAccount string    `db:"source_account" json:"source_account"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// NewAccount cria uma conta do usuário
+func NewAccount(userID int64, name, institution string, accountType AccountType, currency, number string, openingBalance int64) (*Account, error) {
+	account := &Account{
+		ExternalID: uuid.New(),
+		UserID:     userID,
+		CreatedAt:  time.Now(),
+	}
+	if err := account.Update(name, institution, accountType, currency, number, openingBalance); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// Update substitui os dados da conta com validações. Do número informado são
+// guardados apenas os últimos dígitos; vazio mantém os já cadastrados.
+func (a *Account) Update(name, institution string, accountType AccountType, currency, number string, openingBalance int64) error {
+	name = strings.Join(strings.Fields(name), " ")
+	institution = strings.Join(strings.Fields(institution), " ")
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+
+	if name == "" || utf8.RuneCountInString(name) > 60 {
+		return ErrInvalidAccountName
+	}
+	if utf8.RuneCountInString(institution) > 100 {
+		return ErrInvalidAccountInstitution
+	}
+	if !accountType.IsValid() {
+		return ErrInvalidAccountType
+	}
+	if !IsValidCurrency(currency) {
+		return ErrInvalidAccountCurrency
+	}
+
+	if strings.TrimSpace(number) != "" {
+		digits := lastDigits(number)
+		if len(digits) < accountNumberDigits {
+			return ErrInvalidAccountNumber
+		}
+		a.MaskedNumber = "****" + digits
+	}
+
+	a.Name = name
+	a.Institution = institution
+	a.Type = accountType
+	a.Currency = currency
+	a.OpeningBalance = openingBalance
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// MatchesStatement indica se um extrato com o identificador de conta informado
+// (ACCTID do OFX ou número do cartão, possivelmente mascarado) pode ser desta
+// conta: os tipos precisam ser compatíveis e os últimos dígitos iguais
+func (a *Account) MatchesStatement(accountID string, creditCard bool) bool {
+	if (a.Type == AccountTypeCreditCard) != creditCard || a.MaskedNumber == "" {
+		return false
+	}
+	digits := lastDigits(accountID)
+	return len(digits) == accountNumberDigits && digits == lastDigits(a.MaskedNumber)
+}
+
+// MatchAccount escolhe entre as contas do usuário a do extrato: primeiro a que
+// já foi associada ao mesmo identificador em importações anteriores e, na
+// falta dela, a única cujos últimos dígitos coincidem. Retorna nil se nenhuma
+// ou mais de uma conta for compatível.
+func MatchAccount(accounts []*Account, sourceAccount, accountID string, creditCard bool) *Account {
+	if sourceAccount != "" {
+		for _, account := range accounts {
+			if account.SourceAccount == sourceAccount {
+				return account
+			}
+		}
+	}
+
+	var match *Account
+	for _, account := range accounts {
+		if account.SourceAccount != "" || !account.MatchesStatement(accountID, creditCard) {
+			continue
+		}
+		if match != nil {
+			return nil
+		}
+		match = account
+	}
+	return match
+}
+
+// lastDigits retorna os últimos dígitos de um número de conta ou cartão,
+// ignorando a máscara e os separadores
+func lastDigits(number string) string {
+	var digits []rune
+	for _, r := range number {
+		if unicode.IsDigit(r) {
+			digits = append(digits, r)
+		}
+	}
+	if len(digits) > accountNumberDigits {
+		digits = digits[len(digits)-accountNumberDigits:]
+	}
+	return string(digits)
+}
@@ -0,0 +1,138 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReconciliationStatus é o resultado da conferência de um extrato com as
+// transações da conta
+type ReconciliationStatus string
+
+const (
+	ReconciliationMatched        ReconciliationStatus = "matched"         // O saldo do extrato confere com as transações
+	ReconciliationAmountMismatch ReconciliationStatus = "amount_mismatch" // O saldo do extrato difere do calculado
+	ReconciliationOverlap        ReconciliationStatus = "overlap"         // O período se sobrepõe ao do extrato anterior
+	ReconciliationGap            ReconciliationStatus = "gap"             // Falta o extrato de um período anterior
+	ReconciliationUnverified     ReconciliationStatus = "unverified"      // O extrato não informa o saldo
+)
+
+// AccountStatement é o período e os saldos informados por um extrato
+// importado para uma conta, com o resultado da última conciliação
+type AccountStatement struct {
+	ID                 int64     `db:"id" json:"id"`
+	AccountID          int64     `db:"account_id" json:"account_id"`
+	DocumentID         int64     `db:"document_id" json:"document_id"`
+	DocumentExternalID uuid.UUID `db:"document_external_id" json:"document_external_id"`
+	SourceAccount      string    `db:"source_account" json:"source_account"`
+	StartDate          time.Time `db:"start_date" json:"start_date"`
+	EndDate            time.Time `db:"end_date" json:"end_date"`
+	OpeningBalance     *int64    `db:"opening_balance" json:"opening_balance"` // Saldo anterior ao período; nil quando não informado
+	ClosingBalance     *int64    `db:"closing_balance" json:"closing_balance"` // Saldo final em centavos; nil quando não informado
+	BalanceDate        time.Time `db:"balance_date" json:"balance_date"`       // Data do saldo final
+	// ExpectedBalance é o saldo calculado na data do saldo final: o saldo do
+	// extrato anterior, ou o inicial da conta, somado às transações do período
+	ExpectedBalance int64                `db:"expected_balance" json:"expected_balance"`
+	Difference      int64                `db:"difference" json:"difference"` // ClosingBalance - ExpectedBalance
+	Status          ReconciliationStatus `db:"status" json:"status"`
+	CreatedAt       time.Time            `db:"created_at" json:"created_at"`
+}
+
+// BalanceChange é a soma das transações de uma conta em um dia
+type BalanceChange struct {
+	Date   time.Time `db:"transaction_date" json:"date"`
+	Amount int64     `db:"amount" json:"amount"`
+}
+
+// NewAccountStatement registra o extrato de um documento para a conta
+func NewAccountStatement(account *Account, document *Document, sourceAccount string, startDate, endDate time.Time, openingBalance, closingBalance *int64, balanceDate time.Time) *AccountStatement {
+	if balanceDate.IsZero() {
+		balanceDate = endDate
+	}
+	return &AccountStatement{
+		AccountID:          account.ID,
+		DocumentID:         document.ID,
+		DocumentExternalID: document.ExternalID,
+		SourceAccount:      sourceAccount,
+		StartDate:          dateOnly(startDate),
+		EndDate:            dateOnly(endDate),
+		OpeningBalance:     openingBalance,
+		ClosingBalance:     closingBalance,
+		BalanceDate:        dateOnly(balanceDate),
+		Status:             ReconciliationUnverified,
+		CreatedAt:          time.Now(),
+	}
+}
+
+// Reconcile confere os extratos de uma conta, do mais antigo para o mais
+// recente, com as transações agrupadas por dia (changes, em ordem de data).
+// O saldo esperado de cada extrato parte do saldo final do extrato anterior,
+// ou do saldo inicial da conta, de forma que uma divergência não se propaga
+// para os extratos seguintes. Quando o extrato e o anterior informam os saldos,
+// a lacuna é apontada pelo saldo inicial que não continua o saldo final do
+// anterior; sem eles, pelos dias sem extrato entre os períodos. Retorna o
+// saldo atual da conta.
+func Reconcile(account *Account, statements []*AccountStatement, changes []BalanceChange) int64 {
+	slices.SortStableFunc(statements, func(a, b *AccountStatement) int {
+		if c := a.StartDate.Compare(b.StartDate); c != 0 {
+			return c
+		}
+		return a.EndDate.Compare(b.EndDate)
+	})
+
+	balance := account.OpeningBalance
+	next := 0 // Primeira mudança ainda não somada a balance
+	var previous *AccountStatement
+	for _, statement := range statements {
+		for next < len(changes) && !changes[next].Date.After(statement.BalanceDate) {
+			balance += changes[next].Amount
+			next++
+		}
+		statement.ExpectedBalance = balance
+		statement.Difference = 0
+
+		switch {
+		case statement.ClosingBalance != nil && *statement.ClosingBalance != balance:
+			statement.Status = ReconciliationAmountMismatch
+			statement.Difference = *statement.ClosingBalance - balance
+		case previous != nil && !statement.StartDate.After(previous.EndDate):
+			statement.Status = ReconciliationOverlap
+		case previous != nil && hasGap(previous, statement):
+			statement.Status = ReconciliationGap
+		case statement.ClosingBalance == nil:
+			statement.Status = ReconciliationUnverified
+		default:
+			statement.Status = ReconciliationMatched
+		}
+
+		// O saldo informado pelo extrato é a base do seguinte
+		if statement.ClosingBalance != nil {
+			balance = *statement.ClosingBalance
+		}
+		previous = statement
+	}
+
+	for ; next < len(changes); next++ {
+		balance += changes[next].Amount
+	}
+	return balance
+}
+
+// hasGap indica se falta um extrato entre previous e statement
+func hasGap(previous, statement *AccountStatement) bool {
+	if statement.OpeningBalance != nil && previous.ClosingBalance != nil {
+		return *statement.OpeningBalance != *previous.ClosingBalance
+	}
+	return statement.StartDate.After(previous.EndDate.AddDate(0, 0, 1))
+}
+
+// IsReconciled indica se o saldo do extrato não diverge do calculado
+func (s *AccountStatement) IsReconciled() bool {
+	return s.Status != ReconciliationAmountMismatch
+}
+
+func dateOnly(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
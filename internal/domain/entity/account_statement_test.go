@@ -0,0 +1,211 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func testDate(day string) time.Time {
+	parsed, err := time.Parse("2006-01-02", day)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func testBalance(amount int64) *int64 {
+	return &amount
+}
+
+func testStatement(start, end string, closing *int64) *AccountStatement {
+	return &AccountStatement{
+		StartDate:      testDate(start),
+		EndDate:        testDate(end),
+		BalanceDate:    testDate(end),
+		ClosingBalance: closing,
+		Status:         ReconciliationUnverified,
+	}
+}
+
+func withOpening(statement *AccountStatement, opening int64) *AccountStatement {
+	statement.OpeningBalance = &opening
+	return statement
+}
+
+func TestReconcile(t *testing.T) {
+	type result struct {
+		status     ReconciliationStatus
+		expected   int64
+		difference int64
+	}
+
+	tests := []struct {
+		name        string
+		opening     int64
+		statements  []*AccountStatement
+		changes     []BalanceChange
+		want        []result
+		wantBalance int64
+	}{
+		{
+			name:    "extratos consecutivos conferem",
+			opening: 1000,
+			statements: []*AccountStatement{
+				testStatement("2024-01-01", "2024-01-31", testBalance(1500)),
+				testStatement("2024-02-01", "2024-02-29", testBalance(1300)),
+			},
+			changes: []BalanceChange{
+				{Date: testDate("2024-01-10"), Amount: 500},
+				{Date: testDate("2024-02-05"), Amount: -200},
+				{Date: testDate("2024-03-02"), Amount: 50},
+			},
+			want: []result{
+				{status: ReconciliationMatched, expected: 1500},
+				{status: ReconciliationMatched, expected: 1300},
+			},
+			wantBalance: 1350,
+		},
+		{
+			name:    "divergência prevalece sobre sobreposição",
+			opening: 0,
+			statements: []*AccountStatement{
+				testStatement("2024-01-01", "2024-01-31", testBalance(100)),
+				testStatement("2024-01-15", "2024-02-15", testBalance(999)),
+			},
+			changes: []BalanceChange{
+				{Date: testDate("2024-01-10"), Amount: 100},
+				{Date: testDate("2024-02-10"), Amount: 50},
+			},
+			want: []result{
+				{status: ReconciliationMatched, expected: 100},
+				{status: ReconciliationAmountMismatch, expected: 150, difference: 849},
+			},
+			wantBalance: 999,
+		},
+		{
+			name:    "sobreposição com saldo conferido",
+			opening: 0,
+			statements: []*AccountStatement{
+				testStatement("2024-01-01", "2024-01-31", testBalance(100)),
+				testStatement("2024-01-31", "2024-02-29", testBalance(150)),
+			},
+			changes: []BalanceChange{
+				{Date: testDate("2024-01-10"), Amount: 100},
+				{Date: testDate("2024-02-10"), Amount: 50},
+			},
+			want: []result{
+				{status: ReconciliationMatched, expected: 100},
+				{status: ReconciliationOverlap, expected: 150},
+			},
+			wantBalance: 150,
+		},
+		{
+			name:    "lacuna entre extratos",
+			opening: 0,
+			statements: []*AccountStatement{
+				testStatement("2024-03-01", "2024-03-31", testBalance(120)),
+				testStatement("2024-01-01", "2024-01-31", testBalance(100)),
+			},
+			changes: []BalanceChange{
+				{Date: testDate("2024-01-10"), Amount: 100},
+				{Date: testDate("2024-03-10"), Amount: 20},
+			},
+			want: []result{
+				{status: ReconciliationGap, expected: 120},
+				{status: ReconciliationMatched, expected: 100},
+			},
+			wantBalance: 120,
+		},
+		{
+			name:    "extrato sem saldo mantém o saldo calculado para o seguinte",
+			opening: 500,
+			statements: []*AccountStatement{
+				testStatement("2024-01-01", "2024-01-31", nil),
+				testStatement("2024-02-01", "2024-02-29", testBalance(420)),
+			},
+			changes: []BalanceChange{
+				{Date: testDate("2024-01-20"), Amount: -100},
+				{Date: testDate("2024-02-20"), Amount: 20},
+			},
+			want: []result{
+				{status: ReconciliationUnverified, expected: 400},
+				{status: ReconciliationMatched, expected: 420},
+			},
+			wantBalance: 420,
+		},
+		{
+			name:    "divergência não se propaga para o extrato seguinte",
+			opening: 0,
+			statements: []*AccountStatement{
+				testStatement("2024-01-01", "2024-01-31", testBalance(300)),
+				testStatement("2024-02-01", "2024-02-29", testBalance(250)),
+			},
+			changes: []BalanceChange{
+				{Date: testDate("2024-01-10"), Amount: 200},
+				{Date: testDate("2024-02-10"), Amount: -50},
+			},
+			want: []result{
+				{status: ReconciliationAmountMismatch, expected: 200, difference: 100},
+				{status: ReconciliationMatched, expected: 250},
+			},
+			wantBalance: 250,
+		},
+		{
+			name:    "saldo inicial que não continua o extrato anterior",
+			opening: 0,
+			statements: []*AccountStatement{
+				testStatement("2024-01-01", "2024-01-31", testBalance(100)),
+				withOpening(testStatement("2024-02-01", "2024-02-29", testBalance(130)), 120),
+			},
+			changes: []BalanceChange{
+				{Date: testDate("2024-01-10"), Amount: 100},
+				{Date: testDate("2024-02-10"), Amount: 30},
+			},
+			want: []result{
+				{status: ReconciliationMatched, expected: 100},
+				{status: ReconciliationGap, expected: 130},
+			},
+			wantBalance: 130,
+		},
+		{
+			name:    "saldo inicial que continua o extrato anterior dispensa os dias entre os períodos",
+			opening: 0,
+			statements: []*AccountStatement{
+				withOpening(testStatement("2024-01-03", "2024-01-20", testBalance(100)), 0),
+				withOpening(testStatement("2024-02-05", "2024-02-25", testBalance(130)), 100),
+			},
+			changes: []BalanceChange{
+				{Date: testDate("2024-01-10"), Amount: 100},
+				{Date: testDate("2024-02-10"), Amount: 30},
+			},
+			want: []result{
+				{status: ReconciliationMatched, expected: 100},
+				{status: ReconciliationMatched, expected: 130},
+			},
+			wantBalance: 130,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statements := append([]*AccountStatement(nil), tt.statements...)
+			got := Reconcile(&Account{OpeningBalance: tt.opening}, tt.statements, tt.changes)
+			if got != tt.wantBalance {
+				t.Errorf("Reconcile() = %d, esperado %d", got, tt.wantBalance)
+			}
+
+			// Os resultados seguem a ordem em que os extratos foram informados
+			for i, statement := range statements {
+				want := tt.want[i]
+				if statement.Status != want.status || statement.ExpectedBalance != want.expected || statement.Difference != want.difference {
+					t.Errorf("extrato %d = {%s %d %d}, esperado {%s %d %d}", i,
+						statement.Status, statement.ExpectedBalance, statement.Difference,
+						want.status, want.expected, want.difference)
+				}
+				if statement.IsReconciled() != (want.status != ReconciliationAmountMismatch) {
+					t.Errorf("extrato %d: IsReconciled() = %v", i, statement.IsReconciled())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAPIKeyName   = errors.New("Nome da chave de API inválido")
+	ErrInvalidAPIKeyScopes = errors.New("Escopos da chave de API inválidos")
+	ErrInvalidAPIKeyExpiry = errors.New("A data de expiração da chave de API deve estar no futuro")
+)
+
+// Escopos que podem ser concedidos a uma chave de API
+const (
+	ScopeUsersRead           = "users:read"
+	ScopeDocumentsRead       = "documents:read"
+	ScopeDocumentsWrite      = "documents:write"
+	ScopeTransactionsRead    = "transactions:read"
+	ScopeTransactionsWrite   = "transactions:write"
+	ScopeImportProfilesRead  = "import-profiles:read"
+	ScopeImportProfilesWrite = "import-profiles:write"
+	ScopeCategoriesRead      = "categories:read"
+	ScopeCategoriesWrite     = "categories:write"
+	ScopeCategoryRulesRead   = "category-rules:read"
+	ScopeCategoryRulesWrite  = "category-rules:write"
+	ScopeMerchantsRead       = "merchants:read"
+	ScopeMerchantsWrite      = "merchants:write"
+	ScopeInstallmentsRead    = "installments:read"
+	ScopeSubscriptionsRead   = "subscriptions:read"
+	ScopeSubscriptionsWrite  = "subscriptions:write"
+	ScopeAccountsRead        = "accounts:read"
+	ScopeAccountsWrite       = "accounts:write"
+	ScopeTransfersRead       = "transfers:read"
+	ScopeTransfersWrite      = "transfers:write"
+)
+
+// APIKeyScopes lista todos os escopos válidos
+var APIKeyScopes = []string{
+	ScopeUsersRead,
+	ScopeDocumentsRead,
+	ScopeDocumentsWrite,
+	ScopeTransactionsRead,
+	ScopeTransactionsWrite,
+	ScopeImportProfilesRead,
+	ScopeImportProfilesWrite,
+	ScopeCategoriesRead,
+	ScopeCategoriesWrite,
+	ScopeCategoryRulesRead,
+	ScopeCategoryRulesWrite,
+	ScopeMerchantsRead,
+	ScopeMerchantsWrite,
+	ScopeInstallmentsRead,
+	ScopeSubscriptionsRead,
+	ScopeSubscriptionsWrite,
+	ScopeAccountsRead,
+	ScopeAccountsWrite,
+	ScopeTransfersRead,
+	ScopeTransfersWrite,
+}
+
+// APIKey é uma chave de acesso pessoal para scripts, limitada aos escopos
+// concedidos. Apenas o hash da chave é armazenado; o valor é exibido uma única
+// vez, na criação.
+type APIKey struct {
+	ID         int64      `db:"id" json:"id"`
+	ExternalID uuid.UUID  `db:"external_id" json:"external_id"`
+	UserID     int64      `db:"user_id" json:"user_id"`
+	Name       string     `db:"name" json:"name"`
+	Prefix     string     `db:"prefix" json:"prefix"` // Início da chave, para identificá-la
+	KeyHash    string     `db:"key_hash" json:"-"`
+	Scopes     []string   `db:"scopes" json:"scopes"`
+	ExpiresAt  *time.Time `db:"expires_at" json:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at" json:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at" json:"revoked_at"`
+	CreatedAt  time.Time  `db:"created_at" json:"created_at"`
+}
+
+// NewAPIKey cria uma chave de API com validações; expiresAt nulo indica que a
+// chave não expira
+func NewAPIKey(userID int64, name, prefix, keyHash string, scopes []string, expiresAt *time.Time) (*APIKey, error) {
+	if name == "" || len(name) > 100 {
+		return nil, ErrInvalidAPIKeyName
+	}
+	if len(scopes) == 0 {
+		return nil, ErrInvalidAPIKeyScopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(APIKeyScopes, scope) {
+			return nil, ErrInvalidAPIKeyScopes
+		}
+	}
+
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, ErrInvalidAPIKeyExpiry
+	}
+
+	return &APIKey{
+		ExternalID: uuid.New(),
+		UserID:     userID,
+		Name:       name,
+		Prefix:     prefix,
+		KeyHash:    keyHash,
+		Scopes:     slices.Compact(slices.Sorted(slices.Values(scopes))),
+		ExpiresAt:  expiresAt,
+		CreatedAt:  now,
+	}, nil
+}
+
+// IsActive indica se a chave não foi revogada nem expirou
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
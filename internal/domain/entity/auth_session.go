@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuthSession representa um login do usuário. O refresh token é trocado a cada
+// renovação e apenas o hash do token atual é armazenado, de forma que a
+// reutilização de um token antigo possa ser detectada.
+type AuthSession struct {
+	ID               uuid.UUID  `db:"id" json:"id"` // Também enviado no access token (claim sid)
+	UserID           int64      `db:"user_id" json:"user_id"`
+	RefreshTokenHash string     `db:"refresh_token_hash" json:"-"`
+	ExpiresAt        time.Time  `db:"expires_at" json:"expires_at"`
+	RevokedAt        *time.Time `db:"revoked_at" json:"revoked_at"`
+	CreatedAt        time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+// NewAuthSession cria uma sessão válida por ttl para o hash de refresh token informado
+func NewAuthSession(userID int64, refreshTokenHash string, ttl time.Duration) *AuthSession {
+	now := time.Now()
+	return &AuthSession{
+		ID:               uuid.New(),
+		UserID:           userID,
+		RefreshTokenHash: refreshTokenHash,
+		ExpiresAt:        now.Add(ttl),
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+}
+
+// IsActive indica se a sessão não foi revogada nem expirou
+func (s *AuthSession) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
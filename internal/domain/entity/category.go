@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidCategoryName   = errors.New("Nome da categoria inválido")
+	ErrInvalidCategoryIcon   = errors.New("Ícone da categoria inválido")
+	ErrInvalidCategoryColor  = errors.New("Cor da categoria inválida, use o formato #RRGGBB")
+	ErrInvalidCategoryParent = errors.New("Subcategorias não podem ter subcategorias")
+)
+
+// categoryColor valida cores no formato hexadecimal #RRGGBB
+var categoryColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// categoryKeyReplacer remove os acentos usados em português; a migração que
+// converteu as categorias antigas usa a mesma tabela
+var categoryKeyReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c",
+)
+
+// Category classifica documentos e transações. As categorias padrão do sistema
+// (sem usuário) valem para todos; cada usuário pode criar as suas, inclusive
+// como subcategorias das padrão. A hierarquia tem no máximo dois níveis.
+type Category struct {
+	ID               int64     `db:"id" json:"id"`
+	ExternalID       uuid.UUID `db:"external_id" json:"external_id"`
+	UserID           int64     `db:"user_id" json:"user_id"`     // 0 para categorias padrão
+	ParentID         int64     `db:"parent_id" json:"parent_id"` // 0 para categorias de primeiro nível
+	ParentExternalID uuid.UUID `db:"parent_external_id" json:"parent_external_id"`
+	Name             string    `db:"name" json:"name"`
+	Slug             string    `db:"slug" json:"slug"` // Chave de unicidade, ver CategoryKey
+	Icon             string    `db:"icon" json:"icon"`
+	Color            string    `db:"color" json:"color"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// NewCategory cria uma categoria do usuário, opcionalmente abaixo de parent
+func NewCategory(userID int64, parent *Category, name, icon, color string) (*Category, error) {
+	now := time.Now()
+	category := &Category{
+		ExternalID: uuid.New(),
+		UserID:     userID,
+		CreatedAt:  now,
+	}
+	if err := category.Update(parent, name, icon, color); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// Update substitui os dados da categoria com validações
+func (c *Category) Update(parent *Category, name, icon, color string) error {
+	name = strings.Join(strings.Fields(name), " ")
+	icon = strings.TrimSpace(icon)
+	color = strings.TrimSpace(color)
+
+	if name == "" || utf8.RuneCountInString(name) > 60 {
+		return ErrInvalidCategoryName
+	}
+	if utf8.RuneCountInString(icon) > 50 {
+		return ErrInvalidCategoryIcon
+	}
+	if color != "" && !categoryColor.MatchString(color) {
+		return ErrInvalidCategoryColor
+	}
+	if parent != nil && (parent.ParentID != 0 || parent.ID == c.ID) {
+		return ErrInvalidCategoryParent
+	}
+
+	c.ParentID = 0
+	c.ParentExternalID = uuid.Nil
+	if parent != nil {
+		c.ParentID = parent.ID
+		c.ParentExternalID = parent.ExternalID
+	}
+	c.Name = name
+	c.Slug = CategoryKey(name)
+	c.Icon = icon
+	c.Color = strings.ToUpper(color)
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsSystem indica se é uma categoria padrão do sistema
+func (c *Category) IsSystem() bool {
+	return c.UserID == 0
+}
+
+// IsAvailableTo indica se o usuário pode usar a categoria: as padrão e as próprias
+func (c *Category) IsAvailableTo(userID int64) bool {
+	return c.IsSystem() || c.UserID == userID
+}
+
+// CategoryKey normaliza o nome de uma categoria para comparação: sem acentos,
+// em minúsculas, com espaços simples e sem o "s" final, de forma que "Banco",
+// "banco" e "bancos" sejam a mesma categoria
+func CategoryKey(name string) string {
+	key := categoryKeyReplacer.Replace(strings.ToLower(strings.Join(strings.Fields(name), " ")))
+	return strings.TrimSuffix(key, "s")
+}
@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidCategoryRuleName       = errors.New("Nome da regra inválido")
+	ErrInvalidCategoryRuleCategory   = errors.New("Categoria da regra inválida")
+	ErrInvalidCategoryRulePriority   = errors.New("A prioridade da regra deve estar entre 0 e 1000000")
+	ErrCategoryRuleWithoutConditions = errors.New("A regra precisa de pelo menos uma condição")
+	ErrInvalidCategoryRuleRegex      = errors.New("Expressão regular da regra inválida")
+	ErrInvalidCategoryRuleAmount     = errors.New("O valor mínimo da regra não pode ser maior que o máximo")
+	ErrInvalidCategoryRuleDay        = errors.New("O dia do mês da regra deve estar entre 1 e 31")
+)
+
+// MaxCategoryRulePriority é a maior prioridade aceita nas regras do usuário; as
+// regras padrão são avaliadas depois de todas elas
+const MaxCategoryRulePriority = 1000000
+
+// CategoryRuleConditions são as condições de uma regra; apenas as preenchidas
+// são avaliadas e todas precisam ser atendidas. Textos são comparados sem
+// diferenciar maiúsculas de minúsculas.
+type CategoryRuleConditions struct {
+	DescriptionContains string `db:"description_contains" json:"description_contains"`
+	DescriptionRegex    string `db:"description_regex" json:"description_regex"`
+	Counterparty        string `db:"counterparty" json:"counterparty"`     // Trecho da contraparte
+	SourceAccount       string `db:"source_account" json:"source_account"` // Conta de origem exata
+	MinAmount           *int64 `db:"min_amount" json:"min_amount"`         // Em centavos, inclusivo; negativo para débitos
+	MaxAmount           *int64 `db:"max_amount" json:"max_amount"`         // Em centavos, inclusivo
+	DayOfMonth          int    `db:"day_of_month" json:"day_of_month"`     // 0 para qualquer dia
+}
+
+// IsEmpty indica se nenhuma condição foi preenchida
+func (c CategoryRuleConditions) IsEmpty() bool {
+	return c.DescriptionContains == "" && c.DescriptionRegex == "" && c.Counterparty == "" &&
+		c.SourceAccount == "" && c.MinAmount == nil && c.MaxAmount == nil && c.DayOfMonth == 0
+}
+
+// CategoryRule atribui uma categoria às transações que atendem às suas condições
+type CategoryRule struct {
+	ID         int64     `db:"id" json:"id"`
+	ExternalID uuid.UUID `db:"external_id" json:"external_id"`
+	UserID     int64     `db:"user_id" json:"user_id"`
+	Name       string    `db:"name" json:"name"`
+	CategoryID int64     `db:"category_id" json:"category_id"`
+	// CategoryExternalID e Category são o ID externo e o nome da categoria atribuída
+	CategoryExternalID uuid.UUID `db:"category_external_id" json:"category_external_id"`
+	Category           string    `db:"category" json:"category"`
+	Priority           int       `db:"priority" json:"priority"` // Regras de menor prioridade são avaliadas primeiro
+	Enabled            bool      `db:"enabled" json:"enabled"`
+	CategoryRuleConditions
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// NewCategoryRule cria uma regra habilitada com validações
+func NewCategoryRule(userID int64, name string, category *Category, priority int, conditions CategoryRuleConditions) (*CategoryRule, error) {
+	now := time.Now()
+	rule := &CategoryRule{
+		ExternalID: uuid.New(),
+		UserID:     userID,
+		Enabled:    true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := rule.Update(name, category, priority, rule.Enabled, conditions); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// Update substitui os dados da regra com validações
+func (r *CategoryRule) Update(name string, category *Category, priority int, enabled bool, conditions CategoryRuleConditions) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return ErrInvalidCategoryRuleName
+	}
+	if category == nil || !category.IsAvailableTo(r.UserID) {
+		return ErrInvalidCategoryRuleCategory
+	}
+	if priority < 0 || priority > MaxCategoryRulePriority {
+		return ErrInvalidCategoryRulePriority
+	}
+	if err := conditions.validate(); err != nil {
+		return err
+	}
+
+	r.Name = name
+	r.CategoryID = category.ID
+	r.CategoryExternalID = category.ExternalID
+	r.Category = category.Name
+	r.Priority = priority
+	r.Enabled = enabled
+	r.CategoryRuleConditions = conditions
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+func (c *CategoryRuleConditions) validate() error {
+	c.DescriptionContains = strings.TrimSpace(c.DescriptionContains)
+	c.Counterparty = strings.TrimSpace(c.Counterparty)
+	c.SourceAccount = strings.TrimSpace(c.SourceAccount)
+
+	if c.IsEmpty() {
+		return ErrCategoryRuleWithoutConditions
+	}
+	if c.DescriptionRegex != "" {
+		if _, err := regexp.Compile(c.DescriptionRegex); err != nil {
+			return ErrInvalidCategoryRuleRegex
+		}
+	}
+	if c.MinAmount != nil && c.MaxAmount != nil && *c.MinAmount > *c.MaxAmount {
+		return ErrInvalidCategoryRuleAmount
+	}
+	if c.DayOfMonth < 0 || c.DayOfMonth > 31 {
+		return ErrInvalidCategoryRuleDay
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,24 +22,41 @@ const (
 	DocumentStatusProcessing DocumentStatus = "processing"
 	DocumentStatusProcessed  DocumentStatus = "processed"
 	DocumentStatusFailed     DocumentStatus = "failed"
+	// DocumentStatusUnreconciled indica que o documento foi processado, mas o
+	// saldo informado em algum extrato não confere com as transações da conta
+	DocumentStatusUnreconciled DocumentStatus = "unreconciled"
 )
 
 type Document struct {
-	ID           int64          `db:"id" json:"id"`
-	ExternalID   uuid.UUID      `db:"external_id" json:"external_id"`
-	UserID       int64          `db:"user_id" json:"user_id"`
-	DocumentType string         `db:"document_type" json:"document_type"`
-	Filename     string         `db:"filename" json:"filename"`
-	ContentType  string         `db:"content_type" json:"content_type"`
-	FileContent  string         `db:"file_content" json:"file_content"`
-	Categories   []string       `db:"categories" json:"categories"`
-	Status       DocumentStatus `db:"status" json:"status"`
-	CreatedAt    time.Time      `db:"created_at" json:"created_at"`
-	UpdatedAt    time.Time      `db:"updated_at" json:"updated_at"`
+	ID           int64     `db:"id" json:"id"`
+	ExternalID   uuid.UUID `db:"external_id" json:"external_id"`
+	UserID       int64     `db:"user_id" json:"user_id"`
+	DocumentType string    `db:"document_type" json:"document_type"`
+	Filename     string    `db:"filename" json:"filename"`
+	ContentType  string    `db:"content_type" json:"content_type"`
+	// StorageKey identifica o conteúdo do arquivo no BlobStore
+	StorageKey string `db:"storage_key" json:"storage_key"`
+	Size       int64  `db:"size_bytes" json:"size_bytes"`
+	SHA256     string `db:"sha256" json:"sha256"`
+	// DuplicateAllowed indica que o documento repete o conteúdo de outro do mesmo usuário e foi aceito explicitamente
+	DuplicateAllowed bool           `db:"duplicate_allowed" json:"duplicate_allowed"`
+	Categories       []*Category    `db:"categories" json:"categories"`
+	Status           DocumentStatus `db:"status" json:"status"`
+	// ImportProfileID referencia o perfil de importação usado em arquivos CSV (0 quando não informado)
+	ImportProfileID int64 `db:"import_profile_id" json:"import_profile_id"`
+	// HouseholdID referencia a família com que o documento é compartilhado (0 quando pessoal)
+	HouseholdID         int64     `db:"household_id" json:"household_id"`
+	HouseholdExternalID uuid.UUID `db:"household_external_id" json:"household_external_id"`
+	// AccountID referencia a conta do usuário a que o documento pertence (0 quando não identificada)
+	AccountID         int64     `db:"account_id" json:"account_id"`
+	AccountExternalID uuid.UUID `db:"account_external_id" json:"account_external_id"`
+	CreatedAt         time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at" json:"updated_at"`
 }
 
-// NewDocument cria um novo documento
-func NewDocument(userID int64, documentType, filename, contentType, fileContent string, categories []string) (*Document, error) {
+// NewDocument cria um novo documento. O conteúdo, gravado previamente no
+// BlobStore, é associado com AttachContent.
+func NewDocument(userID int64, documentType, filename, contentType string, categories []*Category) (*Document, error) {
 	if userID <= 0 {
 		return nil, ErrInvalidDocumentUserID
 	}
@@ -48,12 +66,9 @@ func NewDocument(userID int64, documentType, filename, contentType, fileContent
 	if filename == "" {
 		return nil, ErrInvalidDocumentFilename
 	}
-	if fileContent == "" {
-		return nil, ErrInvalidDocumentContent
-	}
 
 	if categories == nil {
-		categories = []string{}
+		categories = []*Category{}
 	}
 
 	now := time.Now()
@@ -63,7 +78,6 @@ func NewDocument(userID int64, documentType, filename, contentType, fileContent
 		DocumentType: documentType,
 		Filename:     filename,
 		ContentType:  contentType,
-		FileContent:  fileContent,
 		Categories:   categories,
 		Status:       DocumentStatusPending,
 		CreatedAt:    now,
@@ -71,6 +85,22 @@ func NewDocument(userID int64, documentType, filename, contentType, fileContent
 	}, nil
 }
 
+// AttachContent associa ao documento o conteúdo gravado no BlobStore
+func (d *Document) AttachContent(storageKey string, size int64, sha256 string) error {
+	if storageKey == "" || size <= 0 {
+		return ErrInvalidDocumentContent
+	}
+	d.StorageKey = storageKey
+	d.Size = size
+	d.SHA256 = sha256
+	return nil
+}
+
+// DocumentStorageKey monta a chave do conteúdo de um documento no BlobStore
+func DocumentStorageKey(userID int64, externalID uuid.UUID) string {
+	return fmt.Sprintf("documents/%d/%s", userID, externalID)
+}
+
 // Validate valida os dados do documento
 func (d *Document) Validate() error {
 	if d.UserID <= 0 {
@@ -82,7 +112,7 @@ func (d *Document) Validate() error {
 	if d.Filename == "" {
 		return ErrInvalidDocumentFilename
 	}
-	if d.FileContent == "" {
+	if d.StorageKey == "" || d.Size <= 0 {
 		return ErrInvalidDocumentContent
 	}
 	return nil
@@ -95,7 +125,16 @@ func (d *Document) UpdateStatus(status DocumentStatus) {
 }
 
 // UpdateCategories atualiza as categorias do documento
-func (d *Document) UpdateCategories(categories []string) {
+func (d *Document) UpdateCategories(categories []*Category) {
 	d.Categories = categories
 	d.UpdatedAt = time.Now()
 }
+
+// CategoryNames retorna os nomes das categorias do documento
+func (d *Document) CategoryNames() []string {
+	names := make([]string, len(d.Categories))
+	for i, category := range d.Categories {
+		names[i] = category.Name
+	}
+	return names
+}
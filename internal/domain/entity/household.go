@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidHouseholdName       = errors.New("Nome da família inválido")
+	ErrInvalidHouseholdRole       = errors.New("Papel na família inválido")
+	ErrInvalidInvitationEmail     = errors.New("Email do convite inválido")
+	ErrHouseholdInvitationClosed  = errors.New("O convite já foi respondido, revogado ou expirou")
+	ErrInvalidHouseholdMemberUser = errors.New("ID de usuário inválido")
+)
+
+// HouseholdRole define o que o membro pode fazer com os dados da família
+type HouseholdRole string
+
+const (
+	HouseholdRoleOwner  HouseholdRole = "owner"  // Administra a família, os membros e os convites
+	HouseholdRoleMember HouseholdRole = "member" // Envia e exclui documentos da família
+	HouseholdRoleViewer HouseholdRole = "viewer" // Apenas consulta os dados da família
+)
+
+// IsValid indica se o papel é um dos papéis conhecidos
+func (r HouseholdRole) IsValid() bool {
+	switch r {
+	case HouseholdRoleOwner, HouseholdRoleMember, HouseholdRoleViewer:
+		return true
+	}
+	return false
+}
+
+// CanWrite indica se o papel permite enviar e excluir documentos da família
+func (r HouseholdRole) CanWrite() bool {
+	return r == HouseholdRoleOwner || r == HouseholdRoleMember
+}
+
+// HouseholdInvitationTTL é a validade de um convite
+const HouseholdInvitationTTL = 7 * 24 * time.Hour
+
+// Household agrupa usuários que compartilham documentos e transações
+type Household struct {
+	ID         int64     `db:"id" json:"id"`
+	ExternalID uuid.UUID `db:"external_id" json:"external_id"`
+	Name       string    `db:"name" json:"name"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// NewHousehold cria uma família com validações
+func NewHousehold(name string) (*Household, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, ErrInvalidHouseholdName
+	}
+
+	now := time.Now()
+	return &Household{
+		ExternalID: uuid.New(),
+		Name:       name,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// HouseholdMember relaciona um usuário a uma família; os dados do usuário são
+// preenchidos nas consultas, para exibição
+type HouseholdMember struct {
+	HouseholdID    int64         `db:"household_id" json:"household_id"`
+	UserID         int64         `db:"user_id" json:"user_id"`
+	UserExternalID uuid.UUID     `db:"user_external_id" json:"user_external_id"`
+	UserName       string        `db:"user_name" json:"user_name"`
+	UserEmail      string        `db:"user_email" json:"user_email"`
+	Role           HouseholdRole `db:"role" json:"role"`
+	CreatedAt      time.Time     `db:"created_at" json:"created_at"`
+}
+
+// NewHouseholdMember cria a participação do usuário na família com o papel informado
+func NewHouseholdMember(householdID, userID int64, role HouseholdRole) (*HouseholdMember, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidHouseholdMemberUser
+	}
+	if !role.IsValid() {
+		return nil, ErrInvalidHouseholdRole
+	}
+
+	return &HouseholdMember{
+		HouseholdID: householdID,
+		UserID:      userID,
+		Role:        role,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+type HouseholdInvitationStatus string
+
+const (
+	HouseholdInvitationPending  HouseholdInvitationStatus = "pending"
+	HouseholdInvitationAccepted HouseholdInvitationStatus = "accepted"
+	HouseholdInvitationDeclined HouseholdInvitationStatus = "declined"
+	HouseholdInvitationRevoked  HouseholdInvitationStatus = "revoked"
+)
+
+// HouseholdInvitation convida o usuário com o email informado a participar da
+// família; o convite pode ser enviado antes de o convidado ter uma conta
+type HouseholdInvitation struct {
+	ID                  int64                     `db:"id" json:"id"`
+	ExternalID          uuid.UUID                 `db:"external_id" json:"external_id"`
+	HouseholdID         int64                     `db:"household_id" json:"household_id"`
+	HouseholdExternalID uuid.UUID                 `db:"household_external_id" json:"household_external_id"`
+	HouseholdName       string                    `db:"household_name" json:"household_name"`
+	Email               string                    `db:"email" json:"email"`
+	Role                HouseholdRole             `db:"role" json:"role"`
+	InvitedByID         int64                     `db:"invited_by" json:"invited_by"`
+	Status              HouseholdInvitationStatus `db:"status" json:"status"`
+	ExpiresAt           time.Time                 `db:"expires_at" json:"expires_at"`
+	RespondedAt         *time.Time                `db:"responded_at" json:"responded_at"`
+	CreatedAt           time.Time                 `db:"created_at" json:"created_at"`
+}
+
+// NewHouseholdInvitation cria um convite pendente para a família
+func NewHouseholdInvitation(household *Household, email string, role HouseholdRole, invitedByID int64) (*HouseholdInvitation, error) {
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil || address.Name != "" {
+		return nil, ErrInvalidInvitationEmail
+	}
+	if !role.IsValid() {
+		return nil, ErrInvalidHouseholdRole
+	}
+
+	now := time.Now()
+	return &HouseholdInvitation{
+		ExternalID:          uuid.New(),
+		HouseholdID:         household.ID,
+		HouseholdExternalID: household.ExternalID,
+		HouseholdName:       household.Name,
+		Email:               strings.ToLower(address.Address),
+		Role:                role,
+		InvitedByID:         invitedByID,
+		Status:              HouseholdInvitationPending,
+		ExpiresAt:           now.Add(HouseholdInvitationTTL),
+		CreatedAt:           now,
+	}, nil
+}
+
+// IsOpen indica se o convite ainda pode ser aceito ou recusado
+func (i *HouseholdInvitation) IsOpen(now time.Time) bool {
+	return i.Status == HouseholdInvitationPending && now.Before(i.ExpiresAt)
+}
+
+// IsFor indica se o convite foi enviado para o email informado
+func (i *HouseholdInvitation) IsFor(email string) bool {
+	return strings.EqualFold(i.Email, strings.TrimSpace(email))
+}
+
+// Respond registra a resposta ao convite (aceito, recusado ou revogado)
+func (i *HouseholdInvitation) Respond(status HouseholdInvitationStatus) error {
+	now := time.Now()
+	if !i.IsOpen(now) {
+		return ErrHouseholdInvitationClosed
+	}
+	i.Status = status
+	i.RespondedAt = &now
+	return nil
+}
@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"finance-assistant/internal/pkg/csvimport"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidImportProfileName   = errors.New("Nome do perfil de importação inválido")
+	ErrInvalidImportProfileUserID = errors.New("ID de usuário inválido")
+)
+
+// ImportProfile descreve o layout de um arquivo CSV de extrato de um banco específico
+type ImportProfile struct {
+	ID               int64             `db:"id" json:"id"`
+	ExternalID       uuid.UUID         `db:"external_id" json:"external_id"`
+	UserID           int64             `db:"user_id" json:"user_id"`
+	Name             string            `db:"name" json:"name"`
+	Delimiter        string            `db:"delimiter" json:"delimiter"`
+	Encoding         string            `db:"encoding" json:"encoding"`
+	DateFormat       string            `db:"date_format" json:"date_format"`
+	DecimalSeparator string            `db:"decimal_separator" json:"decimal_separator"`
+	HasHeader        bool              `db:"has_header" json:"has_header"`
+	SkipRows         int               `db:"skip_rows" json:"skip_rows"`
+	Columns          csvimport.Columns `db:"columns" json:"columns"`
+	Currency         string            `db:"currency" json:"currency"`
+	CreatedAt        time.Time         `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time         `db:"updated_at" json:"updated_at"`
+}
+
+// NewImportProfile cria um novo perfil de importação a partir de um layout CSV
+func NewImportProfile(userID int64, name string, layout *csvimport.Layout, currency string) (*ImportProfile, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidImportProfileUserID
+	}
+
+	now := time.Now()
+	profile := &ImportProfile{
+		ExternalID: uuid.New(),
+		UserID:     userID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	profile.apply(name, layout, currency)
+
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// Validate valida os dados do perfil
+func (p *ImportProfile) Validate() error {
+	if p.UserID <= 0 {
+		return ErrInvalidImportProfileUserID
+	}
+	if strings.TrimSpace(p.Name) == "" {
+		return ErrInvalidImportProfileName
+	}
+	if !IsValidCurrency(p.Currency) {
+		return ErrInvalidTransactionCurrency
+	}
+	return p.Layout().Validate()
+}
+
+// Update atualiza o nome e o layout do perfil
+func (p *ImportProfile) Update(name string, layout *csvimport.Layout, currency string) error {
+	p.apply(name, layout, currency)
+	p.UpdatedAt = time.Now()
+	return p.Validate()
+}
+
+// Layout converte o perfil para o layout usado pelo leitor de CSV
+func (p *ImportProfile) Layout() *csvimport.Layout {
+	layout := &csvimport.Layout{
+		Encoding:   p.Encoding,
+		DateFormat: p.DateFormat,
+		HasHeader:  p.HasHeader,
+		SkipRows:   p.SkipRows,
+		Columns:    p.Columns,
+	}
+	if delimiter, size := utf8.DecodeRuneInString(p.Delimiter); size == len(p.Delimiter) {
+		layout.Delimiter = delimiter
+	}
+	if len(p.DecimalSeparator) == 1 {
+		layout.DecimalSeparator = p.DecimalSeparator[0]
+	}
+	return layout
+}
+
+func (p *ImportProfile) apply(name string, layout *csvimport.Layout, currency string) {
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+
+	p.Name = strings.TrimSpace(name)
+	p.Delimiter = string(layout.Delimiter)
+	p.Encoding = strings.ToLower(layout.Encoding)
+	p.DateFormat = strings.ToLower(layout.DateFormat)
+	p.DecimalSeparator = string(layout.DecimalSeparator)
+	p.HasHeader = layout.HasHeader
+	p.SkipRows = layout.SkipRows
+	p.Columns = layout.Columns
+	p.Currency = strings.ToUpper(currency)
+}
@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"finance-assistant/internal/pkg/merchant"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidInstallmentPlan = errors.New("Compra parcelada inválida")
+)
+
+// InstallmentPlan agrupa as parcelas de uma compra parcelada no cartão. As
+// parcelas de uma mesma compra têm a mesma conta de origem, o mesmo nome
+// normalizado, o mesmo valor e o mesmo número de parcelas.
+type InstallmentPlan struct {
+	ID                int64     `db:"id" json:"id"`
+	ExternalID        uuid.UUID `db:"external_id" json:"external_id"`
+	UserID            int64     `db:"user_id" json:"user_id"`
+	Description       string    `db:"description" json:"description"`         // Nome da compra, sem a parcela (ver merchant.Normalize)
+	DescriptionKey    string    `db:"description_key" json:"description_key"` // Nome normalizado para comparação (ver merchant.Key)
+	SourceAccount     string    `db:"source_account" json:"source_account"`
+	Currency          string    `db:"currency" json:"currency"`
+	InstallmentAmount int64     `db:"installment_amount" json:"installment_amount"` // Valor de cada parcela em centavos, positivo
+	Installments      int       `db:"installments" json:"installments"`             // Número total de parcelas
+	CreatedAt         time.Time `db:"created_at" json:"created_at"`
+
+	// Última parcela importada, calculada a partir das transações
+	LastInstallment     int       `db:"last_installment" json:"last_installment"`
+	LastInstallmentDate time.Time `db:"last_installment_date" json:"last_installment_date"`
+}
+
+// ProjectedInstallment é uma parcela futura prevista de uma compra parcelada
+type ProjectedInstallment struct {
+	Number  int
+	DueDate time.Time
+	Amount  int64
+}
+
+// NewInstallmentPlan cria a compra parcelada de uma transação que é a parcela
+// number de total
+func NewInstallmentPlan(transaction *Transaction, number, total int) (*InstallmentPlan, error) {
+	description := merchant.Normalize(transaction.Description)
+	key := merchant.Key(description)
+	if key == "" || !transaction.IsDebit() || number < 1 || number > total {
+		return nil, ErrInvalidInstallmentPlan
+	}
+
+	return &InstallmentPlan{
+		ExternalID:          uuid.New(),
+		UserID:              transaction.UserID,
+		Description:         description,
+		DescriptionKey:      key,
+		SourceAccount:       transaction.SourceAccount,
+		Currency:            transaction.Currency,
+		InstallmentAmount:   -transaction.Amount,
+		Installments:        total,
+		CreatedAt:           time.Now(),
+		LastInstallment:     number,
+		LastInstallmentDate: transaction.Date,
+	}, nil
+}
+
+// RemainingInstallments retorna quantas parcelas ainda não foram importadas
+func (p *InstallmentPlan) RemainingInstallments() int {
+	return p.Installments - p.LastInstallment
+}
+
+// OutstandingBalance retorna o valor das parcelas restantes, em centavos
+func (p *InstallmentPlan) OutstandingBalance() int64 {
+	return int64(p.RemainingInstallments()) * p.InstallmentAmount
+}
+
+// IsFinished indica se a última parcela já foi importada
+func (p *InstallmentPlan) IsFinished() bool {
+	return p.RemainingInstallments() <= 0
+}
+
+// EndDate retorna a data prevista da última parcela, considerando uma parcela
+// por mês a partir da última importada
+func (p *InstallmentPlan) EndDate() time.Time {
+	return addMonths(p.LastInstallmentDate, p.RemainingInstallments())
+}
+
+// ProjectedInstallments prevê as parcelas restantes, uma por mês
+func (p *InstallmentPlan) ProjectedInstallments() []ProjectedInstallment {
+	projected := make([]ProjectedInstallment, 0, max(p.RemainingInstallments(), 0))
+	for i := 1; i <= p.RemainingInstallments(); i++ {
+		projected = append(projected, ProjectedInstallment{
+			Number:  p.LastInstallment + i,
+			DueDate: addMonths(p.LastInstallmentDate, i),
+			Amount:  p.InstallmentAmount,
+		})
+	}
+	return projected
+}
+
+// addMonths soma meses à data mantendo o dia, limitado ao último dia do mês
+// (31/01 mais um mês é 28/02 ou 29/02)
+func addMonths(date time.Time, months int) time.Time {
+	first := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, date.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(date.Day(), lastDay)-1)
+}
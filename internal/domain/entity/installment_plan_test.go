@@ -0,0 +1,81 @@
+package entity
+
+import "testing"
+
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		date   string
+		months int
+		want   string
+	}{
+		{date: "2023-01-31", months: 1, want: "2023-02-28"},
+		{date: "2024-01-31", months: 1, want: "2024-02-29"},
+		{date: "2023-01-31", months: 2, want: "2023-03-31"},
+		{date: "2023-03-31", months: 1, want: "2023-04-30"},
+		{date: "2023-01-15", months: 1, want: "2023-02-15"},
+		{date: "2023-11-30", months: 3, want: "2024-02-29"},
+		{date: "2023-12-10", months: 1, want: "2024-01-10"},
+		{date: "2023-01-31", months: 12, want: "2024-01-31"},
+		{date: "2024-02-29", months: 12, want: "2025-02-28"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			if got := addMonths(testDate(tt.date), tt.months); !got.Equal(testDate(tt.want)) {
+				t.Errorf("addMonths(%s, %d) = %s, esperado %s", tt.date, tt.months, got.Format("2006-01-02"), tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectedInstallments(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    InstallmentPlan
+		want    []string // Datas previstas das parcelas restantes
+		wantEnd string
+	}{
+		{
+			name:    "virada do fim do mês",
+			plan:    InstallmentPlan{InstallmentAmount: 10000, Installments: 4, LastInstallment: 1, LastInstallmentDate: testDate("2023-01-31")},
+			want:    []string{"2023-02-28", "2023-03-31", "2023-04-30"},
+			wantEnd: "2023-04-30",
+		},
+		{
+			name:    "virada do ano",
+			plan:    InstallmentPlan{InstallmentAmount: 10000, Installments: 12, LastInstallment: 10, LastInstallmentDate: testDate("2023-11-15")},
+			want:    []string{"2023-12-15", "2024-01-15"},
+			wantEnd: "2024-01-15",
+		},
+		{
+			name:    "plano quitado",
+			plan:    InstallmentPlan{InstallmentAmount: 10000, Installments: 3, LastInstallment: 3, LastInstallmentDate: testDate("2023-05-10")},
+			wantEnd: "2023-05-10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projected := tt.plan.ProjectedInstallments()
+			if len(projected) != len(tt.want) {
+				t.Fatalf("ProjectedInstallments() retornou %d parcelas, esperado %d", len(projected), len(tt.want))
+			}
+			for i, want := range tt.want {
+				got := projected[i]
+				if got.Number != tt.plan.LastInstallment+i+1 || !got.DueDate.Equal(testDate(want)) || got.Amount != tt.plan.InstallmentAmount {
+					t.Errorf("parcela %d = {%d %s %d}, esperado {%d %s %d}", i, got.Number, got.DueDate.Format("2006-01-02"), got.Amount,
+						tt.plan.LastInstallment+i+1, want, tt.plan.InstallmentAmount)
+				}
+			}
+			if end := tt.plan.EndDate(); !end.Equal(testDate(tt.wantEnd)) {
+				t.Errorf("EndDate() = %s, esperado %s", end.Format("2006-01-02"), tt.wantEnd)
+			}
+			if balance := tt.plan.OutstandingBalance(); balance != int64(len(tt.want))*tt.plan.InstallmentAmount {
+				t.Errorf("OutstandingBalance() = %d, esperado %d", balance, int64(len(tt.want))*tt.plan.InstallmentAmount)
+			}
+			if finished := tt.plan.IsFinished(); finished != (len(tt.want) == 0) {
+				t.Errorf("IsFinished() = %v, esperado %v", finished, len(tt.want) == 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"finance-assistant/internal/pkg/merchant"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidMerchantName = errors.New("Nome do estabelecimento inválido")
+)
+
+// Merchant é um estabelecimento do usuário. Os apelidos são os nomes
+// normalizados (ver merchant.Key) que o identificam nas descrições dos extratos.
+type Merchant struct {
+	ID         int64     `db:"id" json:"id"`
+	ExternalID uuid.UUID `db:"external_id" json:"external_id"`
+	UserID     int64     `db:"user_id" json:"user_id"`
+	Name       string    `db:"name" json:"name"`
+	Aliases    []string  `db:"-" json:"aliases"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// MerchantSpending resume as transações de um estabelecimento em uma moeda
+type MerchantSpending struct {
+	MerchantID         int64     `db:"merchant_id" json:"merchant_id"`
+	MerchantExternalID uuid.UUID `db:"merchant_external_id" json:"merchant_external_id"`
+	Name               string    `db:"name" json:"name"`
+	Currency           string    `db:"currency" json:"currency"`
+	Transactions       int       `db:"transactions" json:"transactions"`
+	Spent              int64     `db:"spent" json:"spent"`       // Soma dos débitos em centavos, positiva
+	Received           int64     `db:"received" json:"received"` // Soma dos créditos em centavos
+}
+
+// MerchantDailySpending resume as transações de um estabelecimento em uma
+// moeda em um dia, para a conversão pela cotação do dia
+type MerchantDailySpending struct {
+	MerchantSpending
+	Date time.Time `db:"date" json:"date"`
+}
+
+// NewMerchant cria um estabelecimento do usuário com o nome já normalizado
+// (ver merchant.Normalize), que também é o seu primeiro apelido
+func NewMerchant(userID int64, name string) (*Merchant, error) {
+	name = strings.TrimSpace(name)
+	alias := merchant.Key(name)
+	if alias == "" || utf8.RuneCountInString(name) > 100 {
+		return nil, ErrInvalidMerchantName
+	}
+
+	now := time.Now()
+	return &Merchant{
+		ExternalID: uuid.New(),
+		UserID:     userID,
+		Name:       name,
+		Aliases:    []string{alias},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// Absorb incorpora os apelidos de outros estabelecimentos, usado ao mesclá-los
+func (m *Merchant) Absorb(others []*Merchant) {
+	for _, other := range others {
+		m.Aliases = append(m.Aliases, other.Aliases...)
+	}
+	slices.Sort(m.Aliases)
+	m.Aliases = slices.Compact(m.Aliases)
+	m.UpdatedAt = time.Now()
+}
@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+
+	"finance-assistant/internal/pkg/amount"
+)
+
+var (
+	ErrInvalidCurrency  = errors.New("Moeda inválida, use um código ISO 4217 (ex: BRL, USD, EUR)")
+	ErrCurrencyMismatch = errors.New("Não é possível somar valores em moedas diferentes")
+)
+
+// Money é um valor monetário em unidades mínimas da moeda (centavos para a
+// maioria delas, ver MinorUnits) e o código ISO 4217 da moeda
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// NewMoney cria um valor na moeda informada, normalizada para maiúsculas
+func NewMoney(amount int64, currency string) (Money, error) {
+	currency, err := ParseCurrency(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// ParseCurrency normaliza um código de moeda e verifica que ele tem o formato
+// ISO 4217, três letras
+func ParseCurrency(currency string) (string, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if !IsValidCurrency(currency) {
+		return "", ErrInvalidCurrency
+	}
+	return currency, nil
+}
+
+// IsValidCurrency indica se o código tem o formato ISO 4217, três letras maiúsculas
+func IsValidCurrency(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+	for _, r := range currency {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// MinorUnits retorna as casas decimais da moeda segundo a ISO 4217, ou seja,
+// quantas unidades mínimas formam uma unidade (2 para BRL, 0 para JPY, 3 para KWD)
+func MinorUnits(currency string) int {
+	return amount.MinorUnits(currency)
+}
+
+// Add soma dois valores na mesma moeda
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidOutboxEventType    = errors.New("Tipo de evento inválido")
+	ErrInvalidOutboxEventPayload = errors.New("Conteúdo do evento inválido")
+)
+
+// Tipos de evento publicados pela aplicação
+const (
+	OutboxEventDocumentCreated = "document.created"
+)
+
+// OutboxEvent é uma mensagem gravada na mesma transação da alteração que a
+// originou e publicada no broker posteriormente pelo relay do outbox
+type OutboxEvent struct {
+	ID            int64      `db:"id" json:"id"`
+	AggregateType string     `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   uuid.UUID  `db:"aggregate_id" json:"aggregate_id"` // Também usado como chave da mensagem
+	EventType     string     `db:"event_type" json:"event_type"`
+	Payload       []byte     `db:"payload" json:"payload"`
+	Attempts      int        `db:"attempts" json:"attempts"`
+	LastError     string     `db:"last_error" json:"last_error"`
+	NextAttemptAt time.Time  `db:"next_attempt_at" json:"next_attempt_at"`
+	PublishedAt   *time.Time `db:"published_at" json:"published_at"`
+	CreatedAt     time.Time  `db:"created_at" json:"created_at"`
+}
+
+// NewOutboxEvent cria um evento pendente de publicação
+func NewOutboxEvent(aggregateType string, aggregateID uuid.UUID, eventType string, payload []byte) (*OutboxEvent, error) {
+	if eventType == "" {
+		return nil, ErrInvalidOutboxEventType
+	}
+	if len(payload) == 0 {
+		return nil, ErrInvalidOutboxEventPayload
+	}
+
+	now := time.Now()
+	return &OutboxEvent{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       payload,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// RetryDelay calcula a espera antes da próxima tentativa de publicação, com
+// crescimento exponencial a partir de 1 segundo limitado a maxDelay
+func (e *OutboxEvent) RetryDelay(maxDelay time.Duration) time.Duration {
+	delay := time.Second
+	for i := 1; i < e.Attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Frequency é a periodicidade de uma série de transações recorrentes
+type Frequency string
+
+const (
+	FrequencyWeekly  Frequency = "weekly"
+	FrequencyMonthly Frequency = "monthly"
+	FrequencyYearly  Frequency = "yearly"
+)
+
+// Period retorna o intervalo típico da periodicidade, em dias
+func (f Frequency) Period() int {
+	switch f {
+	case FrequencyWeekly:
+		return 7
+	case FrequencyYearly:
+		return 365
+	default:
+		return 30
+	}
+}
+
+// Tolerance retorna quantos dias uma ocorrência pode se afastar da data esperada
+func (f Frequency) Tolerance() int {
+	switch f {
+	case FrequencyWeekly:
+		return 2
+	case FrequencyYearly:
+		return 15
+	default:
+		return 5
+	}
+}
+
+// Next retorna a data esperada da ocorrência seguinte a date
+func (f Frequency) Next(date time.Time) time.Time {
+	switch f {
+	case FrequencyWeekly:
+		return date.AddDate(0, 0, 7)
+	case FrequencyYearly:
+		return addMonths(date, 12)
+	default:
+		return addMonths(date, 1)
+	}
+}
+
+// RecurringSeries é uma série de débitos periódicos de um mesmo estabelecimento,
+// como uma assinatura, identificada no histórico de transações do usuário
+type RecurringSeries struct {
+	ID                 int64     `db:"id" json:"id"`
+	ExternalID         uuid.UUID `db:"external_id" json:"external_id"`
+	UserID             int64     `db:"user_id" json:"user_id"`
+	SeriesKey          string    `db:"series_key" json:"series_key"`   // Estabelecimento ou nome normalizado que agrupa as ocorrências
+	MerchantID         int64     `db:"merchant_id" json:"merchant_id"` // 0 quando o estabelecimento não foi reconhecido
+	MerchantExternalID uuid.UUID `db:"merchant_external_id" json:"merchant_external_id"`
+	Description        string    `db:"description" json:"description"`
+	Currency           string    `db:"currency" json:"currency"`
+	Frequency          Frequency `db:"frequency" json:"frequency"`
+	Amount             int64     `db:"amount" json:"amount"`                   // Valor esperado em centavos, positivo: o da última ocorrência
+	PreviousAmount     int64     `db:"previous_amount" json:"previous_amount"` // Valor da ocorrência anterior à última
+	Occurrences        int       `db:"occurrences" json:"occurrences"`
+	FirstDate          time.Time `db:"first_date" json:"first_date"`
+	LastDate           time.Time `db:"last_date" json:"last_date"`
+	NextDate           time.Time `db:"next_date" json:"next_date"` // Data esperada da próxima ocorrência
+	CreatedAt          time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// NewRecurringSeries cria a série de débitos periódicos agrupados por key,
+// a partir da primeira transação encontrada
+func NewRecurringSeries(transaction *Transaction, key, description string) *RecurringSeries {
+	now := time.Now()
+	return &RecurringSeries{
+		ExternalID:         uuid.New(),
+		UserID:             transaction.UserID,
+		SeriesKey:          key,
+		MerchantID:         transaction.MerchantID,
+		MerchantExternalID: transaction.MerchantExternalID,
+		Description:        description,
+		Currency:           transaction.Currency,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+}
+
+// PriceChanged indica se o valor da última ocorrência difere do da anterior
+func (s *RecurringSeries) PriceChanged() bool {
+	return s.PreviousAmount != 0 && s.PreviousAmount != s.Amount
+}
+
+// IsMissed indica se a próxima ocorrência já deveria ter acontecido em now
+func (s *RecurringSeries) IsMissed(now time.Time) bool {
+	return now.After(s.NextDate.AddDate(0, 0, s.Frequency.Tolerance()))
+}
+
+// MonthlyCost retorna o custo mensal equivalente da série, em centavos
+func (s *RecurringSeries) MonthlyCost() int64 {
+	switch s.Frequency {
+	case FrequencyWeekly:
+		return s.Amount * 52 / 12
+	case FrequencyYearly:
+		return s.Amount / 12
+	default:
+		return s.Amount
+	}
+}
@@ -0,0 +1,166 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTransactionUserID      = errors.New("ID de usuário inválido")
+	ErrInvalidTransactionDocumentID  = errors.New("ID de documento inválido")
+	ErrInvalidTransactionDate        = errors.New("Data da transação inválida")
+	ErrInvalidTransactionCurrency    = errors.New("Moeda da transação inválida")
+	ErrInvalidTransactionDescription = errors.New("Descrição da transação inválida")
+)
+
+// DefaultCurrency é a moeda assumida quando o documento não informa uma
+const DefaultCurrency = "BRL"
+
+// Origens da categoria de uma transação
+const (
+	CategorySourceFile       = "file"       // Informada no arquivo importado
+	CategorySourceRule       = "rule"       // Atribuída por uma regra de categorização
+	CategorySourceSuggestion = "suggestion" // Sugerida pelo classificador
+	CategorySourceUser       = "user"       // Corrigida pelo usuário
+)
+
+type Transaction struct {
+	ID                        int64     `db:"id" json:"id"`
+	ExternalID                uuid.UUID `db:"external_id" json:"external_id"`
+	UserID                    int64     `db:"user_id" json:"user_id"`
+	DocumentID                int64     `db:"document_id" json:"document_id"`
+	DocumentExternalID        uuid.UUID `db:"document_external_id" json:"document_external_id"`
+	HouseholdID               int64     `db:"household_id" json:"household_id"` // Família do documento de origem (0 quando pessoal)
+	AccountID                 int64     `db:"account_id" json:"account_id"`     // Conta do usuário (0 quando não identificada)
+	AccountExternalID         uuid.UUID `db:"account_external_id" json:"account_external_id"`
+	Date                      time.Time `db:"transaction_date" json:"date"`
+	Amount                    int64     `db:"amount" json:"amount"` // Valor em unidades mínimas da moeda (centavos); negativo para débitos
+	Currency                  string    `db:"currency" json:"currency"`
+	Description               string    `db:"description" json:"description"`
+	Counterparty              string    `db:"counterparty" json:"counterparty"`
+	CategoryID                int64     `db:"category_id" json:"category_id"` // 0 quando sem categoria
+	CategoryExternalID        uuid.UUID `db:"category_external_id" json:"category_external_id"`
+	Category                  string    `db:"category" json:"category"`               // Nome da categoria; na extração, o nome informado no arquivo
+	CategorySource            string    `db:"category_source" json:"category_source"` // Origem da categoria (CategorySource*)
+	MerchantID                int64     `db:"merchant_id" json:"merchant_id"`         // 0 quando o estabelecimento não foi reconhecido
+	MerchantExternalID        uuid.UUID `db:"merchant_external_id" json:"merchant_external_id"`
+	Merchant                  string    `db:"merchant" json:"merchant"`                       // Nome do estabelecimento
+	InstallmentPlanID         int64     `db:"installment_plan_id" json:"installment_plan_id"` // 0 quando não é parcela de uma compra parcelada
+	InstallmentPlanExternalID uuid.UUID `db:"installment_plan_external_id" json:"installment_plan_external_id"`
+	InstallmentNumber         int       `db:"installment_number" json:"installment_number"`
+	Installments              int       `db:"installments" json:"installments"`     // Número total de parcelas da compra
+	FITID                     string    `db:"fitid" json:"fitid"`                   // Identificador da transação na instituição (OFX FITID)
+	SourceAccount             string    `db:"source_account" json:"source_account"` // Conta de origem conforme informada no extrato
+	TransferID                int64     `db:"transfer_id" json:"transfer_id"`       // Transferência não rejeitada entre contas do usuário (0 quando não é)
+	TransferExternalID        uuid.UUID `db:"transfer_external_id" json:"transfer_external_id"`
+	Fingerprint               string    `db:"fingerprint" json:"fingerprint"` // Identifica a transação em extratos sobrepostos (ver dedup.AssignFingerprints)
+	CreatedAt                 time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt                 time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// NewTransaction cria uma nova transação extraída de um documento
+func NewTransaction(document *Document, date time.Time, amount int64, currency, description string) (*Transaction, error) {
+	if document == nil || document.ID <= 0 {
+		return nil, ErrInvalidTransactionDocumentID
+	}
+
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+
+	now := time.Now()
+	transaction := &Transaction{
+		ExternalID:         uuid.New(),
+		UserID:             document.UserID,
+		DocumentID:         document.ID,
+		DocumentExternalID: document.ExternalID,
+		HouseholdID:        document.HouseholdID,
+		AccountID:          document.AccountID,
+		AccountExternalID:  document.AccountExternalID,
+		Date:               time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+		Amount:             amount,
+		Currency:           strings.ToUpper(currency),
+		Description:        strings.TrimSpace(description),
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	if err := transaction.Validate(); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// Validate valida os dados da transação
+func (t *Transaction) Validate() error {
+	if t.UserID <= 0 {
+		return ErrInvalidTransactionUserID
+	}
+	if t.DocumentID <= 0 {
+		return ErrInvalidTransactionDocumentID
+	}
+	if t.Date.IsZero() {
+		return ErrInvalidTransactionDate
+	}
+	if !IsValidCurrency(t.Currency) {
+		return ErrInvalidTransactionCurrency
+	}
+	if t.Description == "" {
+		return ErrInvalidTransactionDescription
+	}
+	return nil
+}
+
+// Money retorna o valor da transação na sua moeda
+func (t *Transaction) Money() Money {
+	return Money{Amount: t.Amount, Currency: t.Currency}
+}
+
+// IsDebit indica se a transação representa uma saída de dinheiro
+func (t *Transaction) IsDebit() bool {
+	return t.Amount < 0
+}
+
+// UpdateCategory atualiza a categoria da transação e a sua origem
+func (t *Transaction) UpdateCategory(category *Category, source string) {
+	t.CategoryID = category.ID
+	t.CategoryExternalID = category.ExternalID
+	t.Category = category.Name
+	t.CategorySource = source
+	t.UpdatedAt = time.Now()
+}
+
+// UpdateMerchant associa a transação ao estabelecimento
+func (t *Transaction) UpdateMerchant(merchant *Merchant) {
+	t.MerchantID = merchant.ID
+	t.MerchantExternalID = merchant.ExternalID
+	t.Merchant = merchant.Name
+	t.UpdatedAt = time.Now()
+}
+
+// UpdateInstallment associa a transação à parcela number da compra parcelada
+func (t *Transaction) UpdateInstallment(plan *InstallmentPlan, number int) {
+	t.InstallmentPlanID = plan.ID
+	t.InstallmentPlanExternalID = plan.ExternalID
+	t.InstallmentNumber = number
+	t.Installments = plan.Installments
+	t.UpdatedAt = time.Now()
+}
+
+// MerchantDescription retorna o texto usado para reconhecer o estabelecimento:
+// a contraparte, quando informada, ou a descrição
+func (t *Transaction) MerchantDescription() string {
+	if t.Counterparty != "" {
+		return t.Counterparty
+	}
+	return t.Description
+}
+
+// IsCategoryConfirmed indica se a categoria foi informada pelo usuário, no
+// arquivo importado ou por correção, e não deduzida por regras ou sugestões
+func (t *Transaction) IsCategoryConfirmed() bool {
+	return t.CategoryID != 0 && (t.CategorySource == CategorySourceFile || t.CategorySource == CategorySourceUser)
+}
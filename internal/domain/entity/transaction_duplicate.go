@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDuplicateAlreadyReviewed = errors.New("A possível duplicata já foi revisada")
+)
+
+type DuplicateStatus string
+
+const (
+	DuplicateStatusPending   DuplicateStatus = "pending"   // Aguardando a revisão do usuário
+	DuplicateStatusDismissed DuplicateStatus = "dismissed" // O usuário indicou que são transações diferentes
+)
+
+// TransactionSource é um documento que informou a transação
+type TransactionSource struct {
+	DocumentID         int64     `db:"document_id" json:"document_id"`
+	DocumentExternalID uuid.UUID `db:"document_external_id" json:"document_external_id"`
+	Filename           string    `db:"filename" json:"filename"`
+	FITID              string    `db:"fitid" json:"fitid"` // Identificador da transação no documento, quando informado
+	CreatedAt          time.Time `db:"created_at" json:"created_at"`
+}
+
+// TransactionDuplicate é uma transação importada que pode ser a mesma que outra
+// já importada de um extrato de período sobreposto, com descrição ou data
+// diferentes demais para ser descartada automaticamente
+type TransactionDuplicate struct {
+	ID          int64           `json:"id"`
+	ExternalID  uuid.UUID       `json:"external_id"`
+	UserID      int64           `json:"user_id"`
+	Transaction *Transaction    `json:"transaction"`  // Importada por último
+	DuplicateOf *Transaction    `json:"duplicate_of"` // Importada antes; é a mantida quando as duas são unidas
+	Score       float64         `json:"score"`        // Semelhança entre as transações, entre 0 e 1
+	Status      DuplicateStatus `json:"status"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// NewTransactionDuplicate registra transaction como possível duplicata de duplicateOf
+func NewTransactionDuplicate(transaction, duplicateOf *Transaction, score float64) *TransactionDuplicate {
+	now := time.Now()
+	return &TransactionDuplicate{
+		ExternalID:  uuid.New(),
+		UserID:      transaction.UserID,
+		Transaction: transaction,
+		DuplicateOf: duplicateOf,
+		Score:       score,
+		Status:      DuplicateStatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Dismiss indica que as transações são diferentes e devem ser mantidas
+func (d *TransactionDuplicate) Dismiss() error {
+	if d.Status != DuplicateStatusPending {
+		return ErrDuplicateAlreadyReviewed
+	}
+	d.Status = DuplicateStatusDismissed
+	d.UpdatedAt = time.Now()
+	return nil
+}
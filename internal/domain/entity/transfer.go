@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTransferStatus       = errors.New("Status da transferência inválido, use suggested, confirmed ou rejected")
+	ErrInvalidTransferTransactions = errors.New("Uma transferência liga um débito e um crédito do mesmo usuário, de mesmo valor e moeda")
+	ErrInvalidTransferAccounts     = errors.New("As transações de uma transferência devem ser de contas diferentes")
+)
+
+type TransferStatus string
+
+const (
+	TransferStatusSuggested TransferStatus = "suggested" // Encontrada automaticamente, ainda não revisada
+	TransferStatusConfirmed TransferStatus = "confirmed" // Confirmada ou ligada manualmente pelo usuário
+	TransferStatusRejected  TransferStatus = "rejected"  // Rejeitada; o par não volta a ser sugerido
+)
+
+// IsValid verifica se o status é suportado
+func (s TransferStatus) IsValid() bool {
+	switch s {
+	case TransferStatusSuggested, TransferStatusConfirmed, TransferStatusRejected:
+		return true
+	}
+	return false
+}
+
+// TransferLeg é um dos lados de uma transferência
+type TransferLeg struct {
+	TransactionID         int64     `json:"transaction_id"`
+	TransactionExternalID uuid.UUID `json:"transaction_external_id"`
+	AccountExternalID     uuid.UUID `json:"account_external_id"` // Zero quando a conta não foi identificada
+	Date                  time.Time `json:"date"`
+	Description           string    `json:"description"`
+}
+
+// Transfer liga o débito em uma conta do usuário ao crédito correspondente em
+// outra, como o pagamento da fatura do cartão a partir da conta corrente. As
+// transações de transferências confirmadas não contam como gastos.
+type Transfer struct {
+	ID         int64          `json:"id"`
+	ExternalID uuid.UUID      `json:"external_id"`
+	UserID     int64          `json:"user_id"`
+	Outgoing   TransferLeg    `json:"outgoing"` // Débito na conta de origem
+	Incoming   TransferLeg    `json:"incoming"` // Crédito na conta de destino
+	Amount     int64          `json:"amount"`   // Valor transferido em centavos, positivo
+	Currency   string         `json:"currency"`
+	Status     TransferStatus `json:"status"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+// NewTransfer liga duas transações do usuário, em qualquer ordem, como uma
+// transferência entre suas contas
+func NewTransfer(a, b *Transaction, status TransferStatus) (*Transfer, error) {
+	outgoing, incoming := a, b
+	if outgoing.Amount > 0 {
+		outgoing, incoming = incoming, outgoing
+	}
+
+	if outgoing.UserID != incoming.UserID || outgoing.Amount >= 0 ||
+		outgoing.Amount != -incoming.Amount || outgoing.Currency != incoming.Currency {
+		return nil, ErrInvalidTransferTransactions
+	}
+	if SameAccount(outgoing, incoming) {
+		return nil, ErrInvalidTransferAccounts
+	}
+	if !status.IsValid() {
+		return nil, ErrInvalidTransferStatus
+	}
+
+	now := time.Now()
+	return &Transfer{
+		ExternalID: uuid.New(),
+		UserID:     outgoing.UserID,
+		Outgoing:   newTransferLeg(outgoing),
+		Incoming:   newTransferLeg(incoming),
+		Amount:     incoming.Amount,
+		Currency:   outgoing.Currency,
+		Status:     status,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// SameAccount indica se as transações são da mesma conta: pela conta
+// cadastrada quando ambas a têm, senão pela conta de origem do extrato e, na
+// falta dela, pelo documento importado
+func SameAccount(a, b *Transaction) bool {
+	switch {
+	case a.AccountID != 0 && b.AccountID != 0:
+		return a.AccountID == b.AccountID
+	case a.SourceAccount != "" && b.SourceAccount != "":
+		return a.SourceAccount == b.SourceAccount
+	default:
+		return a.DocumentID == b.DocumentID
+	}
+}
+
+// Links verifica se a transferência liga as duas transações informadas
+func (t *Transfer) Links(outgoingID, incomingID int64) bool {
+	return t.Outgoing.TransactionID == outgoingID && t.Incoming.TransactionID == incomingID
+}
+
+// IsActive indica se a transferência não foi rejeitada
+func (t *Transfer) IsActive() bool {
+	return t.Status != TransferStatusRejected
+}
+
+// UpdateStatus confirma ou rejeita a transferência
+func (t *Transfer) UpdateStatus(status TransferStatus) error {
+	if !status.IsValid() {
+		return ErrInvalidTransferStatus
+	}
+	t.Status = status
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+func newTransferLeg(transaction *Transaction) TransferLeg {
+	return TransferLeg{
+		TransactionID:         transaction.ID,
+		TransactionExternalID: transaction.ExternalID,
+		AccountExternalID:     transaction.AccountExternalID,
+		Date:                  transaction.Date,
+		Description:           transaction.Description,
+	}
+}
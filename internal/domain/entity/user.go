@@ -5,11 +5,38 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
 )
 
 var (
-	ErrInvalidUserName  = errors.New("nome de usuário inválido")
-	ErrInvalidUserEmail = errors.New("email de usuário inválido")
+	ErrInvalidUserName     = errors.New("nome de usuário inválido")
+	ErrInvalidUserEmail    = errors.New("email de usuário inválido")
+	ErrInvalidUserPassword = errors.New("a senha deve ter entre 8 e 72 caracteres")
+	ErrInvalidUserRole     = errors.New("papel de usuário inválido")
+)
+
+// UserRole define o que o usuário pode acessar além dos próprios recursos
+type UserRole string
+
+const (
+	RoleUser    UserRole = "user"    // Acessa apenas os próprios recursos
+	RoleSupport UserRole = "support" // Leitura dos metadados de todos os usuários, sem acesso ao conteúdo dos arquivos
+	RoleAdmin   UserRole = "admin"   // Acesso total, incluindo as funções de operação
+)
+
+// IsValid indica se o papel é um dos papéis conhecidos
+func (r UserRole) IsValid() bool {
+	switch r {
+	case RoleUser, RoleSupport, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+// Limites de tamanho da senha; o bcrypt considera apenas os primeiros 72 bytes
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
 )
 
 type User struct {
@@ -18,8 +45,11 @@ type User struct {
 	Name       string    `db:"name" json:"name"`
 	Email      string    `db:"email" json:"email"`
 	Phone      string    `db:"phone" json:"phone"`
-	CreatedAt  time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
+	Role       UserRole  `db:"role" json:"role"`
+	// Hash bcrypt da senha; vazio para usuários que ainda não definiram uma
+	PasswordHash string    `db:"password_hash" json:"-"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at" json:"updated_at"`
 }
 
 // NewUser cria um novo usuário com validações
@@ -36,6 +66,7 @@ func NewUser(name, email, phone string) (*User, error) {
 		Name:       name,
 		Email:      email,
 		Phone:      phone,
+		Role:       RoleUser,
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
 	}, nil
@@ -64,3 +95,37 @@ func (u *User) Update(name, email, phone string) error {
 	u.UpdatedAt = time.Now()
 	return u.Validate()
 }
+
+// SetPassword valida a senha e armazena o seu hash bcrypt
+func (u *User) SetPassword(password string) error {
+	if len(password) < MinPasswordLength || len(password) > MaxPasswordLength {
+		return ErrInvalidUserPassword
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	u.PasswordHash = string(hash)
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+// CheckPassword verifica a senha informada; usuários sem senha nunca são autenticados
+func (u *User) CheckPassword(password string) bool {
+	if u.PasswordHash == "" {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
+}
+
+// ChangeRole altera o papel do usuário
+func (u *User) ChangeRole(role UserRole) error {
+	if !role.IsValid() {
+		return ErrInvalidUserRole
+	}
+	u.Role = role
+	u.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,145 @@
+package extractor
+
+import (
+	"context"
+	"fmt"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"finance-assistant/internal/pkg/csvimport"
+)
+
+// CSVExtractor extrai transações de extratos CSV usando o perfil de importação
+// associado ao documento ou, na ausência dele, um layout detectado automaticamente
+type CSVExtractor struct {
+	profileRepo repository.ImportProfileRepository
+	accountRepo repository.AccountRepository
+}
+
+func NewCSVExtractor(profileRepo repository.ImportProfileRepository, accountRepo repository.AccountRepository) *CSVExtractor {
+	return &CSVExtractor{
+		profileRepo: profileRepo,
+		accountRepo: accountRepo,
+	}
+}
+
+func (e *CSVExtractor) Name() string {
+	return "csv"
+}
+
+func (e *CSVExtractor) Supports(documentType, contentType string) bool {
+	switch contentType {
+	case "text/csv", "application/csv":
+		return true
+	}
+	return false
+}
+
+func (e *CSVExtractor) Extract(ctx context.Context, document *entity.Document, content []byte) (*Result, error) {
+	layout, currency, profileName, err := e.resolveLayout(ctx, document, content)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := csvimport.Read(content, layout, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Metadata: map[string]string{"import_profile": profileName},
+	}
+	for _, row := range rows {
+		transaction, err := entity.NewTransaction(document, row.Date, row.Amount, currency, row.Description)
+		if err != nil {
+			return nil, fmt.Errorf("linha %d inválida: %w", row.Line, err)
+		}
+		transaction.Counterparty = row.Counterparty
+		transaction.Category = row.Category
+		result.Transactions = append(result.Transactions, transaction)
+	}
+	if statement := runningBalanceStatement(rows, currency); statement != nil {
+		result.Statements = append(result.Statements, statement)
+	}
+
+	return result, nil
+}
+
+// runningBalanceStatement monta o extrato do arquivo a partir da coluna de
+// saldo: o saldo inicial é o da primeira linha antes do seu lançamento e o
+// final é o da última, na ordem cronológica (bancos que listam do mais recente
+// para o mais antigo têm as linhas invertidas). Retorna nil quando a primeira
+// ou a última linha não informa o saldo.
+func runningBalanceStatement(rows []*csvimport.Row, currency string) *Statement {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	first, last := rows[0], rows[len(rows)-1]
+	if first.Date.After(last.Date) {
+		first, last = last, first
+	}
+	if first.Balance == nil || last.Balance == nil {
+		return nil
+	}
+
+	statement := &Statement{
+		Currency:          currency,
+		StartDate:         first.Date,
+		EndDate:           last.Date,
+		LedgerBalanceDate: last.Date,
+	}
+	opening := *first.Balance - first.Amount
+	closing := *last.Balance
+	statement.OpeningBalance = &opening
+	statement.LedgerBalance = &closing
+	for _, row := range rows {
+		if row.Date.Before(statement.StartDate) {
+			statement.StartDate = row.Date
+		}
+		if row.Date.After(statement.EndDate) {
+			statement.EndDate = row.Date
+		}
+	}
+	return statement
+}
+
+// resolveLayout carrega o perfil do documento ou detecta o layout pelo conteúdo.
+// A moeda é a do perfil; sem perfil, a da conta do documento ou DefaultCurrency.
+func (e *CSVExtractor) resolveLayout(ctx context.Context, document *entity.Document, content []byte) (*csvimport.Layout, string, string, error) {
+	if document.ImportProfileID != 0 {
+		profile, err := e.profileRepo.FindByID(ctx, document.ImportProfileID)
+		if err != nil {
+			return nil, "", "", err
+		}
+		if profile != nil {
+			return profile.Layout(), profile.Currency, profile.Name, nil
+		}
+	}
+
+	layout, err := csvimport.Detect(content)
+	if err != nil {
+		return nil, "", "", err
+	}
+	currency, err := e.accountCurrency(ctx, document)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return layout, currency, "detected", nil
+}
+
+// accountCurrency retorna a moeda da conta vinculada ao documento, ou
+// DefaultCurrency quando o documento não tem conta
+func (e *CSVExtractor) accountCurrency(ctx context.Context, document *entity.Document) (string, error) {
+	if document.AccountID == 0 {
+		return entity.DefaultCurrency, nil
+	}
+	account, err := e.accountRepo.FindByID(ctx, document.AccountID)
+	if err != nil {
+		return "", err
+	}
+	if account == nil || account.Currency == "" {
+		return entity.DefaultCurrency, nil
+	}
+	return account.Currency, nil
+}
@@ -0,0 +1,155 @@
+package extractor
+
+import (
+	"context"
+	"testing"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"finance-assistant/internal/pkg/csvimport"
+	"github.com/google/uuid"
+)
+
+// fakeProfileRepository e fakeAccountRepository respondem FindByID a partir de
+// um mapa; os demais métodos não são usados pelo CSVExtractor
+type fakeProfileRepository struct {
+	repository.ImportProfileRepository
+	profiles map[int64]*entity.ImportProfile
+}
+
+func (r *fakeProfileRepository) FindByID(ctx context.Context, id int64) (*entity.ImportProfile, error) {
+	return r.profiles[id], nil
+}
+
+type fakeAccountRepository struct {
+	repository.AccountRepository
+	accounts map[int64]*entity.Account
+}
+
+func (r *fakeAccountRepository) FindByID(ctx context.Context, id int64) (*entity.Account, error) {
+	return r.accounts[id], nil
+}
+
+func TestCSVExtractorCurrency(t *testing.T) {
+	content := []byte("Data;Descrição;Valor\n05/03/2024;MERCADO;-1.500\n06/03/2024;SALARIO;98.000\n")
+
+	layout, err := csvimport.Detect(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile, err := entity.NewImportProfile(7, "Banco em euro", layout, "EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile.ID = 3
+
+	extractor := NewCSVExtractor(
+		&fakeProfileRepository{profiles: map[int64]*entity.ImportProfile{3: profile}},
+		&fakeAccountRepository{accounts: map[int64]*entity.Account{
+			1: {ID: 1, Currency: "USD"},
+			2: {ID: 2, Currency: "JPY"},
+		}},
+	)
+
+	tests := []struct {
+		name      string
+		accountID int64
+		profileID int64
+		currency  string
+		amounts   []int64
+	}{
+		{name: "sem conta nem perfil", currency: "BRL", amounts: []int64{-150000, 9800000}},
+		{name: "moeda da conta", accountID: 1, currency: "USD", amounts: []int64{-150000, 9800000}},
+		{name: "conta em moeda sem casas decimais", accountID: 2, currency: "JPY", amounts: []int64{-1500, 98000}},
+		{name: "conta não encontrada", accountID: 9, currency: "BRL", amounts: []int64{-150000, 9800000}},
+		{name: "moeda do perfil prevalece", accountID: 2, profileID: 3, currency: "EUR", amounts: []int64{-150000, 9800000}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document := &entity.Document{ID: 1, ExternalID: uuid.New(), UserID: 7, AccountID: tt.accountID, ImportProfileID: tt.profileID}
+			result, err := extractor.Extract(context.Background(), document, content)
+			if err != nil {
+				t.Fatalf("Extract() erro = %v", err)
+			}
+			if len(result.Transactions) != len(tt.amounts) {
+				t.Fatalf("Extract() retornou %d transações, esperadas %d", len(result.Transactions), len(tt.amounts))
+			}
+			for i, transaction := range result.Transactions {
+				if transaction.Currency != tt.currency || transaction.Amount != tt.amounts[i] {
+					t.Errorf("transação %d = %d %s, esperado %d %s", i, transaction.Amount, transaction.Currency, tt.amounts[i], tt.currency)
+				}
+			}
+		})
+	}
+}
+
+func TestCSVExtractorRunningBalance(t *testing.T) {
+	extractor := NewCSVExtractor(&fakeProfileRepository{}, &fakeAccountRepository{})
+
+	tests := []struct {
+		name    string
+		content string
+		want    bool
+		start   string
+		end     string
+		opening int64
+		closing int64
+	}{
+		{
+			name:    "linhas em ordem cronológica",
+			content: "Data;Descrição;Valor;Saldo\n05/03/2024;MERCADO;-50,00;950,00\n10/03/2024;SALARIO;2.000,00;2.950,00\n20/03/2024;ALUGUEL;-1.200,00;1.750,00\n",
+			want:    true,
+			start:   "2024-03-05",
+			end:     "2024-03-20",
+			opening: 100000,
+			closing: 175000,
+		},
+		{
+			name:    "linhas do mais recente para o mais antigo",
+			content: "Data;Descrição;Valor;Saldo\n20/03/2024;ALUGUEL;-1.200,00;1.750,00\n10/03/2024;SALARIO;2.000,00;2.950,00\n05/03/2024;MERCADO;-50,00;950,00\n",
+			want:    true,
+			start:   "2024-03-05",
+			end:     "2024-03-20",
+			opening: 100000,
+			closing: 175000,
+		},
+		{
+			name:    "sem coluna de saldo",
+			content: "Data;Descrição;Valor\n05/03/2024;MERCADO;-50,00\n10/03/2024;SALARIO;2.000,00\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document := &entity.Document{ID: 1, ExternalID: uuid.New(), UserID: 7}
+			result, err := extractor.Extract(context.Background(), document, []byte(tt.content))
+			if err != nil {
+				t.Fatalf("Extract() erro = %v", err)
+			}
+			if !tt.want {
+				if len(result.Statements) != 0 {
+					t.Errorf("Extract() retornou %d extratos, esperado nenhum", len(result.Statements))
+				}
+				return
+			}
+			if len(result.Statements) != 1 {
+				t.Fatalf("Extract() retornou %d extratos, esperado 1", len(result.Statements))
+			}
+
+			statement := result.Statements[0]
+			if got := statement.StartDate.Format("2006-01-02"); got != tt.start {
+				t.Errorf("início = %s, esperado %s", got, tt.start)
+			}
+			if got := statement.EndDate.Format("2006-01-02"); got != tt.end {
+				t.Errorf("fim = %s, esperado %s", got, tt.end)
+			}
+			if statement.OpeningBalance == nil || *statement.OpeningBalance != tt.opening {
+				t.Errorf("saldo inicial = %v, esperado %d", statement.OpeningBalance, tt.opening)
+			}
+			if statement.LedgerBalance == nil || *statement.LedgerBalance != tt.closing {
+				t.Errorf("saldo final = %v, esperado %d", statement.LedgerBalance, tt.closing)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package extractor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+var (
+	ErrUnsupportedDocument = errors.New("nenhum extrator disponível para este documento")
+)
+
+// Result representa os dados extraídos de um documento
+type Result struct {
+	Transactions []*entity.Transaction
+	Statements   []*Statement
+	Metadata     map[string]string
+}
+
+// Statement resume os dados de conta e saldo informados por um extrato
+type Statement struct {
+	AccountKey        string // Identificador da conta de origem, o mesmo gravado em Transaction.SourceAccount
+	BankID            string
+	BranchID          string
+	AccountID         string
+	AccountType       string
+	Currency          string
+	StartDate         time.Time
+	EndDate           time.Time
+	OpeningBalance    *int64 // Saldo anterior ao período, quando informado pelo extrato
+	LedgerBalance     *int64 // Saldo final informado pelo extrato, em centavos
+	LedgerBalanceDate time.Time
+}
+
+// Extractor extrai dados estruturados do conteúdo de um documento
+type Extractor interface {
+	// Name identifica o extrator nos logs
+	Name() string
+	// Supports indica se o extrator sabe processar o tipo de documento/conteúdo
+	Supports(documentType, contentType string) bool
+	// Extract processa o conteúdo binário do documento
+	Extract(ctx context.Context, document *entity.Document, content []byte) (*Result, error)
+}
+
+// Registry seleciona o extrator adequado para cada documento
+type Registry struct {
+	extractors []Extractor
+	fallback   Extractor
+}
+
+// NewRegistry cria um registro com os extratores informados, na ordem de prioridade
+func NewRegistry(extractors ...Extractor) *Registry {
+	return &Registry{
+		extractors: extractors,
+	}
+}
+
+// Register adiciona um extrator ao final da lista de prioridade
+func (r *Registry) Register(extractor Extractor) {
+	r.extractors = append(r.extractors, extractor)
+}
+
+// SetFallback define o extrator usado quando nenhum outro suporta o documento
+func (r *Registry) SetFallback(extractor Extractor) {
+	r.fallback = extractor
+}
+
+// Resolve retorna o primeiro extrator que suporta o tipo de documento/conteúdo
+func (r *Registry) Resolve(documentType, contentType string) (Extractor, error) {
+	for _, extractor := range r.extractors {
+		if extractor.Supports(documentType, contentType) {
+			return extractor, nil
+		}
+	}
+	if r.fallback != nil {
+		return r.fallback, nil
+	}
+	return nil, ErrUnsupportedDocument
+}
@@ -0,0 +1,88 @@
+package extractor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/pkg/ofx"
+)
+
+// OFXExtractor extrai transações de extratos OFX/QFX (OFX 1.x SGML e 2.x XML)
+type OFXExtractor struct{}
+
+func NewOFXExtractor() *OFXExtractor {
+	return &OFXExtractor{}
+}
+
+func (e *OFXExtractor) Name() string {
+	return "ofx"
+}
+
+func (e *OFXExtractor) Supports(documentType, contentType string) bool {
+	switch contentType {
+	case "application/x-ofx", "application/ofx", "application/vnd.intu.qfx", "application/x-qfx":
+		return true
+	}
+	return false
+}
+
+func (e *OFXExtractor) Extract(ctx context.Context, document *entity.Document, content []byte) (*Result, error) {
+	file, err := ofx.Parse(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	for _, stmt := range file.Statements {
+		statement := &Statement{
+			AccountKey:        stmt.AccountKey(),
+			BankID:            stmt.BankID,
+			BranchID:          stmt.BranchID,
+			AccountID:         stmt.AccountID,
+			AccountType:       stmt.AccountType,
+			Currency:          stmt.Currency,
+			StartDate:         stmt.StartDate,
+			EndDate:           stmt.EndDate,
+			LedgerBalanceDate: stmt.LedgerBalanceDate,
+		}
+		if stmt.HasLedgerBalance {
+			balance := stmt.LedgerBalance
+			statement.LedgerBalance = &balance
+		}
+		result.Statements = append(result.Statements, statement)
+
+		for _, trn := range stmt.Transactions {
+			description := describe(trn)
+			transaction, err := entity.NewTransaction(document, trn.Posted, trn.Amount, trn.Currency, description)
+			if err != nil {
+				return nil, fmt.Errorf("transação %s inválida: %w", trn.FITID, err)
+			}
+			transaction.Counterparty = trn.Name
+			transaction.FITID = trn.FITID
+			transaction.SourceAccount = statement.AccountKey
+			result.Transactions = append(result.Transactions, transaction)
+		}
+	}
+
+	return result, nil
+}
+
+// describe monta a descrição da transação a partir de NAME e MEMO. Bancos
+// brasileiros costumam deixar NAME genérico e o detalhe em MEMO.
+func describe(trn *ofx.Transaction) string {
+	name := strings.TrimSpace(trn.Name)
+	memo := strings.TrimSpace(trn.Memo)
+	switch {
+	case name == "" && memo == "":
+		return trn.Type
+	case name == "":
+		return memo
+	case memo == "" || strings.EqualFold(name, memo):
+		return name
+	default:
+		return name + " - " + memo
+	}
+}
@@ -0,0 +1,161 @@
+package extractor
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"finance-assistant/internal/domain/currency"
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+func TestOFXExtractorExtract(t *testing.T) {
+	type wantTransaction struct {
+		fitid        string
+		date         time.Time
+		amount       int64
+		currency     string
+		description  string
+		counterparty string
+	}
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name          string
+		fixture       string
+		accountKey    string
+		ledgerBalance int64
+		transactions  []wantTransaction
+	}{
+		{
+			name:          "conta corrente em SGML",
+			fixture:       "bank_sgml_1252.ofx",
+			accountKey:    "0341:1234:56789-0",
+			ledgerBalance: 221954,
+			transactions: []wantTransaction{
+				// 23h no horário de Brasília: a data do lançamento não muda para o dia seguinte
+				{fitid: "202403100001", date: date(2024, 3, 10), amount: -4590, currency: "BRL", description: "PAG*PÃO DE AÇÚCAR - Compra no débito", counterparty: "PAG*PÃO DE AÇÚCAR"},
+				{fitid: "202403150002", date: date(2024, 3, 15), amount: 150000, currency: "BRL", description: "PIX RECEBIDO", counterparty: "PIX RECEBIDO"},
+				{fitid: "202403200003", date: date(2024, 3, 20), amount: -123456, currency: "BRL", description: "PAGTO FATURA CARTAO"},
+			},
+		},
+		{
+			name:          "cartão de crédito em XML",
+			fixture:       "creditcard_xml.ofx",
+			accountKey:    "5502********1234",
+			ledgerBalance: -17980,
+			transactions: []wantTransaction{
+				{fitid: "CC-0001", date: date(2024, 3, 6), amount: -15990, currency: "BRL", description: "LOJA X 03/10", counterparty: "LOJA X 03/10"},
+				{fitid: "CC-0002", date: date(2024, 3, 12), amount: -3990, currency: "BRL", description: "NETFLIX.COM - Assinatura & streaming", counterparty: "NETFLIX.COM"},
+				{fitid: "CC-0003", date: date(2024, 3, 20), amount: 2000, currency: "USD", description: "ESTORNO LOJA X", counterparty: "ESTORNO LOJA X"},
+			},
+		},
+		{
+			name:          "conta em iene, sem casas decimais",
+			fixture:       "bank_sgml_jpy.ofx",
+			accountKey:    "0005:001:1234567",
+			ledgerBalance: 120000,
+			transactions: []wantTransaction{
+				{fitid: "JP-0001", date: date(2024, 3, 5), amount: -1500, currency: "JPY", description: "FAMILYMART", counterparty: "FAMILYMART"},
+				{fitid: "JP-0002", date: date(2024, 3, 25), amount: 98000, currency: "JPY", description: "SALARY", counterparty: "SALARY"},
+			},
+		},
+	}
+
+	document := &entity.Document{ID: 1, ExternalID: uuid.New(), UserID: 7}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := NewOFXExtractor().Extract(context.Background(), document, content)
+			if err != nil {
+				t.Fatalf("Extract() erro = %v", err)
+			}
+
+			if len(result.Statements) != 1 {
+				t.Fatalf("Extract() retornou %d extratos, esperado 1", len(result.Statements))
+			}
+			statement := result.Statements[0]
+			if statement.AccountKey != tt.accountKey {
+				t.Errorf("AccountKey = %q, esperado %q", statement.AccountKey, tt.accountKey)
+			}
+			if statement.LedgerBalance == nil || *statement.LedgerBalance != tt.ledgerBalance {
+				t.Errorf("LedgerBalance = %v, esperado %d", statement.LedgerBalance, tt.ledgerBalance)
+			}
+
+			if len(result.Transactions) != len(tt.transactions) {
+				t.Fatalf("Extract() retornou %d transações, esperadas %d", len(result.Transactions), len(tt.transactions))
+			}
+			for i, want := range tt.transactions {
+				got := result.Transactions[i]
+				if got.FITID != want.fitid || !got.Date.Equal(want.date) || got.Amount != want.amount ||
+					got.Currency != want.currency || got.Description != want.description || got.Counterparty != want.counterparty {
+					t.Errorf("transação %d = {%q %v %d %q %q %q}, esperada %+v",
+						i, got.FITID, got.Date, got.Amount, got.Currency, got.Description, got.Counterparty, want)
+				}
+				if got.IsDebit() != (want.amount < 0) {
+					t.Errorf("transação %s IsDebit() = %v", got.FITID, got.IsDebit())
+				}
+				if got.SourceAccount != tt.accountKey {
+					t.Errorf("transação %s SourceAccount = %q, esperado %q", got.FITID, got.SourceAccount, tt.accountKey)
+				}
+				if got.UserID != document.UserID || got.DocumentID != document.ID {
+					t.Errorf("transação %s do usuário %d e documento %d", got.FITID, got.UserID, got.DocumentID)
+				}
+			}
+		})
+	}
+}
+
+// jpyRateProvider cota o iene em reais, em qualquer data
+type jpyRateProvider struct{}
+
+func (jpyRateProvider) Rate(ctx context.Context, from, to string, date time.Time) (*big.Rat, error) {
+	if from != "JPY" || to != "BRL" {
+		return nil, currency.ErrRateNotFound
+	}
+	return big.NewRat(345, 10000), nil
+}
+
+// Os valores importados ficam nas unidades mínimas da moeda do extrato, que é
+// o que o Converter espera: ¥1.500 a R$ 0,0345 são R$ 51,75
+func TestOFXExtractorConvertsMinorUnits(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join("testdata", "bank_sgml_jpy.ofx"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	document := &entity.Document{ID: 1, ExternalID: uuid.New(), UserID: 7}
+	result, err := NewOFXExtractor().Extract(context.Background(), document, content)
+	if err != nil {
+		t.Fatalf("Extract() erro = %v", err)
+	}
+
+	converter := currency.NewConverter(jpyRateProvider{})
+	want := []int64{-5175, 338100}
+	for i, transaction := range result.Transactions {
+		converted, err := converter.Convert(context.Background(), transaction.Money(), "BRL", transaction.Date)
+		if err != nil {
+			t.Fatalf("Convert() erro = %v", err)
+		}
+		if converted.Amount != want[i] || converted.Currency != "BRL" {
+			t.Errorf("transação %s convertida = %d %s, esperado %d BRL", transaction.FITID, converted.Amount, converted.Currency, want[i])
+		}
+	}
+}
+
+func TestOFXExtractorExtractInvalid(t *testing.T) {
+	document := &entity.Document{ID: 1, ExternalID: uuid.New(), UserID: 7}
+	if _, err := NewOFXExtractor().Extract(context.Background(), document, []byte("não é um OFX")); err == nil {
+		t.Error("Extract() deveria falhar para conteúdo que não é OFX")
+	}
+}
@@ -0,0 +1,32 @@
+package extractor
+
+import (
+	"context"
+	"strconv"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+// PassthroughExtractor apenas arquiva o documento, sem extrair dados.
+// É usado como fallback enquanto não existe um extrator específico para o formato.
+type PassthroughExtractor struct{}
+
+func NewPassthroughExtractor() *PassthroughExtractor {
+	return &PassthroughExtractor{}
+}
+
+func (e *PassthroughExtractor) Name() string {
+	return "passthrough"
+}
+
+func (e *PassthroughExtractor) Supports(documentType, contentType string) bool {
+	return true
+}
+
+func (e *PassthroughExtractor) Extract(ctx context.Context, document *entity.Document, content []byte) (*Result, error) {
+	return &Result{
+		Metadata: map[string]string{
+			"size": strconv.Itoa(len(content)),
+		},
+	}, nil
+}
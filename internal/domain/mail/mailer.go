@@ -0,0 +1,16 @@
+package mail
+
+import "context"
+
+// Message é um email em texto simples
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer envia os emails da aplicação, como os convites para famílias
+type Mailer interface {
+	// Send retorna após o servidor de email aceitar a mensagem
+	Send(ctx context.Context, message Message) error
+}
@@ -0,0 +1,73 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+// DocumentMessage representa a mensagem publicada no tópico de documentos
+type DocumentMessage struct {
+	ID           string    `json:"id"`
+	ExternalID   string    `json:"external_id"`
+	UserID       string    `json:"user_id"`
+	DocumentType string    `json:"document_type"`
+	Filename     string    `json:"filename"`
+	ContentType  string    `json:"content_type"`
+	StorageKey   string    `json:"storage_key"`
+	Size         int64     `json:"size_bytes"`
+	SHA256       string    `json:"sha256"`
+	Categories   []string  `json:"categories"` // Nomes das categorias do documento
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// NewDocumentMessage converte um documento na mensagem publicada no tópico de documentos
+func NewDocumentMessage(document *entity.Document) DocumentMessage {
+	return DocumentMessage{
+		ID:           fmt.Sprintf("%d", document.ID),
+		ExternalID:   document.ExternalID.String(),
+		UserID:       fmt.Sprintf("%d", document.UserID),
+		DocumentType: document.DocumentType,
+		Filename:     document.Filename,
+		ContentType:  document.ContentType,
+		StorageKey:   document.StorageKey,
+		Size:         document.Size,
+		SHA256:       document.SHA256,
+		Categories:   document.CategoryNames(),
+		CreatedAt:    document.CreatedAt,
+		UpdatedAt:    document.UpdatedAt,
+	}
+}
+
+// Message é uma mensagem já serializada, como gravada no outbox
+type Message struct {
+	Key     string
+	Payload []byte
+	Headers map[string]string
+}
+
+// DocumentPublisher entrega mensagens ao tópico de documentos
+type DocumentPublisher interface {
+	// PublishDocument retorna apenas após a confirmação de entrega pelo broker
+	PublishDocument(ctx context.Context, message Message) error
+}
+
+// DocumentHandler processa uma mensagem de documento. Um erro indica falha
+// transitória: a mensagem é processada novamente.
+type DocumentHandler func(ctx context.Context, message *DocumentMessage) error
+
+// DocumentFailureHandler é chamado quando uma mensagem esgota as tentativas de
+// processamento, para que o documento não fique pendente indefinidamente
+type DocumentFailureHandler func(ctx context.Context, message *DocumentMessage, cause error) error
+
+// DocumentConsumer entrega as mensagens do tópico de documentos a um handler
+type DocumentConsumer interface {
+	// Run consome mensagens até o contexto ser cancelado, executando até
+	// concurrency handlers em paralelo, e aguarda os handlers em andamento.
+	// Mensagens que esgotam as tentativas são entregues a fail e confirmadas.
+	Run(ctx context.Context, concurrency int, handler DocumentHandler, fail DocumentFailureHandler) error
+	Close()
+}
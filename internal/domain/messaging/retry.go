@@ -0,0 +1,49 @@
+package messaging
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const (
+	minRetryBackoff = time.Second
+	maxRetryBackoff = 30 * time.Second
+	maxAttempts     = 8 // Cerca de dois minutos e meio de retentativas
+)
+
+// HandleWithRetry executa o handler com backoff exponencial até obter sucesso,
+// esgotar maxAttempts tentativas ou ctx ser cancelado. Ao esgotar as
+// tentativas, fail é chamado com o último erro e a mensagem é considerada
+// concluída. Os handlers recebem handlerCtx, que não deve ser cancelado no
+// desligamento para que atualizações em andamento terminem. Retorna true se a
+// mensagem foi concluída e pode ser confirmada no broker.
+func HandleWithRetry(ctx, handlerCtx context.Context, message *DocumentMessage, handler DocumentHandler, fail DocumentFailureHandler) bool {
+	backoff := minRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := handler(handlerCtx, message)
+		if err == nil {
+			return true
+		}
+
+		if attempt == maxAttempts {
+			log.Printf("Erro ao processar documento %s, desistindo após %d tentativas: %v", message.ExternalID, attempt, err)
+			if err := fail(handlerCtx, message, err); err != nil {
+				log.Printf("Erro ao registrar falha do documento %s: %v", message.ExternalID, err)
+			}
+			return true
+		}
+
+		log.Printf("Erro ao processar documento %s, nova tentativa em %s: %v", message.ExternalID, backoff, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package recurrence
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/pkg/merchant"
+)
+
+const (
+	// amountTolerance é a variação aceita em torno do valor mediano das
+	// ocorrências; valores fora dela são outras compras no mesmo estabelecimento
+	amountTolerance = 0.25
+	// minRegularity é a fração mínima dos intervalos entre ocorrências que
+	// precisa seguir a periodicidade
+	minRegularity = 0.75
+	// maxSkipped é o maior número de ocorrências consecutivas ausentes aceito em
+	// um intervalo regular
+	maxSkipped = 2
+)
+
+// frequencies são as periodicidades avaliadas e o mínimo de ocorrências de cada uma
+var frequencies = []struct {
+	frequency      entity.Frequency
+	minOccurrences int
+}{
+	{entity.FrequencyWeekly, 4},
+	{entity.FrequencyMonthly, 3},
+	{entity.FrequencyYearly, 2},
+}
+
+// occurrence é um débito candidato a fazer parte de uma série
+type occurrence struct {
+	date   time.Time
+	amount int64
+}
+
+// group reúne os débitos de um mesmo estabelecimento e moeda
+type group struct {
+	series      *entity.RecurringSeries
+	occurrences []occurrence
+}
+
+// Detect procura séries de débitos periódicos (semanais, mensais ou anuais) nas
+// transações de um usuário, agrupando-as pelo estabelecimento ou, quando ele não
+// foi reconhecido, pelo nome normalizado da descrição. Parcelas de compras
+// parceladas e transferências entre contas não são consideradas, e as séries
+// sem ocorrências recentes em now, como assinaturas canceladas, são descartadas.
+func Detect(transactions []*entity.Transaction, now time.Time) []*entity.RecurringSeries {
+	groups := make(map[string]*group)
+	var keys []string
+	for _, transaction := range transactions {
+		if !transaction.IsDebit() || transaction.InstallmentPlanID != 0 || transaction.TransferID != 0 {
+			continue
+		}
+
+		key, description := seriesKey(transaction)
+		if key == "" {
+			continue
+		}
+		key = fmt.Sprintf("%s:%s", transaction.Currency, key)
+
+		g, ok := groups[key]
+		if !ok {
+			g = &group{series: entity.NewRecurringSeries(transaction, key, description)}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.occurrences = append(g.occurrences, occurrence{date: transaction.Date, amount: -transaction.Amount})
+	}
+
+	var detected []*entity.RecurringSeries
+	for _, key := range keys {
+		if series := groups[key].detect(); series != nil && !isEnded(series, now) {
+			detected = append(detected, series)
+		}
+	}
+	return detected
+}
+
+// seriesKey retorna a chave que agrupa as ocorrências da transação e o nome da série
+func seriesKey(transaction *entity.Transaction) (string, string) {
+	if transaction.MerchantID != 0 {
+		return fmt.Sprintf("merchant:%d", transaction.MerchantID), transaction.Merchant
+	}
+	name := merchant.Normalize(transaction.MerchantDescription())
+	if key := merchant.Key(name); key != "" {
+		return "description:" + key, name
+	}
+	return "", ""
+}
+
+// detect retorna a série do grupo, ou nil se as ocorrências não forem periódicas
+func (g *group) detect() *entity.RecurringSeries {
+	occurrences := g.withinAmountTolerance()
+	slices.SortFunc(occurrences, func(a, b occurrence) int {
+		return a.date.Compare(b.date)
+	})
+	// Mais de um débito no mesmo dia conta como uma ocorrência
+	occurrences = slices.CompactFunc(occurrences, func(a, b occurrence) bool {
+		return a.date.Equal(b.date)
+	})
+
+	for _, candidate := range frequencies {
+		if len(occurrences) < candidate.minOccurrences || !isRegular(occurrences, candidate.frequency) {
+			continue
+		}
+
+		last := occurrences[len(occurrences)-1]
+		series := g.series
+		series.Frequency = candidate.frequency
+		series.Amount = last.amount
+		series.PreviousAmount = occurrences[len(occurrences)-2].amount
+		series.Occurrences = len(occurrences)
+		series.FirstDate = occurrences[0].date
+		series.LastDate = last.date
+		series.NextDate = candidate.frequency.Next(last.date)
+		return series
+	}
+	return nil
+}
+
+// isEnded indica se a série deixou de ocorrer: em now já faltam mais
+// ocorrências do que as admitidas em um intervalo regular
+func isEnded(series *entity.RecurringSeries, now time.Time) bool {
+	days := (maxSkipped + 1) * (series.Frequency.Period() + series.Frequency.Tolerance())
+	return now.After(series.LastDate.AddDate(0, 0, days))
+}
+
+// withinAmountTolerance descarta as ocorrências com valor distante da mediana
+func (g *group) withinAmountTolerance() []occurrence {
+	amounts := make([]int64, len(g.occurrences))
+	for i, o := range g.occurrences {
+		amounts[i] = o.amount
+	}
+	slices.Sort(amounts)
+	median := float64(amounts[len(amounts)/2])
+
+	var kept []occurrence
+	for _, o := range g.occurrences {
+		if math.Abs(float64(o.amount)-median) <= median*amountTolerance {
+			kept = append(kept, o)
+		}
+	}
+	return kept
+}
+
+// isRegular indica se a maior parte dos intervalos entre as ocorrências segue
+// a periodicidade, admitindo algumas ocorrências ausentes
+func isRegular(occurrences []occurrence, frequency entity.Frequency) bool {
+	regular := 0
+	for i := 1; i < len(occurrences); i++ {
+		days := occurrences[i].date.Sub(occurrences[i-1].date).Hours() / 24
+		for periods := 1; periods <= maxSkipped+1; periods++ {
+			if math.Abs(days-float64(periods*frequency.Period())) <= float64(frequency.Tolerance()*periods) {
+				regular++
+				break
+			}
+		}
+	}
+
+	intervals := len(occurrences) - 1
+	return intervals > 0 && float64(regular)/float64(intervals) >= minRegularity
+}
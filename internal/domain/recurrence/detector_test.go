@@ -0,0 +1,195 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+func date(day string) time.Time {
+	parsed, err := time.Parse("2006-01-02", day)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func debit(day string, amount int64, description string) *entity.Transaction {
+	return &entity.Transaction{
+		UserID:      1,
+		Date:        date(day),
+		Amount:      -amount,
+		Currency:    "BRL",
+		Description: description,
+	}
+}
+
+func TestDetect(t *testing.T) {
+	installment := debit("2024-03-15", 3990, "NETFLIX")
+	installment.InstallmentPlanID = 7
+	transfer := debit("2024-03-15", 3990, "NETFLIX")
+	transfer.TransferID = 9
+
+	type series struct {
+		frequency      entity.Frequency
+		occurrences    int
+		amount         int64
+		previousAmount int64
+		nextDate       string
+	}
+
+	tests := []struct {
+		name         string
+		transactions []*entity.Transaction
+		now          string
+		want         []series
+	}{
+		{
+			name: "assinatura mensal",
+			transactions: []*entity.Transaction{
+				debit("2024-01-15", 3990, "NETFLIX"),
+				debit("2024-02-16", 3990, "NETFLIX"),
+				debit("2024-03-15", 3990, "NETFLIX"),
+				debit("2024-04-14", 3990, "NETFLIX"),
+			},
+			now:  "2024-04-20",
+			want: []series{{entity.FrequencyMonthly, 4, 3990, 3990, "2024-05-14"}},
+		},
+		{
+			name: "mensal no fim do mês",
+			transactions: []*entity.Transaction{
+				debit("2024-01-31", 12000, "ACADEMIA FORMA"),
+				debit("2024-02-29", 12000, "ACADEMIA FORMA"),
+				debit("2024-03-31", 12000, "ACADEMIA FORMA"),
+			},
+			now:  "2024-04-10",
+			want: []series{{entity.FrequencyMonthly, 3, 12000, 12000, "2024-04-30"}},
+		},
+		{
+			name: "reajuste de preço e compra avulsa no mesmo estabelecimento",
+			transactions: []*entity.Transaction{
+				debit("2024-01-10", 2190, "SPOTIFY"),
+				debit("2024-02-10", 2190, "SPOTIFY"),
+				debit("2024-02-20", 9900, "SPOTIFY"),
+				debit("2024-03-10", 2390, "SPOTIFY"),
+			},
+			now:  "2024-03-15",
+			want: []series{{entity.FrequencyMonthly, 3, 2390, 2190, "2024-04-10"}},
+		},
+		{
+			name: "mês sem cobrança",
+			transactions: []*entity.Transaction{
+				debit("2024-01-05", 5000, "SEGURO CELULAR"),
+				debit("2024-02-05", 5000, "SEGURO CELULAR"),
+				debit("2024-04-05", 5000, "SEGURO CELULAR"),
+				debit("2024-05-05", 5000, "SEGURO CELULAR"),
+			},
+			now:  "2024-05-10",
+			want: []series{{entity.FrequencyMonthly, 4, 5000, 5000, "2024-06-05"}},
+		},
+		{
+			name: "anuidade",
+			transactions: []*entity.Transaction{
+				debit("2022-06-10", 29900, "ANUIDADE DOMINIO"),
+				debit("2023-06-12", 29900, "ANUIDADE DOMINIO"),
+			},
+			now:  "2023-07-01",
+			want: []series{{entity.FrequencyYearly, 2, 29900, 29900, "2024-06-12"}},
+		},
+		{
+			name: "semanal",
+			transactions: []*entity.Transaction{
+				debit("2024-03-01", 4500, "FEIRA ORGANICA"),
+				debit("2024-03-08", 4500, "FEIRA ORGANICA"),
+				debit("2024-03-15", 4700, "FEIRA ORGANICA"),
+				debit("2024-03-22", 4500, "FEIRA ORGANICA"),
+			},
+			now:  "2024-03-25",
+			want: []series{{entity.FrequencyWeekly, 4, 4500, 4700, "2024-03-29"}},
+		},
+		{
+			name: "série encerrada",
+			transactions: []*entity.Transaction{
+				debit("2024-01-15", 3990, "NETFLIX"),
+				debit("2024-02-15", 3990, "NETFLIX"),
+				debit("2024-03-15", 3990, "NETFLIX"),
+			},
+			now: "2024-08-01",
+		},
+		{
+			name: "ocorrências insuficientes",
+			transactions: []*entity.Transaction{
+				debit("2024-02-15", 3990, "NETFLIX"),
+				debit("2024-03-15", 3990, "NETFLIX"),
+			},
+			now: "2024-03-20",
+		},
+		{
+			name: "intervalos irregulares",
+			transactions: []*entity.Transaction{
+				debit("2024-01-03", 3000, "PADARIA"),
+				debit("2024-01-20", 3000, "PADARIA"),
+				debit("2024-03-02", 3000, "PADARIA"),
+				debit("2024-03-11", 3000, "PADARIA"),
+			},
+			now: "2024-03-15",
+		},
+		{
+			name: "parcelas, transferências e créditos ignorados",
+			transactions: []*entity.Transaction{
+				debit("2024-01-15", 3990, "NETFLIX"),
+				debit("2024-02-15", 3990, "NETFLIX"),
+				installment,
+				transfer,
+				{Date: date("2024-03-15"), Amount: 3990, Currency: "BRL", Description: "NETFLIX"},
+			},
+			now: "2024-03-20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detected := Detect(tt.transactions, date(tt.now))
+			if len(detected) != len(tt.want) {
+				t.Fatalf("Detect() retornou %d séries, esperado %d", len(detected), len(tt.want))
+			}
+			for i, want := range tt.want {
+				got := detected[i]
+				if got.Frequency != want.frequency || got.Occurrences != want.occurrences || got.Amount != want.amount || got.PreviousAmount != want.previousAmount {
+					t.Errorf("série = {%s %d %d %d}, esperado {%s %d %d %d}",
+						got.Frequency, got.Occurrences, got.Amount, got.PreviousAmount,
+						want.frequency, want.occurrences, want.amount, want.previousAmount)
+				}
+				if !got.NextDate.Equal(date(want.nextDate)) {
+					t.Errorf("próxima data = %s, esperado %s", got.NextDate.Format("2006-01-02"), want.nextDate)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectGroupsByMerchantAndCurrency(t *testing.T) {
+	var transactions []*entity.Transaction
+	for _, day := range []string{"2024-01-15", "2024-02-15", "2024-03-15"} {
+		named := debit(day, 3990, "NETFLIX.COM 1234")
+		named.MerchantID = 3
+		named.Merchant = "Netflix"
+		transactions = append(transactions, named)
+
+		dollars := debit(day, 999, "NETFLIX.COM 1234")
+		dollars.Currency = "USD"
+		transactions = append(transactions, dollars)
+	}
+
+	detected := Detect(transactions, date("2024-03-20"))
+	if len(detected) != 2 {
+		t.Fatalf("Detect() retornou %d séries, esperado 2", len(detected))
+	}
+	if detected[0].SeriesKey != "BRL:merchant:3" || detected[0].Description != "Netflix" {
+		t.Errorf("série = %s %q, esperado BRL:merchant:3 \"Netflix\"", detected[0].SeriesKey, detected[0].Description)
+	}
+	if detected[1].Currency != "USD" || detected[1].Amount != 999 {
+		t.Errorf("série = %s %d, esperado USD 999", detected[1].Currency, detected[1].Amount)
+	}
+}
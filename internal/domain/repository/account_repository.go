@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type AccountRepository interface {
+	Create(ctx context.Context, account *entity.Account) error
+	FindByID(ctx context.Context, id int64) (*entity.Account, error)
+	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Account, error)
+	FindByUserID(ctx context.Context, userID int64) ([]*entity.Account, error)
+	Update(ctx context.Context, account *entity.Account) error
+	// UpdateSourceAccount grava o identificador da conta nos extratos, aprendido na importação
+	UpdateSourceAccount(ctx context.Context, id int64, sourceAccount string) error
+	// Delete exclui a conta; os documentos e as transações deixam de referenciá-la
+	Delete(ctx context.Context, id int64) error
+}
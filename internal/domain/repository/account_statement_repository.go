@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+type AccountStatementRepository interface {
+	// ReplaceForDocument substitui os extratos registrados para o documento,
+	// de forma que o reprocessamento não os duplique
+	ReplaceForDocument(ctx context.Context, documentID int64, statements []*entity.AccountStatement) error
+	FindByAccountID(ctx context.Context, accountID int64) ([]*entity.AccountStatement, error)
+	// UpdateReconciliation grava o resultado da conciliação do extrato
+	UpdateReconciliation(ctx context.Context, statement *entity.AccountStatement) error
+}
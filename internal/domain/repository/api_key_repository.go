@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *entity.APIKey) error
+	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.APIKey, error)
+	FindByHash(ctx context.Context, keyHash string) (*entity.APIKey, error)
+	FindByUserID(ctx context.Context, userID int64) ([]*entity.APIKey, error)
+	Revoke(ctx context.Context, id int64) error
+	// TouchLastUsed registra o uso da chave; gravações muito próximas são ignoradas
+	TouchLastUsed(ctx context.Context, id int64) error
+}
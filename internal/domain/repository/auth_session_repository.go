@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type AuthSessionRepository interface {
+	Create(ctx context.Context, session *entity.AuthSession) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.AuthSession, error)
+	// RotateRefreshToken substitui o hash do refresh token apenas se o atual
+	// ainda for currentHash e a sessão estiver ativa; retorna false caso contrário
+	RotateRefreshToken(ctx context.Context, id uuid.UUID, currentHash, newHash string, expiresAt time.Time) (bool, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	// RevokeAllByUserID revoga as sessões ativas do usuário, exceto exceptID
+	RevokeAllByUserID(ctx context.Context, userID int64, exceptID uuid.UUID) error
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrDuplicateCategory indica que o usuário já possui uma categoria com a mesma chave
+	ErrDuplicateCategory = errors.New("categoria já existe")
+	// ErrCategoryInUse indica que a categoria possui subcategorias ou regras de categorização
+	ErrCategoryInUse = errors.New("categoria em uso")
+)
+
+type CategoryRepository interface {
+	Create(ctx context.Context, category *entity.Category) error
+	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Category, error)
+	// FindByKey busca entre as categorias do usuário e as padrão a que tem a
+	// chave informada (ver entity.CategoryKey), dando preferência à do usuário
+	FindByKey(ctx context.Context, userID int64, key string) (*entity.Category, error)
+	// FindAvailable lista as categorias padrão e as do usuário, as de primeiro nível antes das subcategorias
+	FindAvailable(ctx context.Context, userID int64) ([]*entity.Category, error)
+	FindSystem(ctx context.Context) ([]*entity.Category, error)
+	CountChildren(ctx context.Context, id int64) (int, error)
+	Update(ctx context.Context, category *entity.Category) error
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type CategoryRuleRepository interface {
+	Create(ctx context.Context, rule *entity.CategoryRule) error
+	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.CategoryRule, error)
+	// FindByUserID lista as regras do usuário na ordem de avaliação
+	FindByUserID(ctx context.Context, userID int64) ([]*entity.CategoryRule, error)
+	Update(ctx context.Context, rule *entity.CategoryRule) error
+	Delete(ctx context.Context, id int64) error
+}
@@ -2,19 +2,30 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"finance-assistant/internal/domain/entity"
 	"github.com/google/uuid"
 )
 
+var (
+	// ErrDuplicateDocumentHash indica que o usuário já possui um documento com o mesmo hash de conteúdo
+	ErrDuplicateDocumentHash = errors.New("documento com o mesmo conteúdo já existe")
+)
+
 type DocumentRepository interface {
 	Create(ctx context.Context, document *entity.Document) error
 	FindByID(ctx context.Context, id int64) (*entity.Document, error)
 	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Document, error)
 	FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entity.Document, error)
+	// FindAccessibleByUserID lista os documentos do usuário e os das famílias de que ele participa
+	FindAccessibleByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entity.Document, error)
+	FindByUserIDAndSHA256(ctx context.Context, userID int64, sha256 string) ([]*entity.Document, error)
 	Update(ctx context.Context, document *entity.Document) error
 	UpdateStatus(ctx context.Context, id int64, status entity.DocumentStatus) error
+	UpdateAccount(ctx context.Context, id, accountID int64) error
 	Delete(ctx context.Context, id int64) error
 	List(ctx context.Context, limit, offset int) ([]*entity.Document, error)
 	CountByUserID(ctx context.Context, userID int64) (int, error)
+	CountAccessibleByUserID(ctx context.Context, userID int64) (int, error)
 }
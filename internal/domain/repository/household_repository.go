@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrDuplicateHouseholdInvitation indica que já existe um convite pendente para o email na família
+	ErrDuplicateHouseholdInvitation = errors.New("convite pendente já existe")
+)
+
+type HouseholdRepository interface {
+	Create(ctx context.Context, household *entity.Household) error
+	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Household, error)
+	FindByUserID(ctx context.Context, userID int64) ([]*entity.Household, error)
+	Delete(ctx context.Context, id int64) error
+
+	AddMember(ctx context.Context, member *entity.HouseholdMember) error
+	FindMember(ctx context.Context, householdID, userID int64) (*entity.HouseholdMember, error)
+	FindMembers(ctx context.Context, householdID int64) ([]*entity.HouseholdMember, error)
+	UpdateMemberRole(ctx context.Context, householdID, userID int64, role entity.HouseholdRole) error
+	RemoveMember(ctx context.Context, householdID, userID int64) error
+}
+
+type HouseholdInvitationRepository interface {
+	Create(ctx context.Context, invitation *entity.HouseholdInvitation) error
+	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.HouseholdInvitation, error)
+	// FindPendingByHouseholdID lista os convites pendentes e não expirados da família
+	FindPendingByHouseholdID(ctx context.Context, householdID int64) ([]*entity.HouseholdInvitation, error)
+	// FindPendingByEmail lista os convites pendentes e não expirados enviados para o email
+	FindPendingByEmail(ctx context.Context, email string) ([]*entity.HouseholdInvitation, error)
+	UpdateStatus(ctx context.Context, invitation *entity.HouseholdInvitation) error
+}
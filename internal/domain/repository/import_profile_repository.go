@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type ImportProfileRepository interface {
+	Create(ctx context.Context, profile *entity.ImportProfile) error
+	FindByID(ctx context.Context, id int64) (*entity.ImportProfile, error)
+	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.ImportProfile, error)
+	FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entity.ImportProfile, error)
+	Update(ctx context.Context, profile *entity.ImportProfile) error
+	Delete(ctx context.Context, id int64) error
+	CountByUserID(ctx context.Context, userID int64) (int, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+type InstallmentPlanRepository interface {
+	Create(ctx context.Context, plan *entity.InstallmentPlan) error
+	// FindMatching lista, da mais antiga para a mais recente, as compras
+	// parceladas com os mesmos dados de plan que ainda não têm a parcela number
+	// entre as transações de outros documentos; as do documento
+	// excludeDocumentID, que está sendo reprocessado, são ignoradas
+	FindMatching(ctx context.Context, plan *entity.InstallmentPlan, number int, excludeDocumentID int64) ([]*entity.InstallmentPlan, error)
+	// FindAccessibleByUserID lista as compras parceladas com ao menos uma parcela
+	// importada do usuário e as que têm parcelas compartilhadas com as famílias
+	// de que ele participa
+	FindAccessibleByUserID(ctx context.Context, userID int64) ([]*entity.InstallmentPlan, error)
+}
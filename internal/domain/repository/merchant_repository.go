@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrDuplicateMerchant indica que outro estabelecimento do usuário já possui o apelido
+	ErrDuplicateMerchant = errors.New("estabelecimento já existe")
+)
+
+type MerchantRepository interface {
+	Create(ctx context.Context, merchant *entity.Merchant) error
+	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Merchant, error)
+	// FindByAlias busca o estabelecimento do usuário com o apelido informado (ver merchant.Key)
+	FindByAlias(ctx context.Context, userID int64, alias string) (*entity.Merchant, error)
+	// Merge transfere para target os apelidos e as transações dos estabelecimentos
+	// sources e os exclui
+	Merge(ctx context.Context, targetID int64, sourceIDs []int64) error
+	// SumAccessibleSpendingByUserID soma as transações do usuário e as das
+	// famílias de que ele participa por estabelecimento e moeda no período, com
+	// as datas vazias sem limite. Transferências confirmadas entre contas não
+	// são consideradas.
+	SumAccessibleSpendingByUserID(ctx context.Context, userID int64, from, to time.Time) ([]*entity.MerchantSpending, error)
+	// SumAccessibleDailySpendingByUserID soma as transações como
+	// SumAccessibleSpendingByUserID, separando também por dia
+	SumAccessibleDailySpendingByUserID(ctx context.Context, userID int64, from, to time.Time) ([]*entity.MerchantDailySpending, error)
+}
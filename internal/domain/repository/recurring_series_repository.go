@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+type RecurringSeriesRepository interface {
+	// ReplaceForUser grava as séries detectadas para o usuário, mantendo o ID
+	// externo das que já existiam (mesma chave), e remove as demais
+	ReplaceForUser(ctx context.Context, userID int64, series []*entity.RecurringSeries) error
+	// FindByUserID lista as séries do usuário, das mais caras para as mais baratas
+	FindByUserID(ctx context.Context, userID int64) ([]*entity.RecurringSeries, error)
+}
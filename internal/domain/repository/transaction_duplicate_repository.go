@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type TransactionDuplicateRepository interface {
+	Create(ctx context.Context, duplicate *entity.TransactionDuplicate) error
+	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.TransactionDuplicate, error)
+	// FindPendingByUserID lista as possíveis duplicatas do usuário aguardando revisão,
+	// das mais recentes para as mais antigas
+	FindPendingByUserID(ctx context.Context, userID int64) ([]*entity.TransactionDuplicate, error)
+	UpdateStatus(ctx context.Context, duplicate *entity.TransactionDuplicate) error
+	// Merge registra as origens da transação duplicada na mantida e a exclui
+	Merge(ctx context.Context, duplicate *entity.TransactionDuplicate) error
+}
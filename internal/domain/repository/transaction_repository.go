@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrDuplicateTransaction indica que a transação já foi importada de outro
+	// extrato (mesmo FITID ou mesma impressão digital)
+	ErrDuplicateTransaction = errors.New("transação já importada")
+)
+
+type TransactionRepository interface {
+	// Create insere a transação; se ela já foi importada, registra o documento
+	// como mais uma origem da existente e retorna ErrDuplicateTransaction
+	Create(ctx context.Context, transaction *entity.Transaction) error
+	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Transaction, error)
+	FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entity.Transaction, error)
+	// FindByUserIDSince lista, da mais antiga para a mais recente, as transações
+	// do usuário a partir da data informada
+	FindByUserIDSince(ctx context.Context, userID int64, since time.Time) ([]*entity.Transaction, error)
+	// FindAccessibleByUserIDSince lista as transações como FindByUserIDSince,
+	// incluindo as das famílias de que o usuário participa
+	FindAccessibleByUserIDSince(ctx context.Context, userID int64, since time.Time) ([]*entity.Transaction, error)
+	// FindAccessibleByUserID lista as transações do usuário e as das famílias de que ele participa
+	FindAccessibleByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entity.Transaction, error)
+	// FindConfirmedByUserID lista as transações mais recentes do usuário cuja
+	// categoria foi informada por ele (ver entity.Transaction.IsCategoryConfirmed)
+	FindConfirmedByUserID(ctx context.Context, userID int64, limit int) ([]*entity.Transaction, error)
+	// SumDailyByAccountID soma as transações da conta por dia, em ordem de data
+	SumDailyByAccountID(ctx context.Context, accountID int64) ([]entity.BalanceChange, error)
+	FindByDocumentID(ctx context.Context, documentID int64, limit, offset int) ([]*entity.Transaction, error)
+	Update(ctx context.Context, transaction *entity.Transaction) error
+	UpdateCategory(ctx context.Context, id, categoryID int64, source string) error
+	UpdateMerchant(ctx context.Context, id, merchantID int64) error
+	// FindSources lista os documentos que informaram a transação, do primeiro ao último
+	FindSources(ctx context.Context, transactionID int64) ([]*entity.TransactionSource, error)
+	// DeleteByDocumentID remove as transações importadas do documento, exceto as
+	// também informadas por outros documentos, que passam para o mais antigo deles
+	DeleteByDocumentID(ctx context.Context, documentID int64) error
+	CountByUserID(ctx context.Context, userID int64) (int, error)
+	CountAccessibleByUserID(ctx context.Context, userID int64) (int, error)
+	CountByDocumentID(ctx context.Context, documentID int64) (int, error)
+}
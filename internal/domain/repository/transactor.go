@@ -0,0 +1,9 @@
+package repository
+
+import "context"
+
+// Transactor executa operações de diferentes repositórios em uma única transação.
+// Os repositórios participam da transação quando recebem o contexto passado a fn.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type TransferRepository interface {
+	Create(ctx context.Context, transfer *entity.Transfer) error
+	FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Transfer, error)
+	// FindByUserID lista as transferências do usuário, das mais recentes para as
+	// mais antigas; status vazio não filtra
+	FindByUserID(ctx context.Context, userID int64, status entity.TransferStatus) ([]*entity.Transfer, error)
+	// FindByTransactionID lista as transferências, inclusive as rejeitadas, de que
+	// a transação faz parte
+	FindByTransactionID(ctx context.Context, transactionID int64) ([]*entity.Transfer, error)
+	UpdateStatus(ctx context.Context, transfer *entity.Transfer) error
+}
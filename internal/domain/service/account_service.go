@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAccountNotFound = errors.New("Conta não encontrada")
+)
+
+// AccountInput agrupa os dados de uma conta enviados pelo usuário
+type AccountInput struct {
+	Name           string
+	Institution    string
+	Type           entity.AccountType
+	Currency       string
+	Number         string // Número da conta ou do cartão; apenas os últimos dígitos são guardados
+	OpeningBalance int64
+}
+
+// Reconciliation é a conferência dos extratos de uma conta com as transações
+// importadas e o saldo atual resultante
+type Reconciliation struct {
+	Account    *entity.Account
+	Statements []*entity.AccountStatement
+	Balance    int64
+}
+
+type AccountService struct {
+	repo            repository.AccountRepository
+	userRepo        repository.UserRepository
+	statementRepo   repository.AccountStatementRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewAccountService(
+	repo repository.AccountRepository,
+	userRepo repository.UserRepository,
+	statementRepo repository.AccountStatementRepository,
+	transactionRepo repository.TransactionRepository,
+) *AccountService {
+	return &AccountService{
+		repo:            repo,
+		userRepo:        userRepo,
+		statementRepo:   statementRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// CreateAccount cria uma conta do usuário
+func (s *AccountService) CreateAccount(ctx context.Context, userExternalID uuid.UUID, input AccountInput) (*entity.Account, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := entity.NewAccount(user.ID, input.Name, input.Institution, input.Type, input.Currency, input.Number, input.OpeningBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// ListAccounts lista as contas do usuário
+func (s *AccountService) ListAccounts(ctx context.Context, userExternalID uuid.UUID) ([]*entity.Account, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.repo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if accounts == nil {
+		return []*entity.Account{}, nil
+	}
+	return accounts, nil
+}
+
+// GetAccount busca uma conta do usuário
+func (s *AccountService) GetAccount(ctx context.Context, userExternalID, accountExternalID uuid.UUID) (*entity.Account, error) {
+	return s.findAccount(ctx, userExternalID, accountExternalID, auth.AuthorizeRead)
+}
+
+// UpdateAccount substitui os dados de uma conta do usuário
+func (s *AccountService) UpdateAccount(ctx context.Context, userExternalID, accountExternalID uuid.UUID, input AccountInput) (*entity.Account, error) {
+	account, err := s.findAccount(ctx, userExternalID, accountExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := account.Update(input.Name, input.Institution, input.Type, input.Currency, input.Number, input.OpeningBalance); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// DeleteAccount exclui uma conta do usuário; os documentos e as transações
+// importados são mantidos, sem conta
+func (s *AccountService) DeleteAccount(ctx context.Context, userExternalID, accountExternalID uuid.UUID) error {
+	account, err := s.findAccount(ctx, userExternalID, accountExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, account.ID)
+}
+
+// GetReconciliation confere os extratos da conta com as transações importadas
+// até o momento e calcula o saldo atual
+func (s *AccountService) GetReconciliation(ctx context.Context, accountExternalID uuid.UUID) (*Reconciliation, error) {
+	account, err := s.repo.FindByExternalID(ctx, accountExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, ErrAccountNotFound
+	}
+	if err := auth.AuthorizeRead(ctx, account.UserID); err != nil {
+		return nil, err
+	}
+
+	return reconcileAccount(ctx, s.statementRepo, s.transactionRepo, account)
+}
+
+// findAccount busca uma conta do usuário verificando o acesso com authorize
+func (s *AccountService) findAccount(ctx context.Context, userExternalID, accountExternalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.Account, error) {
+	user, err := s.findUser(ctx, userExternalID, authorize)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.repo.FindByExternalID(ctx, accountExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil || account.UserID != user.ID {
+		return nil, ErrAccountNotFound
+	}
+	return account, nil
+}
+
+// findUser busca o usuário e verifica o acesso do usuário autenticado com authorize
+func (s *AccountService) findUser(ctx context.Context, userExternalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.User, error) {
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := authorize(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// reconcileAccount confere os extratos registrados para a conta com a soma
+// diária das suas transações
+func reconcileAccount(ctx context.Context, statementRepo repository.AccountStatementRepository, transactionRepo repository.TransactionRepository, account *entity.Account) (*Reconciliation, error) {
+	statements, err := statementRepo.FindByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar extratos da conta: %w", err)
+	}
+	changes, err := transactionRepo.SumDailyByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao somar transações da conta: %w", err)
+	}
+
+	reconciliation := &Reconciliation{
+		Account:    account,
+		Statements: statements,
+		Balance:    entity.Reconcile(account, statements, changes),
+	}
+	if reconciliation.Statements == nil {
+		reconciliation.Statements = []*entity.AccountStatement{}
+	}
+	return reconciliation, nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAPIKeyNotFound  = errors.New("Chave de API não encontrada")
+	ErrInvalidAPIKey   = errors.New("Chave de API inválida, revogada ou expirada")
+	ErrAPIKeyViaAPIKey = errors.New("Chaves de API não podem gerenciar chaves de API")
+)
+
+const (
+	// apiKeyPrefix identifica as chaves emitidas pela aplicação (ex: em scanners de segredos)
+	apiKeyPrefix = "fa_"
+	// apiKeyDisplayLength é quantos caracteres do início da chave são guardados para exibição
+	apiKeyDisplayLength = len(apiKeyPrefix) + 8
+)
+
+type APIKeyService struct {
+	repo     repository.APIKeyRepository
+	userRepo repository.UserRepository
+}
+
+func NewAPIKeyService(repo repository.APIKeyRepository, userRepo repository.UserRepository) *APIKeyService {
+	return &APIKeyService{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+// CreateAPIKey gera uma chave de API para o usuário e retorna também o seu valor,
+// que não é armazenado e não pode ser recuperado depois
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, userExternalID uuid.UUID, name string, scopes []string, expiresAt *time.Time) (*entity.APIKey, string, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("erro ao gerar chave de API: %w", err)
+	}
+	plaintext := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	key, err := entity.NewAPIKey(user.ID, strings.TrimSpace(name), plaintext[:apiKeyDisplayLength], hashToken(plaintext), scopes, expiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.repo.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+
+	return key, plaintext, nil
+}
+
+// ListAPIKeys lista as chaves do usuário, incluindo as revogadas e expiradas
+func (s *APIKeyService) ListAPIKeys(ctx context.Context, userExternalID uuid.UUID) ([]*entity.APIKey, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindByUserID(ctx, user.ID)
+}
+
+// RevokeAPIKey revoga uma chave do usuário; a chave deixa de ser aceita imediatamente
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, userExternalID, keyExternalID uuid.UUID) error {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return err
+	}
+
+	key, err := s.repo.FindByExternalID(ctx, keyExternalID)
+	if err != nil {
+		return err
+	}
+	if key == nil || key.UserID != user.ID {
+		return ErrAPIKeyNotFound
+	}
+
+	return s.repo.Revoke(ctx, key.ID)
+}
+
+// Authenticate valida a chave de API e retorna o principal da requisição,
+// limitado aos escopos da chave
+func (s *APIKeyService) Authenticate(ctx context.Context, plaintext string) (*auth.Principal, error) {
+	if !strings.HasPrefix(plaintext, apiKeyPrefix) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	key, err := s.repo.FindByHash(ctx, hashToken(plaintext))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar chave de API: %w", err)
+	}
+	if key == nil || !key.IsActive(time.Now()) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	user, err := s.userRepo.FindByID(ctx, key.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	if user == nil {
+		return nil, ErrInvalidAPIKey
+	}
+
+	// Falhar ao registrar o último uso não deve impedir a requisição
+	if err := s.repo.TouchLastUsed(ctx, key.ID); err != nil {
+		log.Printf("Aviso: Não foi possível registrar o uso da chave de API %s: %v", key.ExternalID, err)
+	}
+
+	return &auth.Principal{
+		UserID:         user.ID,
+		UserExternalID: user.ExternalID,
+		Role:           user.Role,
+		APIKeyID:       key.ExternalID,
+		Scopes:         key.Scopes,
+	}, nil
+}
+
+// findUser busca o usuário e verifica o acesso do usuário autenticado com
+// authorize; chaves de API não podem gerenciar chaves
+func (s *APIKeyService) findUser(ctx context.Context, userExternalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.User, error) {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok && principal.IsAPIKey() {
+		return nil, ErrAPIKeyViaAPIKey
+	}
+
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := authorize(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
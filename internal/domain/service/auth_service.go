@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidCredentials  = errors.New("Email ou senha inválidos")
+	ErrInvalidAccessToken  = errors.New("Token de acesso inválido ou expirado")
+	ErrInvalidRefreshToken = errors.New("Refresh token inválido ou expirado")
+)
+
+// tokenIssuer identifica os access tokens emitidos pela aplicação
+const tokenIssuer = "finance-assistant"
+
+// TokenPair são as credenciais entregues no login e em cada renovação
+type TokenPair struct {
+	AccessToken          string
+	AccessTokenExpiresAt time.Time
+	RefreshToken         string
+}
+
+// accessClaims são as claims do access token; sub é o ID externo do usuário e
+// sid a sessão, consultada a cada requisição para que o logout tenha efeito imediato
+type accessClaims struct {
+	SessionID string `json:"sid"`
+	jwt.RegisteredClaims
+}
+
+type AuthService struct {
+	userRepo        repository.UserRepository
+	sessionRepo     repository.AuthSessionRepository
+	secret          []byte
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+}
+
+func NewAuthService(
+	userRepo repository.UserRepository,
+	sessionRepo repository.AuthSessionRepository,
+	secret []byte,
+	accessTokenTTL time.Duration,
+	refreshTokenTTL time.Duration,
+) *AuthService {
+	if accessTokenTTL <= 0 {
+		accessTokenTTL = 15 * time.Minute
+	}
+	if refreshTokenTTL <= 0 {
+		refreshTokenTTL = 30 * 24 * time.Hour
+	}
+
+	return &AuthService{
+		userRepo:        userRepo,
+		sessionRepo:     sessionRepo,
+		secret:          secret,
+		accessTokenTTL:  accessTokenTTL,
+		refreshTokenTTL: refreshTokenTTL,
+	}
+}
+
+// Login verifica email e senha e abre uma nova sessão
+func (s *AuthService) Login(ctx context.Context, email, password string) (*TokenPair, error) {
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	if user == nil || !user.CheckPassword(password) {
+		return nil, ErrInvalidCredentials
+	}
+
+	secret, err := newRefreshSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	session := entity.NewAuthSession(user.ID, "", s.refreshTokenTTL)
+	refreshToken := formatRefreshToken(session.ID, secret)
+	session.RefreshTokenHash = hashToken(refreshToken)
+
+	if err := s.sessionRepo.Create(ctx, session); err != nil {
+		return nil, fmt.Errorf("erro ao criar sessão: %w", err)
+	}
+
+	return s.tokenPair(user, session.ID, refreshToken)
+}
+
+// Refresh troca um refresh token válido por um novo par de tokens. Cada refresh
+// token só pode ser usado uma vez: a reutilização de um token já trocado indica
+// que ele vazou, e a sessão inteira é revogada.
+func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	session, err := s.findSession(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := newRefreshSecret()
+	if err != nil {
+		return nil, err
+	}
+	newRefreshToken := formatRefreshToken(session.ID, secret)
+
+	currentHash := hashToken(refreshToken)
+	rotated, err := s.sessionRepo.RotateRefreshToken(
+		ctx, session.ID, currentHash, hashToken(newRefreshToken), time.Now().Add(s.refreshTokenTTL),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao renovar sessão: %w", err)
+	}
+	if !rotated {
+		if !sameHash(session.RefreshTokenHash, currentHash) {
+			log.Printf("Aviso: Refresh token reutilizado na sessão %s; sessão revogada", session.ID)
+			if err := s.sessionRepo.Revoke(ctx, session.ID); err != nil {
+				return nil, fmt.Errorf("erro ao revogar sessão: %w", err)
+			}
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+
+	user, err := s.userRepo.FindByID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	if user == nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	return s.tokenPair(user, session.ID, newRefreshToken)
+}
+
+// Logout revoga a sessão do refresh token; access tokens da sessão deixam de
+// ser aceitos imediatamente
+func (s *AuthService) Logout(ctx context.Context, refreshToken string) error {
+	session, err := s.findSession(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+	if !sameHash(session.RefreshTokenHash, hashToken(refreshToken)) {
+		return ErrInvalidRefreshToken
+	}
+
+	if err := s.sessionRepo.Revoke(ctx, session.ID); err != nil {
+		return fmt.Errorf("erro ao revogar sessão: %w", err)
+	}
+	return nil
+}
+
+// Authenticate valida o access token e retorna o principal da requisição
+func (s *AuthService) Authenticate(ctx context.Context, accessToken string) (*auth.Principal, error) {
+	var claims accessClaims
+	_, err := jwt.ParseWithClaims(accessToken, &claims, func(*jwt.Token) (any, error) {
+		return s.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(tokenIssuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, ErrInvalidAccessToken
+	}
+
+	userExternalID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, ErrInvalidAccessToken
+	}
+	sessionID, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		return nil, ErrInvalidAccessToken
+	}
+
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar sessão: %w", err)
+	}
+	if session == nil || !session.IsActive(time.Now()) {
+		return nil, ErrInvalidAccessToken
+	}
+
+	// O papel é lido a cada requisição para que alterações tenham efeito imediato
+	user, err := s.userRepo.FindByID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	if user == nil || user.ExternalID != userExternalID {
+		return nil, ErrInvalidAccessToken
+	}
+
+	return &auth.Principal{
+		UserID:         user.ID,
+		UserExternalID: user.ExternalID,
+		SessionID:      session.ID,
+		Role:           user.Role,
+	}, nil
+}
+
+// ChangePassword altera a senha do usuário autenticado e revoga as suas demais sessões
+func (s *AuthService) ChangePassword(ctx context.Context, currentPassword, newPassword string) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return auth.ErrUnauthenticated
+	}
+
+	user, err := s.userRepo.FindByID(ctx, principal.UserID)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+	if !user.CheckPassword(currentPassword) {
+		return ErrInvalidCredentials
+	}
+
+	if err := user.SetPassword(newPassword); err != nil {
+		return err
+	}
+	if err := s.userRepo.UpdatePassword(ctx, user.ID, user.PasswordHash); err != nil {
+		return err
+	}
+
+	return s.sessionRepo.RevokeAllByUserID(ctx, user.ID, principal.SessionID)
+}
+
+// findSession localiza a sessão ativa indicada pelo refresh token
+func (s *AuthService) findSession(ctx context.Context, refreshToken string) (*entity.AuthSession, error) {
+	sessionID, ok := parseRefreshToken(refreshToken)
+	if !ok {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar sessão: %w", err)
+	}
+	if session == nil || !session.IsActive(time.Now()) {
+		return nil, ErrInvalidRefreshToken
+	}
+	return session, nil
+}
+
+func (s *AuthService) tokenPair(user *entity.User, sessionID uuid.UUID, refreshToken string) (*TokenPair, error) {
+	now := time.Now()
+	expiresAt := now.Add(s.accessTokenTTL)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims{
+		SessionID: sessionID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    tokenIssuer,
+			Subject:   user.ExternalID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+
+	accessToken, err := token.SignedString(s.secret)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao assinar token: %w", err)
+	}
+
+	return &TokenPair{
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: expiresAt,
+		RefreshToken:         refreshToken,
+	}, nil
+}
+
+// O refresh token tem o formato <id da sessão>.<segredo aleatório>; apenas o
+// seu hash SHA-256 é armazenado
+func formatRefreshToken(sessionID uuid.UUID, secret string) string {
+	return sessionID.String() + "." + secret
+}
+
+func parseRefreshToken(refreshToken string) (uuid.UUID, bool) {
+	id, secret, found := strings.Cut(refreshToken, ".")
+	if !found || secret == "" {
+		return uuid.Nil, false
+	}
+	sessionID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return sessionID, true
+}
+
+func newRefreshSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("erro ao gerar refresh token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func sameHash(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/categorization"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCategoryRuleNotFound = errors.New("Regra de categorização não encontrada")
+)
+
+// historyBatchSize é quantas transações são lidas por vez ao percorrer o histórico do usuário
+const historyBatchSize = 500
+
+// CategoryRuleInput agrupa os dados de uma regra enviados pelo usuário
+type CategoryRuleInput struct {
+	Name       string
+	CategoryID uuid.UUID // ID externo de uma categoria padrão ou do usuário
+	Priority   int
+	Enabled    bool
+	Conditions entity.CategoryRuleConditions
+}
+
+// CategoryChange descreve a categoria que uma regra atribui a uma transação
+type CategoryChange struct {
+	Transaction      *entity.Transaction
+	Rule             *entity.CategoryRule
+	PreviousCategory string
+}
+
+// CategorizationReport resume a aplicação das regras ao histórico de transações
+type CategorizationReport struct {
+	Evaluated int              // Transações avaliadas
+	Matched   int              // Transações atendidas por alguma regra
+	Changed   int              // Transações cuja categoria muda
+	Changes   []CategoryChange // Amostra das alterações (apenas na simulação)
+}
+
+type CategoryRuleService struct {
+	repo            repository.CategoryRuleRepository
+	userRepo        repository.UserRepository
+	categoryRepo    repository.CategoryRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewCategoryRuleService(
+	repo repository.CategoryRuleRepository,
+	userRepo repository.UserRepository,
+	categoryRepo repository.CategoryRepository,
+	transactionRepo repository.TransactionRepository,
+) *CategoryRuleService {
+	return &CategoryRuleService{
+		repo:            repo,
+		userRepo:        userRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// CreateRule cria uma regra de categorização para o usuário
+func (s *CategoryRuleService) CreateRule(ctx context.Context, userExternalID uuid.UUID, input CategoryRuleInput) (*entity.CategoryRule, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.newCategoryRule(ctx, user.ID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// ListRules lista as regras do usuário na ordem de avaliação
+func (s *CategoryRuleService) ListRules(ctx context.Context, userExternalID uuid.UUID) ([]*entity.CategoryRule, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.repo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		return []*entity.CategoryRule{}, nil
+	}
+	return rules, nil
+}
+
+// UpdateRule substitui os dados de uma regra do usuário; as transações já
+// categorizadas só mudam ao reaplicar as regras
+func (s *CategoryRuleService) UpdateRule(ctx context.Context, userExternalID, ruleExternalID uuid.UUID, input CategoryRuleInput) (*entity.CategoryRule, error) {
+	rule, err := s.findRule(ctx, userExternalID, ruleExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := s.findCategory(ctx, rule.UserID, input.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rule.Update(input.Name, category, input.Priority, input.Enabled, input.Conditions); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteRule exclui uma regra do usuário
+func (s *CategoryRuleService) DeleteRule(ctx context.Context, userExternalID, ruleExternalID uuid.UUID) error {
+	rule, err := s.findRule(ctx, userExternalID, ruleExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, rule.ID)
+}
+
+// TestRules simula a aplicação das regras do usuário às suas transações, sem
+// alterá-las. Uma regra candidata, ainda não salva, pode ser incluída para ver
+// o seu efeito; até limit alterações são retornadas como amostra.
+func (s *CategoryRuleService) TestRules(ctx context.Context, userExternalID uuid.UUID, candidate *CategoryRuleInput, limit int) (*CategorizationReport, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.repo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if candidate != nil {
+		rule, err := s.newCategoryRule(ctx, user.ID, *candidate)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return s.categorizeHistory(ctx, user.ID, rules, false, limit)
+}
+
+// ApplyRules reaplica as regras do usuário a todas as suas transações,
+// atualizando as categorias que mudaram; as categorias corrigidas pelo usuário
+// são mantidas
+func (s *CategoryRuleService) ApplyRules(ctx context.Context, userExternalID uuid.UUID) (*CategorizationReport, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.repo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.categorizeHistory(ctx, user.ID, rules, true, 0)
+}
+
+// categorizeHistory percorre as transações do usuário em lotes aplicando as
+// regras; com save, as novas categorias são gravadas, e sem save até sample
+// alterações são guardadas no relatório
+func (s *CategoryRuleService) categorizeHistory(ctx context.Context, userID int64, rules []*entity.CategoryRule, save bool, sample int) (*CategorizationReport, error) {
+	system, err := s.categoryRepo.FindSystem(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categorizer, err := categorization.New(rules, system)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &CategorizationReport{Changes: []CategoryChange{}}
+	for offset := 0; ; offset += historyBatchSize {
+		transactions, err := s.transactionRepo.FindByUserID(ctx, userID, historyBatchSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, transaction := range transactions {
+			// As correções do usuário prevalecem sobre as regras
+			if transaction.CategorySource == entity.CategorySourceUser {
+				continue
+			}
+			report.Evaluated++
+			previous := transaction.Category
+			rule, changed := categorizer.Apply(transaction)
+			if rule == nil {
+				continue
+			}
+			report.Matched++
+			if !changed {
+				continue
+			}
+			report.Changed++
+
+			if save {
+				if err := s.transactionRepo.UpdateCategory(ctx, transaction.ID, transaction.CategoryID, transaction.CategorySource); err != nil {
+					return nil, fmt.Errorf("erro ao atualizar categoria da transação: %w", err)
+				}
+			} else if len(report.Changes) < sample {
+				report.Changes = append(report.Changes, CategoryChange{
+					Transaction:      transaction,
+					Rule:             rule,
+					PreviousCategory: previous,
+				})
+			}
+		}
+
+		if len(transactions) < historyBatchSize {
+			return report, nil
+		}
+	}
+}
+
+// findRule busca a regra e verifica se pertence ao usuário
+func (s *CategoryRuleService) findRule(ctx context.Context, userExternalID, ruleExternalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.CategoryRule, error) {
+	user, err := s.findUser(ctx, userExternalID, authorize)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.repo.FindByExternalID(ctx, ruleExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil || rule.UserID != user.ID {
+		return nil, ErrCategoryRuleNotFound
+	}
+	return rule, nil
+}
+
+// findUser busca o usuário e verifica o acesso do usuário autenticado com authorize
+func (s *CategoryRuleService) findUser(ctx context.Context, userExternalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.User, error) {
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := authorize(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *CategoryRuleService) newCategoryRule(ctx context.Context, userID int64, input CategoryRuleInput) (*entity.CategoryRule, error) {
+	category, err := s.findCategory(ctx, userID, input.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := entity.NewCategoryRule(userID, input.Name, category, input.Priority, input.Conditions)
+	if err != nil {
+		return nil, err
+	}
+	rule.Enabled = input.Enabled
+	return rule, nil
+}
+
+// findCategory busca uma categoria padrão ou do próprio usuário
+func (s *CategoryRuleService) findCategory(ctx context.Context, userID int64, categoryExternalID uuid.UUID) (*entity.Category, error) {
+	category, err := s.categoryRepo.FindByExternalID(ctx, categoryExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil || !category.IsAvailableTo(userID) {
+		return nil, ErrCategoryNotFound
+	}
+	return category, nil
+}
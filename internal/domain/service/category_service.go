@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCategoryNotFound    = errors.New("Categoria não encontrada")
+	ErrCategoryReadOnly    = errors.New("Categorias padrão não podem ser alteradas")
+	ErrCategoryExists      = errors.New("Já existe uma categoria com esse nome")
+	ErrCategoryInUse       = errors.New("A categoria possui subcategorias ou é usada por regras de categorização")
+	ErrCategoryHasChildren = errors.New("Categorias com subcategorias não podem ser movidas para dentro de outra")
+)
+
+// CategoryInput agrupa os dados de uma categoria enviados pelo usuário
+type CategoryInput struct {
+	Name     string
+	ParentID uuid.UUID // ID externo da categoria pai (opcional)
+	Icon     string
+	Color    string
+}
+
+type CategoryService struct {
+	repo     repository.CategoryRepository
+	userRepo repository.UserRepository
+}
+
+func NewCategoryService(repo repository.CategoryRepository, userRepo repository.UserRepository) *CategoryService {
+	return &CategoryService{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+// CreateCategory cria uma categoria do usuário
+func (s *CategoryService) CreateCategory(ctx context.Context, userExternalID uuid.UUID, input CategoryInput) (*entity.Category, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, err := s.findParent(ctx, user.ID, input.ParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := entity.NewCategory(user.ID, parent, input.Name, input.Icon, input.Color)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkUnique(ctx, category); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, category); err != nil {
+		if errors.Is(err, repository.ErrDuplicateCategory) {
+			return nil, ErrCategoryExists
+		}
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// ListCategories lista as categorias padrão e as do usuário
+func (s *CategoryService) ListCategories(ctx context.Context, userExternalID uuid.UUID) ([]*entity.Category, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.repo.FindAvailable(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if categories == nil {
+		return []*entity.Category{}, nil
+	}
+	return categories, nil
+}
+
+// GetCategory busca uma categoria padrão ou do usuário
+func (s *CategoryService) GetCategory(ctx context.Context, userExternalID, categoryExternalID uuid.UUID) (*entity.Category, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.findCategory(ctx, user.ID, categoryExternalID)
+}
+
+// UpdateCategory substitui os dados de uma categoria do usuário
+func (s *CategoryService) UpdateCategory(ctx context.Context, userExternalID, categoryExternalID uuid.UUID, input CategoryInput) (*entity.Category, error) {
+	category, err := s.findOwnCategory(ctx, userExternalID, categoryExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, err := s.findParent(ctx, category.UserID, input.ParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A hierarquia tem dois níveis: uma categoria com subcategorias não pode virar subcategoria
+	if parent != nil {
+		children, err := s.repo.CountChildren(ctx, category.ID)
+		if err != nil {
+			return nil, err
+		}
+		if children > 0 {
+			return nil, ErrCategoryHasChildren
+		}
+	}
+
+	if err := category.Update(parent, input.Name, input.Icon, input.Color); err != nil {
+		return nil, err
+	}
+	if err := s.checkUnique(ctx, category); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, category); err != nil {
+		if errors.Is(err, repository.ErrDuplicateCategory) {
+			return nil, ErrCategoryExists
+		}
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// DeleteCategory exclui uma categoria do usuário. Os documentos deixam de
+// referenciá-la e as transações ficam sem categoria; categorias com
+// subcategorias ou usadas por regras não podem ser excluídas.
+func (s *CategoryService) DeleteCategory(ctx context.Context, userExternalID, categoryExternalID uuid.UUID) error {
+	category, err := s.findOwnCategory(ctx, userExternalID, categoryExternalID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, category.ID); err != nil {
+		if errors.Is(err, repository.ErrCategoryInUse) {
+			return ErrCategoryInUse
+		}
+		return err
+	}
+	return nil
+}
+
+// checkUnique impede categorias do usuário com a mesma chave de outra
+// categoria do usuário ou de uma categoria padrão
+func (s *CategoryService) checkUnique(ctx context.Context, category *entity.Category) error {
+	existing, err := s.repo.FindByKey(ctx, category.UserID, category.Slug)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != category.ID {
+		return ErrCategoryExists
+	}
+	return nil
+}
+
+// findParent busca a categoria pai, padrão ou do usuário, quando informada
+func (s *CategoryService) findParent(ctx context.Context, userID int64, parentExternalID uuid.UUID) (*entity.Category, error) {
+	if parentExternalID == uuid.Nil {
+		return nil, nil
+	}
+	return s.findCategory(ctx, userID, parentExternalID)
+}
+
+// findOwnCategory busca uma categoria criada pelo usuário, que ele pode alterar
+func (s *CategoryService) findOwnCategory(ctx context.Context, userExternalID, categoryExternalID uuid.UUID) (*entity.Category, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := s.findCategory(ctx, user.ID, categoryExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if category.IsSystem() {
+		return nil, ErrCategoryReadOnly
+	}
+	return category, nil
+}
+
+// findCategory busca uma categoria padrão ou do usuário
+func (s *CategoryService) findCategory(ctx context.Context, userID int64, categoryExternalID uuid.UUID) (*entity.Category, error) {
+	category, err := s.repo.FindByExternalID(ctx, categoryExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil || !category.IsAvailableTo(userID) {
+		return nil, ErrCategoryNotFound
+	}
+	return category, nil
+}
+
+// findUser busca o usuário e verifica o acesso do usuário autenticado com authorize
+func (s *CategoryService) findUser(ctx context.Context, userExternalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.User, error) {
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := authorize(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -1,51 +1,108 @@
 package service
 
 import (
+	"bufio"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"strings"
 
+	"finance-assistant/internal/domain/auth"
 	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/messaging"
 	"finance-assistant/internal/domain/repository"
-	"finance-assistant/internal/infrastructure/kafka"
+	"finance-assistant/internal/domain/storage"
+	"finance-assistant/internal/pkg/filetype"
 	"github.com/google/uuid"
 )
 
 var (
 	ErrDocumentNotFound        = errors.New("Documento não encontrado")
 	ErrUserNotFoundForDocument = errors.New("Usuário não encontrado para este documento")
+	ErrContentTypeNotAllowed   = errors.New("Tipo de arquivo não permitido")
+	ErrDuplicateDocument       = errors.New("Documento com o mesmo conteúdo já enviado")
+	ErrInvalidDocumentHash     = errors.New("Hash SHA-256 inválido")
 )
 
+// DuplicateDocumentError indica que o usuário já enviou um arquivo com o mesmo
+// conteúdo; Existing é o documento mais antigo com esse conteúdo
+type DuplicateDocumentError struct {
+	Existing *entity.Document
+}
+
+func (e *DuplicateDocumentError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrDuplicateDocument, e.Existing.ExternalID)
+}
+
+func (e *DuplicateDocumentError) Is(target error) bool {
+	return target == ErrDuplicateDocument
+}
+
 type DocumentService struct {
-	repo          repository.DocumentRepository
-	userRepo      repository.UserRepository
-	kafkaProducer *kafka.Producer
+	repo              repository.DocumentRepository
+	userRepo          repository.UserRepository
+	importProfileRepo repository.ImportProfileRepository
+	householdRepo     repository.HouseholdRepository
+	households        householdAccess
+	categoryRepo      repository.CategoryRepository
+	accountRepo       repository.AccountRepository
+	outboxRepo        repository.OutboxRepository
+	transactor        repository.Transactor
+	blobStore         storage.BlobStore
+	allowedTypes      map[string]bool
 }
 
 func NewDocumentService(
 	repo repository.DocumentRepository,
 	userRepo repository.UserRepository,
-	kafkaProducer *kafka.Producer,
+	importProfileRepo repository.ImportProfileRepository,
+	householdRepo repository.HouseholdRepository,
+	categoryRepo repository.CategoryRepository,
+	accountRepo repository.AccountRepository,
+	outboxRepo repository.OutboxRepository,
+	transactor repository.Transactor,
+	blobStore storage.BlobStore,
+	allowedContentTypes []string,
 ) *DocumentService {
+	allowedTypes := make(map[string]bool, len(allowedContentTypes))
+	for _, contentType := range allowedContentTypes {
+		allowedTypes[contentType] = true
+	}
+
 	return &DocumentService{
-		repo:          repo,
-		userRepo:      userRepo,
-		kafkaProducer: kafkaProducer,
-	}
-}
-
-// CreateDocument cria um novo documento e o envia para processamento
-func (s *DocumentService) CreateDocument(
-	ctx context.Context,
-	userExternalID uuid.UUID,
-	documentType,
-	filename,
-	contentType,
-	fileContent string,
-	categories []string,
-) (*entity.Document, error) {
-	// Buscar usuário pelo externalID
+		repo:              repo,
+		userRepo:          userRepo,
+		importProfileRepo: importProfileRepo,
+		householdRepo:     householdRepo,
+		households:        householdAccess{repo: householdRepo},
+		categoryRepo:      categoryRepo,
+		accountRepo:       accountRepo,
+		outboxRepo:        outboxRepo,
+		transactor:        transactor,
+		blobStore:         blobStore,
+		allowedTypes:      allowedTypes,
+	}
+}
+
+// ContentUpload descreve um conteúdo já gravado no BlobStore que ainda não foi
+// associado a um documento
+type ContentUpload struct {
+	UserID      int64
+	StorageKey  string
+	ContentType string // Tipo MIME detectado pelo conteúdo
+	Size        int64
+	SHA256      string
+}
+
+// UploadContent identifica o tipo real do arquivo pelos primeiros bytes e grava
+// o conteúdo no armazenamento em streaming, calculando o tamanho e o hash
+// SHA-256 durante a cópia, sem carregar o arquivo em memória
+func (s *DocumentService) UploadContent(ctx context.Context, userExternalID uuid.UUID, filename string, content io.Reader) (*ContentUpload, error) {
 	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
@@ -53,52 +110,240 @@ func (s *DocumentService) CreateDocument(
 	if user == nil {
 		return nil, ErrUserNotFoundForDocument
 	}
+	if err := auth.AuthorizeOwner(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	// Ler o início do arquivo sem consumi-lo, para detectar o tipo antes da gravação
+	buffered := bufio.NewReaderSize(content, filetype.SniffLen)
+	header, err := buffered.Peek(filetype.SniffLen)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("erro ao ler arquivo: %w", err)
+	}
+	if len(header) == 0 {
+		return nil, entity.ErrInvalidDocumentContent
+	}
+
+	contentType, err := filetype.Detect(header, filename)
+	if err != nil {
+		return nil, err
+	}
+	if !s.allowedTypes[contentType] {
+		return nil, fmt.Errorf("%w: %s", ErrContentTypeNotAllowed, contentType)
+	}
+
+	hash := sha256.New()
+	counter := &countingReader{reader: io.TeeReader(buffered, hash)}
+	upload := &ContentUpload{
+		UserID:      user.ID,
+		StorageKey:  entity.DocumentStorageKey(user.ID, uuid.New()),
+		ContentType: contentType,
+	}
+
+	if err := s.blobStore.Put(ctx, upload.StorageKey, counter, -1, contentType); err != nil {
+		s.DiscardUpload(ctx, upload)
+		return nil, fmt.Errorf("erro ao armazenar arquivo: %w", err)
+	}
+
+	upload.Size = counter.count
+	upload.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	return upload, nil
+}
+
+// DiscardUpload remove um conteúdo enviado que não chegou a ser associado a um documento
+func (s *DocumentService) DiscardUpload(ctx context.Context, upload *ContentUpload) {
+	if err := s.blobStore.Delete(context.WithoutCancel(ctx), upload.StorageKey); err != nil {
+		log.Printf("Aviso: Não foi possível remover o arquivo %s do armazenamento: %v", upload.StorageKey, err)
+	}
+}
+
+// CreateDocumentInput agrupa os dados de um novo documento enviado pelo usuário
+type CreateDocumentInput struct {
+	UserExternalID  uuid.UUID
+	DocumentType    string
+	Filename        string
+	Upload          *ContentUpload // Conteúdo gravado previamente com UploadContent
+	Categories      []uuid.UUID    // IDs externos de categorias padrão ou do usuário
+	ImportProfileID uuid.UUID      // Perfil de importação para arquivos CSV (opcional)
+	HouseholdID     uuid.UUID      // Família com que o documento é compartilhado (opcional)
+	AccountID       uuid.UUID      // Conta do usuário a que o documento pertence (opcional)
+	Force           bool           // Aceita o documento mesmo que o conteúdo já tenha sido enviado
+}
+
+// CreateDocument cria um novo documento e o envia para processamento. O
+// documento e o evento de criação são gravados na mesma transação; a publicação
+// no broker é feita pelo OutboxRelay, de forma que o upload não depende da
+// disponibilidade do broker. Se o documento não puder ser criado, o conteúdo
+// enviado é descartado.
+func (s *DocumentService) CreateDocument(ctx context.Context, input CreateDocumentInput) (*entity.Document, error) {
+	document, err := s.newDocument(ctx, input)
+	if err == nil {
+		err = s.saveDocument(ctx, document)
+	}
+	if err != nil {
+		s.DiscardUpload(ctx, input.Upload)
+		return nil, err
+	}
+
+	log.Printf("Documento %s criado com sucesso e agendado para processamento", document.ExternalID)
+
+	return document, nil
+}
+
+// newDocument valida os dados e monta o documento, ainda não persistido
+func (s *DocumentService) newDocument(ctx context.Context, input CreateDocumentInput) (*entity.Document, error) {
+	// Buscar usuário pelo externalID
+	user, err := s.userRepo.FindByExternalID(ctx, input.UserExternalID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	if user == nil || input.Upload.UserID != user.ID {
+		return nil, ErrUserNotFoundForDocument
+	}
+	if err := auth.AuthorizeOwner(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.findCategories(ctx, user.ID, input.Categories)
+	if err != nil {
+		return nil, err
+	}
 
 	// Criar novo documento
 	document, err := entity.NewDocument(
 		user.ID,
-		documentType,
-		filename,
-		contentType,
-		fileContent,
+		input.DocumentType,
+		input.Filename,
+		input.Upload.ContentType,
 		categories,
 	)
 	if err != nil {
 		return nil, err
 	}
+	if err := document.AttachContent(input.Upload.StorageKey, input.Upload.Size, input.Upload.SHA256); err != nil {
+		return nil, err
+	}
 
-	// Primeiro salva como pendente
-	document.Status = entity.DocumentStatusPending
-
-	// Salvar no banco de dados
-	if err := s.repo.Create(ctx, document); err != nil {
-		return nil, fmt.Errorf("erro ao salvar documento: %w", err)
+	// Rejeitar conteúdo já enviado pelo usuário, a menos que a duplicata seja forçada
+	existing, err := s.findDuplicate(ctx, user.ID, document.SHA256)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		if !input.Force {
+			return nil, &DuplicateDocumentError{Existing: existing}
+		}
+		document.DuplicateAllowed = true
 	}
 
-	// Log para debug
-	log.Printf("Documento %s criado com sucesso. Enviando para processamento...", document.ExternalID)
+	// Vincular o perfil de importação, que precisa pertencer ao mesmo usuário
+	if input.ImportProfileID != uuid.Nil {
+		profile, err := s.importProfileRepo.FindByExternalID(ctx, input.ImportProfileID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar perfil de importação: %w", err)
+		}
+		if profile == nil || profile.UserID != user.ID {
+			return nil, ErrImportProfileNotFound
+		}
+		document.ImportProfileID = profile.ID
+	}
 
-	// Tentar enviar para o Kafka
-	if err := s.kafkaProducer.SendDocument(document); err != nil {
-		// Se falhar no envio, atualiza status para falha
-		log.Printf("Erro ao enviar documento %s para Kafka: %v", document.ExternalID, err)
-		_ = s.repo.UpdateStatus(ctx, document.ID, entity.DocumentStatusFailed)
-		return nil, fmt.Errorf("erro ao enviar documento para processamento: %w", err)
+	// Vincular a conta, que precisa pertencer ao mesmo usuário; sem ela a conta é
+	// identificada no processamento pelos dados do extrato
+	if input.AccountID != uuid.Nil {
+		account, err := s.accountRepo.FindByExternalID(ctx, input.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar conta: %w", err)
+		}
+		if account == nil || account.UserID != user.ID {
+			return nil, ErrAccountNotFound
+		}
+		document.AccountID = account.ID
+		document.AccountExternalID = account.ExternalID
 	}
 
-	// Atualizar status para processando
-	log.Printf("Documento %s enviado para Kafka com sucesso. Atualizando status...", document.ExternalID)
-	document.UpdateStatus(entity.DocumentStatusProcessing)
-	if err := s.repo.UpdateStatus(ctx, document.ID, entity.DocumentStatusProcessing); err != nil {
-		log.Printf("Aviso: Não foi possível atualizar o status do documento %s: %v", document.ExternalID, err)
-		// Não retornamos erro aqui, pois o documento já foi enviado para o Kafka
+	// Compartilhar com a família, da qual o dono do documento precisa participar
+	// com um papel que permita enviar documentos
+	if input.HouseholdID != uuid.Nil {
+		household, err := s.householdRepo.FindByExternalID(ctx, input.HouseholdID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar família: %w", err)
+		}
+		if household == nil {
+			return nil, ErrHouseholdNotFound
+		}
+		member, err := s.householdRepo.FindMember(ctx, household.ID, user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar membro da família: %w", err)
+		}
+		if member == nil {
+			return nil, ErrHouseholdNotFound
+		}
+		if !member.Role.CanWrite() {
+			return nil, ErrHouseholdWriteDenied
+		}
+		document.HouseholdID = household.ID
+		document.HouseholdExternalID = household.ExternalID
 	}
 
+	// Permanece pendente até que o worker inicie o processamento
+	document.Status = entity.DocumentStatusPending
+
 	return document, nil
 }
 
+// saveDocument grava o documento e o evento de criação no outbox em uma única transação
+func (s *DocumentService) saveDocument(ctx context.Context, document *entity.Document) error {
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, document); err != nil {
+			return err
+		}
+
+		payload, err := json.Marshal(messaging.NewDocumentMessage(document))
+		if err != nil {
+			return fmt.Errorf("erro ao serializar mensagem: %w", err)
+		}
+
+		event, err := entity.NewOutboxEvent("document", document.ExternalID, entity.OutboxEventDocumentCreated, payload)
+		if err != nil {
+			return err
+		}
+		return s.outboxRepo.Create(ctx, event)
+	})
+	if err == nil {
+		return nil
+	}
+
+	// Envio simultâneo do mesmo conteúdo: o índice único garante que apenas um seja aceito
+	if errors.Is(err, repository.ErrDuplicateDocumentHash) {
+		if existing, findErr := s.findDuplicate(ctx, document.UserID, document.SHA256); findErr == nil && existing != nil {
+			return &DuplicateDocumentError{Existing: existing}
+		}
+		return ErrDuplicateDocument
+	}
+	return fmt.Errorf("erro ao salvar documento: %w", err)
+}
+
+// findDuplicate retorna o documento mais antigo do usuário com o mesmo hash, se houver
+func (s *DocumentService) findDuplicate(ctx context.Context, userID int64, hash string) (*entity.Document, error) {
+	documents, err := s.repo.FindByUserIDAndSHA256(ctx, userID, hash)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar documentos duplicados: %w", err)
+	}
+	if len(documents) == 0 {
+		return nil, nil
+	}
+	return documents[0], nil
+}
+
 // GetDocumentByExternalID obtém um documento pelo seu ID externo
 func (s *DocumentService) GetDocumentByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Document, error) {
+	return s.findAuthorizedDocument(ctx, externalID, auth.AuthorizeRead, false)
+}
+
+// findAuthorizedDocument busca o documento e verifica o acesso do usuário autenticado com authorize;
+// os membros da família do documento também têm acesso, e write exige um papel que permita alterações
+func (s *DocumentService) findAuthorizedDocument(ctx context.Context, externalID uuid.UUID, authorize func(context.Context, int64) error, write bool) (*entity.Document, error) {
 	document, err := s.repo.FindByExternalID(ctx, externalID)
 	if err != nil {
 		return nil, err
@@ -106,10 +351,14 @@ func (s *DocumentService) GetDocumentByExternalID(ctx context.Context, externalI
 	if document == nil {
 		return nil, ErrDocumentNotFound
 	}
+	if err := s.households.authorize(ctx, document.UserID, document.HouseholdID, authorize, write); err != nil {
+		return nil, err
+	}
 	return document, nil
 }
 
-// GetDocumentsByUserExternalID lista documentos de um usuário específico
+// GetDocumentsByUserExternalID lista os documentos de um usuário, incluindo os
+// compartilhados com as famílias de que ele participa
 func (s *DocumentService) GetDocumentsByUserExternalID(ctx context.Context, userExternalID uuid.UUID, page, perPage int) ([]*entity.Document, int, error) {
 	// Buscar usuário pelo externalID
 	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
@@ -119,6 +368,9 @@ func (s *DocumentService) GetDocumentsByUserExternalID(ctx context.Context, user
 	if user == nil {
 		return nil, 0, ErrUserNotFound
 	}
+	if err := auth.AuthorizeRead(ctx, user.ID); err != nil {
+		return nil, 0, err
+	}
 
 	if page < 1 {
 		page = 1
@@ -129,14 +381,14 @@ func (s *DocumentService) GetDocumentsByUserExternalID(ctx context.Context, user
 
 	offset := (page - 1) * perPage
 
-	// Contar total de documentos do usuário
-	total, err := s.repo.CountByUserID(ctx, user.ID)
+	// Contar total de documentos do usuário e das suas famílias
+	total, err := s.repo.CountAccessibleByUserID(ctx, user.ID)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	// Buscar documentos do usuário
-	documents, err := s.repo.FindByUserID(ctx, user.ID, perPage, offset)
+	// Buscar documentos do usuário e das suas famílias
+	documents, err := s.repo.FindAccessibleByUserID(ctx, user.ID, perPage, offset)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -149,14 +401,59 @@ func (s *DocumentService) GetDocumentsByUserExternalID(ctx context.Context, user
 	return documents, total, nil
 }
 
-// UpdateDocumentStatus atualiza o status de um documento
-func (s *DocumentService) UpdateDocumentStatus(ctx context.Context, externalID uuid.UUID, status entity.DocumentStatus) (*entity.Document, error) {
-	document, err := s.repo.FindByExternalID(ctx, externalID)
+// GetDocumentsByHash lista os documentos de um usuário com o hash SHA-256 informado,
+// permitindo verificar se um arquivo já foi enviado antes do upload
+func (s *DocumentService) GetDocumentsByHash(ctx context.Context, userExternalID uuid.UUID, hash string) ([]*entity.Document, error) {
+	hash = strings.ToLower(hash)
+	if decoded, err := hex.DecodeString(hash); err != nil || len(decoded) != sha256.Size {
+		return nil, ErrInvalidDocumentHash
+	}
+
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
 	if err != nil {
 		return nil, err
 	}
-	if document == nil {
-		return nil, ErrDocumentNotFound
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := auth.AuthorizeRead(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindByUserIDAndSHA256(ctx, user.ID, hash)
+}
+
+// findCategories busca as categorias informadas, que precisam ser padrão ou do usuário
+func (s *DocumentService) findCategories(ctx context.Context, userID int64, externalIDs []uuid.UUID) ([]*entity.Category, error) {
+	categories := make([]*entity.Category, 0, len(externalIDs))
+	seen := make(map[uuid.UUID]bool, len(externalIDs))
+	for _, externalID := range externalIDs {
+		if seen[externalID] {
+			continue
+		}
+		seen[externalID] = true
+
+		category, err := s.categoryRepo.FindByExternalID(ctx, externalID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar categoria: %w", err)
+		}
+		if category == nil || !category.IsAvailableTo(userID) {
+			return nil, ErrCategoryNotFound
+		}
+		categories = append(categories, category)
+	}
+	return categories, nil
+}
+
+// UpdateDocumentStatus atualiza o status de um documento; restrito aos administradores
+func (s *DocumentService) UpdateDocumentStatus(ctx context.Context, externalID uuid.UUID, status entity.DocumentStatus) (*entity.Document, error) {
+	if err := auth.RequireRole(ctx, entity.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	document, err := s.findAuthorizedDocument(ctx, externalID, auth.AuthorizeOwner, true)
+	if err != nil {
+		return nil, err
 	}
 
 	document.UpdateStatus(status)
@@ -167,21 +464,61 @@ func (s *DocumentService) UpdateDocumentStatus(ctx context.Context, externalID u
 	return document, nil
 }
 
-// DeleteDocument exclui um documento
+// DeleteDocument exclui um documento; em documentos compartilhados, os membros da
+// família que podem enviar documentos também podem excluí-los
 func (s *DocumentService) DeleteDocument(ctx context.Context, externalID uuid.UUID) error {
-	document, err := s.repo.FindByExternalID(ctx, externalID)
+	document, err := s.findAuthorizedDocument(ctx, externalID, auth.AuthorizeOwner, true)
 	if err != nil {
 		return err
 	}
-	if document == nil {
-		return ErrDocumentNotFound
+
+	if err := s.repo.Delete(ctx, document.ID); err != nil {
+		return err
+	}
+
+	s.deleteContent(ctx, document)
+	return nil
+}
+
+// OpenDocumentContent abre o conteúdo do documento no armazenamento com suporte
+// a posicionamento, para downloads parciais; o chamador deve fechar o leitor.
+// O suporte não tem acesso ao conteúdo dos arquivos.
+func (s *DocumentService) OpenDocumentContent(ctx context.Context, document *entity.Document) (io.ReadSeekCloser, error) {
+	if err := s.households.authorize(ctx, document.UserID, document.HouseholdID, auth.AuthorizeContent, false); err != nil {
+		return nil, err
 	}
+	if document.StorageKey == "" {
+		return nil, storage.ErrBlobNotFound
+	}
+	return storage.OpenRange(ctx, s.blobStore, document.StorageKey, document.Size)
+}
 
-	return s.repo.Delete(ctx, document.ID)
+// countingReader conta os bytes lidos do leitor subjacente
+type countingReader struct {
+	reader io.Reader
+	count  int64
 }
 
-// ListDocuments lista todos os documentos com paginação
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
+
+// deleteContent remove o conteúdo do armazenamento; falhas apenas deixam um arquivo órfão
+func (s *DocumentService) deleteContent(ctx context.Context, document *entity.Document) {
+	if err := s.blobStore.Delete(ctx, document.StorageKey); err != nil {
+		log.Printf("Aviso: Não foi possível remover o arquivo %s do armazenamento: %v", document.StorageKey, err)
+	}
+}
+
+// ListDocuments lista os documentos de todos os usuários com paginação; restrito
+// ao suporte e aos administradores
 func (s *DocumentService) ListDocuments(ctx context.Context, page, perPage int) ([]*entity.Document, int, error) {
+	if err := auth.RequireRole(ctx, entity.RoleSupport, entity.RoleAdmin); err != nil {
+		return nil, 0, err
+	}
+
 	if page < 1 {
 		page = 1
 	}
@@ -0,0 +1,463 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/mail"
+	"finance-assistant/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrHouseholdNotFound           = errors.New("Família não encontrada")
+	ErrHouseholdMemberNotFound     = errors.New("Membro da família não encontrado")
+	ErrHouseholdInvitationNotFound = errors.New("Convite não encontrado")
+	ErrHouseholdWriteDenied        = errors.New("Seu papel na família não permite alterar os dados compartilhados")
+	ErrLastHouseholdOwner          = errors.New("A família precisa de pelo menos um proprietário")
+	ErrAlreadyHouseholdMember      = errors.New("O usuário já participa da família")
+	ErrDuplicateInvitation         = errors.New("Já existe um convite pendente para este email")
+)
+
+type HouseholdService struct {
+	repo           repository.HouseholdRepository
+	invitationRepo repository.HouseholdInvitationRepository
+	userRepo       repository.UserRepository
+	transactor     repository.Transactor
+	mailer         mail.Mailer
+}
+
+func NewHouseholdService(
+	repo repository.HouseholdRepository,
+	invitationRepo repository.HouseholdInvitationRepository,
+	userRepo repository.UserRepository,
+	transactor repository.Transactor,
+	mailer mail.Mailer,
+) *HouseholdService {
+	return &HouseholdService{
+		repo:           repo,
+		invitationRepo: invitationRepo,
+		userRepo:       userRepo,
+		transactor:     transactor,
+		mailer:         mailer,
+	}
+}
+
+// CreateHousehold cria uma família tendo o usuário autenticado como proprietário
+func (s *HouseholdService) CreateHousehold(ctx context.Context, name string) (*entity.Household, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+
+	household, err := entity.NewHousehold(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, household); err != nil {
+			return err
+		}
+		owner, err := entity.NewHouseholdMember(household.ID, principal.UserID, entity.HouseholdRoleOwner)
+		if err != nil {
+			return err
+		}
+		return s.repo.AddMember(ctx, owner)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar família: %w", err)
+	}
+
+	return household, nil
+}
+
+// ListHouseholds lista as famílias de que o usuário autenticado participa
+func (s *HouseholdService) ListHouseholds(ctx context.Context) ([]*entity.Household, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+
+	households, err := s.repo.FindByUserID(ctx, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if households == nil {
+		return []*entity.Household{}, nil
+	}
+	return households, nil
+}
+
+// GetHousehold retorna a família e seus membros; disponível aos membros, ao
+// suporte e aos administradores
+func (s *HouseholdService) GetHousehold(ctx context.Context, externalID uuid.UUID) (*entity.Household, []*entity.HouseholdMember, error) {
+	household, err := s.findHousehold(ctx, externalID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members, err := s.repo.FindMembers(ctx, household.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return household, members, nil
+}
+
+// DeleteHousehold exclui a família; os documentos e transações compartilhados
+// voltam a pertencer apenas a quem os enviou
+func (s *HouseholdService) DeleteHousehold(ctx context.Context, externalID uuid.UUID) error {
+	household, err := s.findHousehold(ctx, externalID, true)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, household.ID)
+}
+
+// ChangeMemberRole altera o papel de um membro; restrito aos proprietários
+func (s *HouseholdService) ChangeMemberRole(ctx context.Context, householdExternalID, userExternalID uuid.UUID, role entity.HouseholdRole) (*entity.HouseholdMember, error) {
+	if !role.IsValid() {
+		return nil, entity.ErrInvalidHouseholdRole
+	}
+
+	household, err := s.findHousehold(ctx, householdExternalID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	member, members, err := s.findMember(ctx, household.ID, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Role == entity.HouseholdRoleOwner && role != entity.HouseholdRoleOwner && countOwners(members) == 1 {
+		return nil, ErrLastHouseholdOwner
+	}
+
+	if err := s.repo.UpdateMemberRole(ctx, household.ID, member.UserID, role); err != nil {
+		return nil, err
+	}
+
+	member.Role = role
+	return member, nil
+}
+
+// RemoveMember remove um membro da família; os proprietários removem qualquer
+// membro e os demais podem apenas sair da família
+func (s *HouseholdService) RemoveMember(ctx context.Context, householdExternalID, userExternalID uuid.UUID) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return auth.ErrUnauthenticated
+	}
+
+	household, err := s.findHousehold(ctx, householdExternalID, userExternalID != principal.UserExternalID)
+	if err != nil {
+		return err
+	}
+
+	member, members, err := s.findMember(ctx, household.ID, userExternalID)
+	if err != nil {
+		return err
+	}
+	if member.Role == entity.HouseholdRoleOwner && countOwners(members) == 1 {
+		return ErrLastHouseholdOwner
+	}
+
+	return s.repo.RemoveMember(ctx, household.ID, member.UserID)
+}
+
+// InviteMember convida o email informado para a família e envia o convite por
+// email; restrito aos proprietários. Uma falha no envio não desfaz o convite,
+// que continua disponível ao convidado em ListMyInvitations.
+func (s *HouseholdService) InviteMember(ctx context.Context, householdExternalID uuid.UUID, email string, role entity.HouseholdRole) (*entity.HouseholdInvitation, error) {
+	household, err := s.findHousehold(ctx, householdExternalID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	principal, _ := auth.PrincipalFromContext(ctx)
+	invitation, err := entity.NewHouseholdInvitation(household, email, role, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Não convidar quem já participa da família
+	user, err := s.userRepo.FindByEmail(ctx, invitation.Email)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	if user != nil {
+		member, err := s.repo.FindMember(ctx, household.ID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if member != nil {
+			return nil, ErrAlreadyHouseholdMember
+		}
+	}
+
+	if err := s.invitationRepo.Create(ctx, invitation); err != nil {
+		if errors.Is(err, repository.ErrDuplicateHouseholdInvitation) {
+			return nil, ErrDuplicateInvitation
+		}
+		return nil, err
+	}
+
+	if err := s.mailer.Send(ctx, invitationMessage(invitation)); err != nil {
+		log.Printf("Erro ao enviar o convite %s para %s: %v", invitation.ExternalID, invitation.Email, err)
+	}
+
+	return invitation, nil
+}
+
+// invitationMessage monta o email do convite, com o que o convidado precisa
+// para aceitá-lo ou recusá-lo pela API
+func invitationMessage(invitation *entity.HouseholdInvitation) mail.Message {
+	return mail.Message{
+		To:      invitation.Email,
+		Subject: fmt.Sprintf("Convite para a família %s", invitation.HouseholdName),
+		Body: fmt.Sprintf(
+			"Você foi convidado para participar da família %s com o papel %s.\n\n"+
+				"Entre com este email e aceite o convite em POST /api/v1/household-invitations/%s/accept "+
+				"ou recuse em POST /api/v1/household-invitations/%s/decline até %s.\n",
+			invitation.HouseholdName, invitation.Role, invitation.ExternalID, invitation.ExternalID,
+			invitation.ExpiresAt.Format("02/01/2006 15:04 MST"),
+		),
+	}
+}
+
+// ListInvitations lista os convites pendentes da família; disponível aos membros
+func (s *HouseholdService) ListInvitations(ctx context.Context, householdExternalID uuid.UUID) ([]*entity.HouseholdInvitation, error) {
+	household, err := s.findHousehold(ctx, householdExternalID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.invitationRepo.FindPendingByHouseholdID(ctx, household.ID)
+}
+
+// RevokeInvitation cancela um convite pendente; restrito aos proprietários
+func (s *HouseholdService) RevokeInvitation(ctx context.Context, householdExternalID, invitationExternalID uuid.UUID) error {
+	household, err := s.findHousehold(ctx, householdExternalID, true)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := s.invitationRepo.FindByExternalID(ctx, invitationExternalID)
+	if err != nil {
+		return err
+	}
+	if invitation == nil || invitation.HouseholdID != household.ID {
+		return ErrHouseholdInvitationNotFound
+	}
+
+	if err := invitation.Respond(entity.HouseholdInvitationRevoked); err != nil {
+		return err
+	}
+	return s.invitationRepo.UpdateStatus(ctx, invitation)
+}
+
+// ListMyInvitations lista os convites pendentes enviados para o email do usuário autenticado
+func (s *HouseholdService) ListMyInvitations(ctx context.Context) ([]*entity.HouseholdInvitation, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.invitationRepo.FindPendingByEmail(ctx, user.Email)
+}
+
+// AcceptInvitation aceita o convite, incluindo o usuário autenticado na família
+// com o papel do convite
+func (s *HouseholdService) AcceptInvitation(ctx context.Context, invitationExternalID uuid.UUID) (*entity.Household, error) {
+	user, invitation, err := s.findMyInvitation(ctx, invitationExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	household, err := s.repo.FindByExternalID(ctx, invitation.HouseholdExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if household == nil {
+		return nil, ErrHouseholdInvitationNotFound
+	}
+
+	existing, err := s.repo.FindMember(ctx, household.ID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrAlreadyHouseholdMember
+	}
+
+	if err := invitation.Respond(entity.HouseholdInvitationAccepted); err != nil {
+		return nil, err
+	}
+	member, err := entity.NewHouseholdMember(household.ID, user.ID, invitation.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.invitationRepo.UpdateStatus(ctx, invitation); err != nil {
+			return err
+		}
+		return s.repo.AddMember(ctx, member)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return household, nil
+}
+
+// DeclineInvitation recusa o convite
+func (s *HouseholdService) DeclineInvitation(ctx context.Context, invitationExternalID uuid.UUID) error {
+	_, invitation, err := s.findMyInvitation(ctx, invitationExternalID)
+	if err != nil {
+		return err
+	}
+
+	if err := invitation.Respond(entity.HouseholdInvitationDeclined); err != nil {
+		return err
+	}
+	return s.invitationRepo.UpdateStatus(ctx, invitation)
+}
+
+// findHousehold busca a família e verifica se o usuário autenticado participa
+// dela; manage exige o papel de proprietário. Administradores acessam todas as
+// famílias e o suporte consulta todas.
+func (s *HouseholdService) findHousehold(ctx context.Context, externalID uuid.UUID, manage bool) (*entity.Household, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+
+	household, err := s.repo.FindByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+	if household == nil {
+		return nil, ErrHouseholdNotFound
+	}
+
+	if principal.Role == entity.RoleAdmin || (!manage && principal.Role == entity.RoleSupport) {
+		return household, nil
+	}
+
+	member, err := s.repo.FindMember(ctx, household.ID, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		// Não revelar a existência de famílias das quais o usuário não participa
+		return nil, ErrHouseholdNotFound
+	}
+	if manage && member.Role != entity.HouseholdRoleOwner {
+		return nil, auth.ErrForbidden
+	}
+
+	return household, nil
+}
+
+// findMember busca o membro da família pelo ID externo do usuário e retorna
+// também todos os membros, para as verificações de proprietário
+func (s *HouseholdService) findMember(ctx context.Context, householdID int64, userExternalID uuid.UUID) (*entity.HouseholdMember, []*entity.HouseholdMember, error) {
+	members, err := s.repo.FindMembers(ctx, householdID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, member := range members {
+		if member.UserExternalID == userExternalID {
+			return member, members, nil
+		}
+	}
+	return nil, nil, ErrHouseholdMemberNotFound
+}
+
+// findMyInvitation busca um convite aberto enviado para o email do usuário autenticado
+func (s *HouseholdService) findMyInvitation(ctx context.Context, invitationExternalID uuid.UUID) (*entity.User, *entity.HouseholdInvitation, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invitation, err := s.invitationRepo.FindByExternalID(ctx, invitationExternalID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if invitation == nil || !invitation.IsFor(user.Email) {
+		return nil, nil, ErrHouseholdInvitationNotFound
+	}
+	if !invitation.IsOpen(time.Now()) {
+		return nil, nil, entity.ErrHouseholdInvitationClosed
+	}
+
+	return user, invitation, nil
+}
+
+// currentUser carrega o usuário autenticado
+func (s *HouseholdService) currentUser(ctx context.Context) (*entity.User, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+
+	user, err := s.userRepo.FindByID(ctx, principal.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	return user, nil
+}
+
+func countOwners(members []*entity.HouseholdMember) int {
+	owners := 0
+	for _, member := range members {
+		if member.Role == entity.HouseholdRoleOwner {
+			owners++
+		}
+	}
+	return owners
+}
+
+// householdAccess estende as verificações de posse aos membros da família a
+// que o recurso pertence
+type householdAccess struct {
+	repo repository.HouseholdRepository
+}
+
+// authorize verifica o acesso ao recurso com authorize e, quando negado, libera
+// os membros da família do recurso; write exige um papel que permita alterações.
+// O suporte não recebe acesso adicional: já consulta os metadados de todos os
+// usuários e nunca acessa o conteúdo dos arquivos.
+func (a householdAccess) authorize(ctx context.Context, ownerID, householdID int64, authorize func(context.Context, int64) error, write bool) error {
+	err := authorize(ctx, ownerID)
+	if err == nil || householdID == 0 || !errors.Is(err, auth.ErrForbidden) {
+		return err
+	}
+
+	principal, _ := auth.PrincipalFromContext(ctx)
+	if principal.Role == entity.RoleSupport {
+		return err
+	}
+
+	member, findErr := a.repo.FindMember(ctx, householdID, principal.UserID)
+	if findErr != nil {
+		return fmt.Errorf("erro ao buscar membro da família: %w", findErr)
+	}
+	if member == nil || (write && !member.Role.CanWrite()) {
+		return err
+	}
+	return nil
+}
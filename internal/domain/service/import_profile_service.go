@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"finance-assistant/internal/pkg/csvimport"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrImportProfileNotFound = errors.New("Perfil de importação não encontrado")
+)
+
+type ImportProfileService struct {
+	repo     repository.ImportProfileRepository
+	userRepo repository.UserRepository
+}
+
+func NewImportProfileService(repo repository.ImportProfileRepository, userRepo repository.UserRepository) *ImportProfileService {
+	return &ImportProfileService{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+// CreateProfile cria um perfil de importação para o usuário
+func (s *ImportProfileService) CreateProfile(ctx context.Context, userExternalID uuid.UUID, name string, layout *csvimport.Layout, currency string) (*entity.ImportProfile, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := entity.NewImportProfile(user.ID, name, layout, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// GetProfile obtém um perfil do usuário pelo seu ID externo
+func (s *ImportProfileService) GetProfile(ctx context.Context, userExternalID, profileExternalID uuid.UUID) (*entity.ImportProfile, error) {
+	return s.findProfile(ctx, userExternalID, profileExternalID, auth.AuthorizeRead)
+}
+
+// ListProfiles lista os perfis de importação do usuário
+func (s *ImportProfileService) ListProfiles(ctx context.Context, userExternalID uuid.UUID, page, perPage int) ([]*entity.ImportProfile, int, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeRead)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	page, perPage = normalizePagination(page, perPage)
+	offset := (page - 1) * perPage
+
+	total, err := s.repo.CountByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	profiles, err := s.repo.FindByUserID(ctx, user.ID, perPage, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return profiles, total, nil
+}
+
+// UpdateProfile atualiza um perfil do usuário
+func (s *ImportProfileService) UpdateProfile(ctx context.Context, userExternalID, profileExternalID uuid.UUID, name string, layout *csvimport.Layout, currency string) (*entity.ImportProfile, error) {
+	profile, err := s.findProfile(ctx, userExternalID, profileExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := profile.Update(name, layout, currency); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// DeleteProfile exclui um perfil do usuário
+func (s *ImportProfileService) DeleteProfile(ctx context.Context, userExternalID, profileExternalID uuid.UUID) error {
+	profile, err := s.findProfile(ctx, userExternalID, profileExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, profile.ID)
+}
+
+// DetectProfile sugere um perfil (não persistido) a partir do conteúdo de um arquivo CSV
+func (s *ImportProfileService) DetectProfile(ctx context.Context, userExternalID uuid.UUID, name string, content []byte) (*entity.ImportProfile, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	layout, err := csvimport.Detect(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		name = "Perfil detectado"
+	}
+	return entity.NewImportProfile(user.ID, name, layout, entity.DefaultCurrency)
+}
+
+// findUser busca o usuário e verifica o acesso do usuário autenticado com authorize
+func (s *ImportProfileService) findUser(ctx context.Context, userExternalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.User, error) {
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := authorize(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// findProfile busca o perfil do usuário, verificando o acesso com authorize
+func (s *ImportProfileService) findProfile(ctx context.Context, userExternalID, profileExternalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.ImportProfile, error) {
+	user, err := s.findUser(ctx, userExternalID, authorize)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.findUserProfile(ctx, user, profileExternalID)
+}
+
+// findUserProfile busca o perfil garantindo que pertence ao usuário
+func (s *ImportProfileService) findUserProfile(ctx context.Context, user *entity.User, profileExternalID uuid.UUID) (*entity.ImportProfile, error) {
+	profile, err := s.repo.FindByExternalID(ctx, profileExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil || profile.UserID != user.ID {
+		return nil, ErrImportProfileNotFound
+	}
+	return profile, nil
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/currency"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// InstallmentPlans agrupa as compras parceladas do usuário e, quando pedido, o
+// saldo restante de cada uma convertido para a moeda do relatório
+type InstallmentPlans struct {
+	Plans            []*entity.InstallmentPlan
+	ConvertedBalance map[int64]entity.Money // Pelo ID da compra parcelada
+}
+
+type InstallmentService struct {
+	repo      repository.InstallmentPlanRepository
+	userRepo  repository.UserRepository
+	converter *currency.Converter
+}
+
+func NewInstallmentService(repo repository.InstallmentPlanRepository, userRepo repository.UserRepository, converter *currency.Converter) *InstallmentService {
+	return &InstallmentService{
+		repo:      repo,
+		userRepo:  userRepo,
+		converter: converter,
+	}
+}
+
+// ListPlans lista as compras parceladas do usuário e as com parcelas
+// compartilhadas com as suas famílias, das que terminam antes para
+// as que terminam depois; sem includeFinished, apenas as com parcelas restantes.
+// Com reportCurrency, o saldo restante de cada uma é convertido para ela pela
+// cotação da data da última parcela importada, como nos demais relatórios.
+func (s *InstallmentService) ListPlans(ctx context.Context, userExternalID uuid.UUID, includeFinished bool, reportCurrency string) (*InstallmentPlans, error) {
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := auth.AuthorizeRead(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	plans, err := s.repo.FindAccessibleByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !includeFinished {
+		plans = slices.DeleteFunc(plans, (*entity.InstallmentPlan).IsFinished)
+	}
+	slices.SortStableFunc(plans, func(a, b *entity.InstallmentPlan) int {
+		return a.EndDate().Compare(b.EndDate())
+	})
+	if plans == nil {
+		plans = []*entity.InstallmentPlan{}
+	}
+
+	result := &InstallmentPlans{Plans: plans}
+	if reportCurrency != "" {
+		reportCurrency, err = entity.ParseCurrency(reportCurrency)
+		if err != nil {
+			return nil, err
+		}
+		result.ConvertedBalance = make(map[int64]entity.Money, len(plans))
+		for _, plan := range plans {
+			balance := entity.Money{Amount: plan.OutstandingBalance(), Currency: plan.Currency}
+			converted, err := s.converter.Convert(ctx, balance, reportCurrency, plan.LastInstallmentDate)
+			if err != nil {
+				return nil, err
+			}
+			result.ConvertedBalance[plan.ID] = converted
+		}
+	}
+	return result, nil
+}
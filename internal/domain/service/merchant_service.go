@@ -0,0 +1,300 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/currency"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"finance-assistant/internal/pkg/merchant"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMerchantNotFound     = errors.New("Estabelecimento não encontrado")
+	ErrInvalidMerchantMerge = errors.New("Informe ao menos um estabelecimento diferente do estabelecimento de destino")
+	ErrInvalidSpendingRange = errors.New("A data inicial deve ser anterior à data final")
+)
+
+type MerchantService struct {
+	repo            repository.MerchantRepository
+	userRepo        repository.UserRepository
+	transactionRepo repository.TransactionRepository
+	transactor      repository.Transactor
+	converter       *currency.Converter
+}
+
+func NewMerchantService(
+	repo repository.MerchantRepository,
+	userRepo repository.UserRepository,
+	transactionRepo repository.TransactionRepository,
+	transactor repository.Transactor,
+	converter *currency.Converter,
+) *MerchantService {
+	return &MerchantService{
+		repo:            repo,
+		userRepo:        userRepo,
+		transactionRepo: transactionRepo,
+		transactor:      transactor,
+		converter:       converter,
+	}
+}
+
+// ListSpending soma as transações do usuário, incluindo as compartilhadas com
+// as suas famílias, por estabelecimento e moeda no período, do maior para o menor gasto; datas vazias não limitam o período.
+// Com reportCurrency, os totais de cada estabelecimento são convertidos para
+// ela pela cotação do dia de cada transação.
+func (s *MerchantService) ListSpending(ctx context.Context, userExternalID uuid.UUID, from, to time.Time, reportCurrency string) ([]*entity.MerchantSpending, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeRead)
+	if err != nil {
+		return nil, err
+	}
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return nil, ErrInvalidSpendingRange
+	}
+
+	if reportCurrency != "" {
+		reportCurrency, err = entity.ParseCurrency(reportCurrency)
+		if err != nil {
+			return nil, err
+		}
+		daily, err := s.repo.SumAccessibleDailySpendingByUserID(ctx, user.ID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		return convertSpending(ctx, s.converter, daily, reportCurrency)
+	}
+
+	spending, err := s.repo.SumAccessibleSpendingByUserID(ctx, user.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if spending == nil {
+		return []*entity.MerchantSpending{}, nil
+	}
+	return spending, nil
+}
+
+// MergeMerchants mescla os estabelecimentos sources em target, que recebe os
+// seus apelidos e transações
+func (s *MerchantService) MergeMerchants(ctx context.Context, userExternalID, targetExternalID uuid.UUID, sourceExternalIDs []uuid.UUID) (*entity.Merchant, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.findMerchant(ctx, user.ID, targetExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []*entity.Merchant
+	var sourceIDs []int64
+	seen := map[uuid.UUID]bool{targetExternalID: true}
+	for _, externalID := range sourceExternalIDs {
+		if seen[externalID] {
+			continue
+		}
+		seen[externalID] = true
+
+		source, err := s.findMerchant(ctx, user.ID, externalID)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+		sourceIDs = append(sourceIDs, source.ID)
+	}
+	if len(sources) == 0 {
+		return nil, ErrInvalidMerchantMerge
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.repo.Merge(ctx, target.ID, sourceIDs)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao mesclar estabelecimentos: %w", err)
+	}
+
+	target.Absorb(sources)
+	return target, nil
+}
+
+// AssignMerchants reconhece o estabelecimento das transações do usuário que
+// ainda não têm um, como as importadas antes do diretório de estabelecimentos,
+// e retorna quantas foram associadas
+func (s *MerchantService) AssignMerchants(ctx context.Context, userExternalID uuid.UUID) (int, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return 0, err
+	}
+
+	resolver := newMerchantResolver(s.repo, user.ID)
+	assigned := 0
+	for offset := 0; ; offset += historyBatchSize {
+		transactions, err := s.transactionRepo.FindByUserID(ctx, user.ID, historyBatchSize, offset)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, transaction := range transactions {
+			if transaction.MerchantID != 0 {
+				continue
+			}
+			if err := resolver.resolve(ctx, transaction); err != nil {
+				return 0, err
+			}
+			if transaction.MerchantID == 0 {
+				continue
+			}
+			if err := s.transactionRepo.UpdateMerchant(ctx, transaction.ID, transaction.MerchantID); err != nil {
+				return 0, fmt.Errorf("erro ao atualizar estabelecimento da transação: %w", err)
+			}
+			assigned++
+		}
+
+		if len(transactions) < historyBatchSize {
+			return assigned, nil
+		}
+	}
+}
+
+// findMerchant busca o estabelecimento e verifica se pertence ao usuário
+func (s *MerchantService) findMerchant(ctx context.Context, userID int64, externalID uuid.UUID) (*entity.Merchant, error) {
+	found, err := s.repo.FindByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+	if found == nil || found.UserID != userID {
+		return nil, ErrMerchantNotFound
+	}
+	return found, nil
+}
+
+// findUser busca o usuário e verifica o acesso do usuário autenticado com authorize
+func (s *MerchantService) findUser(ctx context.Context, userExternalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.User, error) {
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := authorize(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// merchantResolver associa transações aos estabelecimentos de um usuário pelo
+// nome normalizado, criando os que ainda não existem
+type merchantResolver struct {
+	repo     repository.MerchantRepository
+	userID   int64
+	resolved map[string]*entity.Merchant
+}
+
+func newMerchantResolver(repo repository.MerchantRepository, userID int64) *merchantResolver {
+	return &merchantResolver{
+		repo:     repo,
+		userID:   userID,
+		resolved: make(map[string]*entity.Merchant),
+	}
+}
+
+// resolve associa a transação ao seu estabelecimento; transações cuja descrição
+// não contém um nome reconhecível ficam sem estabelecimento
+func (r *merchantResolver) resolve(ctx context.Context, transaction *entity.Transaction) error {
+	name := merchant.Normalize(transaction.MerchantDescription())
+	alias := merchant.Key(name)
+	if alias == "" {
+		return nil
+	}
+
+	found, ok := r.resolved[alias]
+	if !ok {
+		var err error
+		found, err = r.findOrCreate(ctx, name, alias)
+		if err != nil {
+			return err
+		}
+		r.resolved[alias] = found
+	}
+
+	if found != nil {
+		transaction.UpdateMerchant(found)
+	}
+	return nil
+}
+
+func (r *merchantResolver) findOrCreate(ctx context.Context, name, alias string) (*entity.Merchant, error) {
+	found, err := r.repo.FindByAlias(ctx, r.userID, alias)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar estabelecimento: %w", err)
+	}
+	if found != nil {
+		return found, nil
+	}
+
+	created, err := entity.NewMerchant(r.userID, name)
+	if err != nil {
+		log.Printf("Estabelecimento %q ignorado: %v", name, err)
+		return nil, nil
+	}
+
+	// Outro documento do usuário pode ter criado o mesmo estabelecimento ao mesmo tempo
+	if err := r.repo.Create(ctx, created); err != nil {
+		if errors.Is(err, repository.ErrDuplicateMerchant) {
+			return r.repo.FindByAlias(ctx, r.userID, alias)
+		}
+		return nil, fmt.Errorf("erro ao criar estabelecimento: %w", err)
+	}
+	return created, nil
+}
+
+// convertSpending soma os gastos diários de cada estabelecimento convertidos
+// para a moeda to pela cotação de cada dia, do maior para o menor gasto
+func convertSpending(ctx context.Context, converter *currency.Converter, daily []*entity.MerchantDailySpending, to string) ([]*entity.MerchantSpending, error) {
+	spending := []*entity.MerchantSpending{}
+	byMerchant := make(map[int64]*entity.MerchantSpending)
+	for _, day := range daily {
+		spent, err := converter.Convert(ctx, entity.Money{Amount: day.Spent, Currency: day.Currency}, to, day.Date)
+		if err != nil {
+			return nil, err
+		}
+		received, err := converter.Convert(ctx, entity.Money{Amount: day.Received, Currency: day.Currency}, to, day.Date)
+		if err != nil {
+			return nil, err
+		}
+
+		total, ok := byMerchant[day.MerchantID]
+		if !ok {
+			total = &entity.MerchantSpending{
+				MerchantID:         day.MerchantID,
+				MerchantExternalID: day.MerchantExternalID,
+				Name:               day.Name,
+				Currency:           to,
+			}
+			byMerchant[day.MerchantID] = total
+			spending = append(spending, total)
+		}
+		total.Transactions += day.Transactions
+		total.Spent += spent.Amount
+		total.Received += received.Amount
+	}
+
+	slices.SortStableFunc(spending, func(a, b *entity.MerchantSpending) int {
+		if c := cmp.Compare(b.Spent, a.Spent); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return spending, nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/messaging"
+	"finance-assistant/internal/domain/repository"
+)
+
+const (
+	// outboxMaxRetryDelay limita o intervalo entre tentativas de publicação de um evento
+	outboxMaxRetryDelay = 5 * time.Minute
+	// outboxRetention é por quanto tempo eventos publicados são mantidos para auditoria
+	outboxRetention       = 7 * 24 * time.Hour
+	outboxCleanupInterval = time.Hour
+)
+
+// OutboxRelay publica os eventos gravados no outbox. Cada lote é bloqueado com
+// SKIP LOCKED e marcado como publicado na mesma transação, de forma que várias
+// instâncias possam rodar o relay sem publicar o mesmo evento.
+type OutboxRelay struct {
+	repo       repository.OutboxRepository
+	transactor repository.Transactor
+	publisher  messaging.DocumentPublisher
+	batchSize  int
+	interval   time.Duration
+}
+
+func NewOutboxRelay(
+	repo repository.OutboxRepository,
+	transactor repository.Transactor,
+	publisher messaging.DocumentPublisher,
+	batchSize int,
+	interval time.Duration,
+) *OutboxRelay {
+	if batchSize < 1 {
+		batchSize = 50
+	}
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	return &OutboxRelay{
+		repo:       repo,
+		transactor: transactor,
+		publisher:  publisher,
+		batchSize:  batchSize,
+		interval:   interval,
+	}
+}
+
+// Run publica os eventos pendentes periodicamente até o contexto ser cancelado
+func (r *OutboxRelay) Run(ctx context.Context) {
+	log.Printf("Relay do outbox iniciado (lote: %d, intervalo: %s)", r.batchSize, r.interval)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	var lastCleanup time.Time
+	for {
+		// Publicar lotes seguidos enquanto houver eventos pendentes e o broker responder
+		for ctx.Err() == nil {
+			published, err := r.relayBatch(ctx)
+			if err != nil {
+				log.Printf("Erro ao publicar eventos do outbox: %v", err)
+				break
+			}
+			if published < r.batchSize {
+				break
+			}
+		}
+
+		if time.Since(lastCleanup) >= outboxCleanupInterval {
+			r.cleanup(ctx)
+			lastCleanup = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Println("Relay do outbox encerrado")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayBatch publica um lote de eventos e retorna quantos foram publicados. A
+// transação não é cancelada junto com ctx: o resultado de um envio em andamento
+// sempre é registrado, para que um evento entregue não seja reenviado.
+func (r *OutboxRelay) relayBatch(ctx context.Context) (int, error) {
+	published := 0
+	err := r.transactor.WithinTransaction(context.WithoutCancel(ctx), func(txCtx context.Context) error {
+		events, err := r.repo.ClaimPending(txCtx, r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			if err := r.publish(txCtx, event); err != nil {
+				event.Attempts++
+				nextAttemptAt := time.Now().Add(event.RetryDelay(outboxMaxRetryDelay))
+				log.Printf("Falha ao publicar evento %d (%s) do outbox, tentativa %d: %v",
+					event.ID, event.EventType, event.Attempts, err)
+
+				// O broker provavelmente está indisponível: encerrar o lote e tentar mais tarde
+				return r.repo.MarkFailed(txCtx, event.ID, err.Error(), nextAttemptAt)
+			}
+
+			if err := r.repo.MarkPublished(txCtx, event.ID); err != nil {
+				return err
+			}
+			published++
+		}
+		return nil
+	})
+	return published, err
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, event *entity.OutboxEvent) error {
+	// event_id permite que consumidores descartem uma eventual reentrega
+	return r.publisher.PublishDocument(ctx, messaging.Message{
+		Key:     event.AggregateID.String(),
+		Payload: event.Payload,
+		Headers: map[string]string{
+			"event_id":   strconv.FormatInt(event.ID, 10),
+			"event_type": event.EventType,
+		},
+	})
+}
+
+// cleanup remove eventos publicados há mais tempo que o período de retenção
+func (r *OutboxRelay) cleanup(ctx context.Context) {
+	deleted, err := r.repo.DeletePublishedBefore(ctx, time.Now().Add(-outboxRetention))
+	if err != nil {
+		log.Printf("Aviso: Não foi possível remover eventos antigos do outbox: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("%d eventos publicados removidos do outbox", deleted)
+	}
+}
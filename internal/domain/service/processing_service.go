@@ -0,0 +1,614 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"time"
+
+	"finance-assistant/internal/domain/categorization"
+	"finance-assistant/internal/domain/dedup"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/extractor"
+	"finance-assistant/internal/domain/repository"
+	"finance-assistant/internal/domain/storage"
+	"finance-assistant/internal/pkg/merchant"
+)
+
+type DocumentProcessingService struct {
+	repo             repository.DocumentRepository
+	transactionRepo  repository.TransactionRepository
+	categoryRepo     repository.CategoryRepository
+	categoryRuleRepo repository.CategoryRuleRepository
+	merchantRepo     repository.MerchantRepository
+	installmentRepo  repository.InstallmentPlanRepository
+	recurringRepo    repository.RecurringSeriesRepository
+	householdRepo    repository.HouseholdRepository
+	accountRepo      repository.AccountRepository
+	statementRepo    repository.AccountStatementRepository
+	transferRepo     repository.TransferRepository
+	duplicateRepo    repository.TransactionDuplicateRepository
+	transactor       repository.Transactor
+	blobStore        storage.BlobStore
+	registry         *extractor.Registry
+	transferWindow   int // Diferença máxima, em dias, entre os lados de uma transferência
+}
+
+func NewDocumentProcessingService(
+	repo repository.DocumentRepository,
+	transactionRepo repository.TransactionRepository,
+	categoryRepo repository.CategoryRepository,
+	categoryRuleRepo repository.CategoryRuleRepository,
+	merchantRepo repository.MerchantRepository,
+	installmentRepo repository.InstallmentPlanRepository,
+	recurringRepo repository.RecurringSeriesRepository,
+	householdRepo repository.HouseholdRepository,
+	accountRepo repository.AccountRepository,
+	statementRepo repository.AccountStatementRepository,
+	transferRepo repository.TransferRepository,
+	duplicateRepo repository.TransactionDuplicateRepository,
+	transactor repository.Transactor,
+	blobStore storage.BlobStore,
+	registry *extractor.Registry,
+	transferWindow int,
+) *DocumentProcessingService {
+	return &DocumentProcessingService{
+		repo:             repo,
+		transactionRepo:  transactionRepo,
+		categoryRepo:     categoryRepo,
+		categoryRuleRepo: categoryRuleRepo,
+		merchantRepo:     merchantRepo,
+		installmentRepo:  installmentRepo,
+		recurringRepo:    recurringRepo,
+		householdRepo:    householdRepo,
+		accountRepo:      accountRepo,
+		statementRepo:    statementRepo,
+		transferRepo:     transferRepo,
+		duplicateRepo:    duplicateRepo,
+		transactor:       transactor,
+		blobStore:        blobStore,
+		registry:         registry,
+		transferWindow:   transferWindow,
+	}
+}
+
+// ProcessDocument executa a extração de um documento e atualiza seu status.
+// Falhas de extração marcam o documento como failed e não são retornadas;
+// apenas erros de persistência do status são devolvidos ao chamador para
+// que a mensagem seja reprocessada.
+func (s *DocumentProcessingService) ProcessDocument(ctx context.Context, documentID int64) error {
+	document, err := s.repo.FindByID(ctx, documentID)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar documento: %w", err)
+	}
+	if document == nil {
+		log.Printf("Documento %d não encontrado, ignorando mensagem", documentID)
+		return nil
+	}
+
+	// Mensagens podem ser reentregues pelo broker; documentos já finalizados são ignorados
+	if document.Status == entity.DocumentStatusProcessed || document.Status == entity.DocumentStatusUnreconciled {
+		log.Printf("Documento %s já processado, ignorando mensagem", document.ExternalID)
+		return nil
+	}
+
+	if err := s.repo.UpdateStatus(ctx, document.ID, entity.DocumentStatusProcessing); err != nil {
+		return fmt.Errorf("erro ao atualizar status do documento: %w", err)
+	}
+	document.UpdateStatus(entity.DocumentStatusProcessing)
+
+	status := entity.DocumentStatusProcessed
+	reconciled, err := s.extract(ctx, document)
+	switch {
+	case err != nil:
+		log.Printf("Erro ao processar documento %s: %v", document.ExternalID, err)
+		status = entity.DocumentStatusFailed
+	case !reconciled:
+		status = entity.DocumentStatusUnreconciled
+	}
+
+	if err := s.repo.UpdateStatus(ctx, document.ID, status); err != nil {
+		return fmt.Errorf("erro ao atualizar status do documento: %w", err)
+	}
+	document.UpdateStatus(status)
+
+	log.Printf("Documento %s finalizado com status %s", document.ExternalID, status)
+	return nil
+}
+
+// MarkFailed marca como failed um documento cujo processamento não pôde ser
+// concluído, para que não permaneça pendente. Documentos já finalizados são
+// mantidos como estão.
+func (s *DocumentProcessingService) MarkFailed(ctx context.Context, documentID int64) error {
+	document, err := s.repo.FindByID(ctx, documentID)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar documento: %w", err)
+	}
+	if document == nil || document.Status == entity.DocumentStatusProcessed || document.Status == entity.DocumentStatusUnreconciled {
+		return nil
+	}
+
+	if err := s.repo.UpdateStatus(ctx, document.ID, entity.DocumentStatusFailed); err != nil {
+		return fmt.Errorf("erro ao atualizar status do documento: %w", err)
+	}
+	return nil
+}
+
+// extract lê o conteúdo do documento, executa o extrator correspondente,
+// persiste as transações extraídas e concilia os extratos com as contas.
+// Retorna false quando o saldo de algum extrato não confere.
+func (s *DocumentProcessingService) extract(ctx context.Context, document *entity.Document) (bool, error) {
+	content, err := s.readContent(ctx, document)
+	if err != nil {
+		return false, err
+	}
+
+	ext, err := s.registry.Resolve(document.DocumentType, document.ContentType)
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("Processando documento %s com extrator %s", document.ExternalID, ext.Name())
+	result, err := ext.Extract(ctx, document, content)
+	if err != nil {
+		return false, fmt.Errorf("erro no extrator %s: %w", ext.Name(), err)
+	}
+
+	for _, statement := range result.Statements {
+		log.Printf("Extrato da conta %s (%s) no documento %s: %s a %s",
+			statement.AccountKey, statement.AccountType, document.ExternalID,
+			statement.StartDate.Format("2006-01-02"), statement.EndDate.Format("2006-01-02"))
+	}
+
+	accounts, err := s.matchAccounts(ctx, document, result)
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.categorize(ctx, document, result.Transactions); err != nil {
+		return false, err
+	}
+
+	resolver := newMerchantResolver(s.merchantRepo, document.UserID)
+	for _, transaction := range result.Transactions {
+		if err := resolver.resolve(ctx, transaction); err != nil {
+			return false, err
+		}
+	}
+
+	if err := s.trackInstallments(ctx, document, result.Transactions); err != nil {
+		return false, err
+	}
+
+	if err := s.saveTransactions(ctx, document, result.Transactions); err != nil {
+		return false, err
+	}
+
+	reconciled, err := s.reconcileStatements(ctx, document, result.Statements, accounts)
+	if err != nil {
+		return false, err
+	}
+
+	// As transações já foram gravadas; uma falha na revisão de duplicatas ou na
+	// detecção não invalida o documento e é corrigida na próxima importação ou
+	// detecção manual. As transferências são ligadas antes para não virarem
+	// assinaturas.
+	if err := s.flagDuplicates(ctx, document, result.Transactions); err != nil {
+		log.Printf("Erro ao procurar possíveis duplicatas do documento %s: %v", document.ExternalID, err)
+	}
+	if len(result.Transactions) > 0 {
+		since := slices.MinFunc(result.Transactions, func(a, b *entity.Transaction) int {
+			return a.Date.Compare(b.Date)
+		}).Date.AddDate(0, 0, -s.transferWindow)
+		if _, err := matchTransfers(ctx, s.transactionRepo, s.transferRepo, document.UserID, since, s.transferWindow); err != nil {
+			log.Printf("Erro ao detectar transferências do documento %s: %v", document.ExternalID, err)
+		}
+	}
+	if err := s.detectRecurring(ctx, document); err != nil {
+		log.Printf("Erro ao detectar transações recorrentes do documento %s: %v", document.ExternalID, err)
+	}
+	return reconciled, nil
+}
+
+// detectRecurring refaz a detecção das séries recorrentes do dono do documento
+// e, quando ele é compartilhado com uma família, a de cada membro
+func (s *DocumentProcessingService) detectRecurring(ctx context.Context, document *entity.Document) error {
+	users := []int64{document.UserID}
+	if document.HouseholdID != 0 {
+		members, err := s.householdRepo.FindMembers(ctx, document.HouseholdID)
+		if err != nil {
+			return fmt.Errorf("erro ao buscar membros da família: %w", err)
+		}
+		for _, member := range members {
+			if !slices.Contains(users, member.UserID) {
+				users = append(users, member.UserID)
+			}
+		}
+	}
+
+	for _, userID := range users {
+		if _, err := detectRecurring(ctx, s.transactionRepo, s.recurringRepo, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// categorize associa às transações extraídas as categorias informadas no
+// arquivo e depois aplica as regras de categorização do dono do documento,
+// seguidas das regras padrão. As transações que continuam sem categoria
+// recebem a sugestão do classificador quando ela é confiável o bastante.
+func (s *DocumentProcessingService) categorize(ctx context.Context, document *entity.Document, transactions []*entity.Transaction) error {
+	if err := s.resolveCategories(ctx, document.UserID, transactions); err != nil {
+		return err
+	}
+
+	rules, err := s.categoryRuleRepo.FindByUserID(ctx, document.UserID)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar regras de categorização: %w", err)
+	}
+	system, err := s.categoryRepo.FindSystem(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar categorias padrão: %w", err)
+	}
+
+	categorizer, err := categorization.New(rules, system)
+	if err != nil {
+		return fmt.Errorf("erro ao preparar regras de categorização: %w", err)
+	}
+
+	var uncategorized []*entity.Transaction
+	for _, transaction := range transactions {
+		categorizer.Apply(transaction)
+		if transaction.CategoryID == 0 {
+			uncategorized = append(uncategorized, transaction)
+		}
+	}
+	if len(uncategorized) == 0 {
+		return nil
+	}
+
+	classifier, err := trainClassifier(ctx, s.transactionRepo, document.UserID)
+	if err != nil {
+		return err
+	}
+	for _, transaction := range uncategorized {
+		suggestions := classifier.Suggest(transaction, 1)
+		if len(suggestions) == 0 || suggestions[0].Confidence < autoCategorizeConfidence {
+			continue
+		}
+		transaction.UpdateCategory(&entity.Category{
+			ID:         suggestions[0].CategoryID,
+			ExternalID: suggestions[0].CategoryExternalID,
+			Name:       suggestions[0].Category,
+		}, entity.CategorySourceSuggestion)
+	}
+	return nil
+}
+
+// resolveCategories troca o nome de categoria informado no arquivo pela
+// categoria do usuário ou padrão com a mesma chave, criando uma categoria do
+// usuário quando não existe nenhuma
+func (s *DocumentProcessingService) resolveCategories(ctx context.Context, userID int64, transactions []*entity.Transaction) error {
+	resolved := make(map[string]*entity.Category)
+	for _, transaction := range transactions {
+		if transaction.CategoryID != 0 || transaction.Category == "" {
+			continue
+		}
+
+		key := entity.CategoryKey(transaction.Category)
+		category, ok := resolved[key]
+		if !ok {
+			var err error
+			category, err = s.findOrCreateCategory(ctx, userID, transaction.Category)
+			if err != nil {
+				return err
+			}
+			resolved[key] = category
+		}
+
+		if category == nil {
+			transaction.Category = ""
+			continue
+		}
+		transaction.UpdateCategory(category, entity.CategorySourceFile)
+	}
+	return nil
+}
+
+// findOrCreateCategory retorna a categoria com a chave do nome informado, ou
+// nil se o nome não for válido para uma categoria
+func (s *DocumentProcessingService) findOrCreateCategory(ctx context.Context, userID int64, name string) (*entity.Category, error) {
+	category, err := s.categoryRepo.FindByKey(ctx, userID, entity.CategoryKey(name))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar categoria: %w", err)
+	}
+	if category != nil {
+		return category, nil
+	}
+
+	category, err = entity.NewCategory(userID, nil, name, "", "")
+	if err != nil {
+		log.Printf("Categoria %q do arquivo ignorada: %v", name, err)
+		return nil, nil
+	}
+
+	// Outro documento do usuário pode ter criado a mesma categoria ao mesmo tempo
+	if err := s.categoryRepo.Create(ctx, category); err != nil {
+		if errors.Is(err, repository.ErrDuplicateCategory) {
+			return s.categoryRepo.FindByKey(ctx, userID, category.Slug)
+		}
+		return nil, fmt.Errorf("erro ao criar categoria: %w", err)
+	}
+	return category, nil
+}
+
+// matchAccounts associa as transações às contas do dono do documento. A conta
+// informada no envio vale para todas; sem ela, cada extrato é associado à
+// conta que já o recebeu antes ou à única com os mesmos últimos dígitos (ver
+// entity.MatchAccount). O identificador do extrato é guardado na conta para as
+// próximas importações, e o documento recebe a conta quando todos os seus
+// extratos são da mesma. Retorna a conta de cada extrato, pelo identificador.
+func (s *DocumentProcessingService) matchAccounts(ctx context.Context, document *entity.Document, result *extractor.Result) (map[string]*entity.Account, error) {
+	matched := make(map[string]*entity.Account)
+	if len(result.Statements) == 0 {
+		return matched, nil
+	}
+
+	if document.AccountID != 0 {
+		account, err := s.accountRepo.FindByID(ctx, document.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar conta: %w", err)
+		}
+		if account == nil {
+			return matched, nil
+		}
+		for _, statement := range result.Statements {
+			matched[statement.AccountKey] = account
+		}
+		if len(result.Statements) == 1 {
+			if err := s.learnSourceAccount(ctx, account, result.Statements[0].AccountKey); err != nil {
+				return nil, err
+			}
+		}
+		return matched, nil
+	}
+
+	accounts, err := s.accountRepo.FindByUserID(ctx, document.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar contas: %w", err)
+	}
+
+	var documentAccount *entity.Account
+	for i, statement := range result.Statements {
+		account := entity.MatchAccount(accounts, statement.AccountKey, statement.AccountID, statement.AccountType == "CREDITCARD")
+		if account == nil || (i > 0 && account != documentAccount) {
+			documentAccount = nil
+		} else {
+			documentAccount = account
+		}
+		if account == nil {
+			continue
+		}
+
+		matched[statement.AccountKey] = account
+		if err := s.learnSourceAccount(ctx, account, statement.AccountKey); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, transaction := range result.Transactions {
+		if account, ok := matched[transaction.SourceAccount]; ok {
+			transaction.AccountID = account.ID
+			transaction.AccountExternalID = account.ExternalID
+		}
+	}
+
+	if documentAccount != nil {
+		if err := s.repo.UpdateAccount(ctx, document.ID, documentAccount.ID); err != nil {
+			return nil, fmt.Errorf("erro ao atualizar conta do documento: %w", err)
+		}
+		document.AccountID = documentAccount.ID
+		document.AccountExternalID = documentAccount.ExternalID
+	}
+	return matched, nil
+}
+
+// reconcileStatements registra os extratos do documento nas suas contas e
+// concilia cada conta com as transações já gravadas. Retorna false quando o
+// saldo de algum extrato do documento não confere.
+func (s *DocumentProcessingService) reconcileStatements(ctx context.Context, document *entity.Document, statements []*extractor.Statement, accounts map[string]*entity.Account) (bool, error) {
+	var registered []*entity.AccountStatement
+	var reconcile []*entity.Account
+	for _, statement := range statements {
+		account, ok := accounts[statement.AccountKey]
+		if !ok || statement.EndDate.IsZero() {
+			continue
+		}
+		registered = append(registered, entity.NewAccountStatement(
+			account, document, statement.AccountKey, statement.StartDate, statement.EndDate,
+			statement.OpeningBalance, statement.LedgerBalance, statement.LedgerBalanceDate,
+		))
+		if !slices.Contains(reconcile, account) {
+			reconcile = append(reconcile, account)
+		}
+	}
+
+	// Também remove os extratos de um processamento anterior do documento
+	if err := s.statementRepo.ReplaceForDocument(ctx, document.ID, registered); err != nil {
+		return false, fmt.Errorf("erro ao registrar extratos: %w", err)
+	}
+
+	reconciled := true
+	for _, account := range reconcile {
+		reconciliation, err := reconcileAccount(ctx, s.statementRepo, s.transactionRepo, account)
+		if err != nil {
+			return false, err
+		}
+		for _, statement := range reconciliation.Statements {
+			if err := s.statementRepo.UpdateReconciliation(ctx, statement); err != nil {
+				return false, fmt.Errorf("erro ao salvar conciliação: %w", err)
+			}
+			if statement.DocumentID == document.ID && !statement.IsReconciled() {
+				log.Printf("Extrato %s do documento %s não confere: diferença de %d centavos",
+					statement.SourceAccount, document.ExternalID, statement.Difference)
+				reconciled = false
+			}
+		}
+	}
+	return reconciled, nil
+}
+
+// learnSourceAccount guarda na conta o identificador do extrato, se ela ainda não tiver um
+func (s *DocumentProcessingService) learnSourceAccount(ctx context.Context, account *entity.Account, sourceAccount string) error {
+	if account == nil || account.SourceAccount != "" || sourceAccount == "" {
+		return nil
+	}
+	if err := s.accountRepo.UpdateSourceAccount(ctx, account.ID, sourceAccount); err != nil {
+		return fmt.Errorf("erro ao atualizar conta: %w", err)
+	}
+	account.SourceAccount = sourceAccount
+	return nil
+}
+
+// trackInstallments associa as parcelas de compras parceladas ("LOJA X 03/10")
+// às suas compras, criando as compras ainda não conhecidas. Uma compra recebe
+// cada número de parcela uma única vez; uma parcela repetida indica outra
+// compra idêntica.
+func (s *DocumentProcessingService) trackInstallments(ctx context.Context, document *entity.Document, transactions []*entity.Transaction) error {
+	// Parcelas já atribuídas neste documento, ainda não gravadas
+	assigned := make(map[int64]map[int]bool)
+
+	for _, transaction := range transactions {
+		if !transaction.IsDebit() {
+			continue
+		}
+		number, total, ok := merchant.ParseInstallment(transaction.Description)
+		if !ok {
+			continue
+		}
+
+		candidate, err := entity.NewInstallmentPlan(transaction, number, total)
+		if err != nil {
+			continue
+		}
+
+		plans, err := s.installmentRepo.FindMatching(ctx, candidate, number, document.ID)
+		if err != nil {
+			return fmt.Errorf("erro ao buscar compra parcelada: %w", err)
+		}
+
+		var plan *entity.InstallmentPlan
+		for _, p := range plans {
+			if !assigned[p.ID][number] {
+				plan = p
+				break
+			}
+		}
+		if plan == nil {
+			if err := s.installmentRepo.Create(ctx, candidate); err != nil {
+				return fmt.Errorf("erro ao criar compra parcelada: %w", err)
+			}
+			plan = candidate
+		}
+
+		if assigned[plan.ID] == nil {
+			assigned[plan.ID] = make(map[int]bool)
+		}
+		assigned[plan.ID][number] = true
+		transaction.UpdateInstallment(plan, number)
+	}
+	return nil
+}
+
+func (s *DocumentProcessingService) readContent(ctx context.Context, document *entity.Document) ([]byte, error) {
+	if document.StorageKey == "" {
+		return nil, fmt.Errorf("conteúdo do documento ainda não migrado para o armazenamento")
+	}
+
+	reader, err := s.blobStore.Get(ctx, document.StorageKey)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao abrir conteúdo: %w", err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler conteúdo: %w", err)
+	}
+	return content, nil
+}
+
+// saveTransactions substitui as transações do documento pelas recém-extraídas,
+// de forma que o reprocessamento de um documento não duplique transações. A
+// substituição é feita em uma transação do banco: uma falha no meio mantém as
+// transações anteriores do documento.
+func (s *DocumentProcessingService) saveTransactions(ctx context.Context, document *entity.Document, transactions []*entity.Transaction) error {
+	dedup.AssignFingerprints(document, transactions)
+
+	duplicates := 0
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.transactionRepo.DeleteByDocumentID(ctx, document.ID); err != nil {
+			return fmt.Errorf("erro ao remover transações anteriores: %w", err)
+		}
+
+		duplicates = 0
+		for _, transaction := range transactions {
+			if err := s.transactionRepo.Create(ctx, transaction); err != nil {
+				if errors.Is(err, repository.ErrDuplicateTransaction) {
+					duplicates++
+					continue
+				}
+				return fmt.Errorf("erro ao salvar transação: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("%d transações extraídas do documento %s (%d já importadas anteriormente)",
+		len(transactions)-duplicates, document.ExternalID, duplicates)
+	return nil
+}
+
+// flagDuplicates envia para a revisão do usuário as transações gravadas que
+// podem ser as mesmas de outro documento, sem certeza suficiente para que a
+// importação as descartasse
+func (s *DocumentProcessingService) flagDuplicates(ctx context.Context, document *entity.Document, transactions []*entity.Transaction) error {
+	var imported []*entity.Transaction
+	var since time.Time
+	for _, transaction := range transactions {
+		// As descartadas como duplicatas não chegam a ser gravadas
+		if transaction.ID == 0 {
+			continue
+		}
+		imported = append(imported, transaction)
+		if since.IsZero() || transaction.Date.Before(since) {
+			since = transaction.Date
+		}
+	}
+	if len(imported) == 0 {
+		return nil
+	}
+
+	existing, err := s.transactionRepo.FindByUserIDSince(ctx, document.UserID, since.AddDate(0, 0, -dedup.WindowDays))
+	if err != nil {
+		return fmt.Errorf("erro ao buscar transações do período: %w", err)
+	}
+
+	candidates := dedup.FindCandidates(imported, existing)
+	for _, candidate := range candidates {
+		if err := s.duplicateRepo.Create(ctx, candidate); err != nil {
+			return fmt.Errorf("erro ao salvar possível duplicata: %w", err)
+		}
+	}
+	if len(candidates) > 0 {
+		log.Printf("%d possíveis duplicatas do documento %s aguardam revisão", len(candidates), document.ExternalID)
+	}
+	return nil
+}
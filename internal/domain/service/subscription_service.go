@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/currency"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/recurrence"
+	"finance-assistant/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// recurrenceHistoryDays é o período do histórico analisado na detecção de
+// séries recorrentes, suficiente para reconhecer cobranças anuais
+const recurrenceHistoryDays = 800
+
+// Subscriptions agrupa as séries recorrentes do usuário e o custo mensal
+// equivalente delas, por moeda ou convertido para a moeda do relatório
+type Subscriptions struct {
+	Series      []*entity.RecurringSeries
+	MonthlyCost map[string]int64
+}
+
+type SubscriptionService struct {
+	repo            repository.RecurringSeriesRepository
+	userRepo        repository.UserRepository
+	transactionRepo repository.TransactionRepository
+	transactor      repository.Transactor
+	converter       *currency.Converter
+}
+
+func NewSubscriptionService(
+	repo repository.RecurringSeriesRepository,
+	userRepo repository.UserRepository,
+	transactionRepo repository.TransactionRepository,
+	transactor repository.Transactor,
+	converter *currency.Converter,
+) *SubscriptionService {
+	return &SubscriptionService{
+		repo:            repo,
+		userRepo:        userRepo,
+		transactionRepo: transactionRepo,
+		transactor:      transactor,
+		converter:       converter,
+	}
+}
+
+// ListSubscriptions lista as séries recorrentes detectadas nas transações do
+// usuário e nas compartilhadas com as suas famílias. Com reportCurrency, o custo mensal total é convertido para ela, o
+// de cada série pela cotação da data da última cobrança, como nos demais relatórios.
+func (s *SubscriptionService) ListSubscriptions(ctx context.Context, userExternalID uuid.UUID, reportCurrency string) (*Subscriptions, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := s.repo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := newSubscriptions(series)
+	if reportCurrency != "" {
+		reportCurrency, err = entity.ParseCurrency(reportCurrency)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions.MonthlyCost, err = convertMonthlyCost(ctx, s.converter, subscriptions.Series, reportCurrency)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return subscriptions, nil
+}
+
+// DetectSubscriptions refaz a detecção das séries recorrentes com o histórico
+// atual de transações do usuário
+func (s *SubscriptionService) DetectSubscriptions(ctx context.Context, userExternalID uuid.UUID) (*Subscriptions, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	var series []*entity.RecurringSeries
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		series, err = detectRecurring(ctx, s.transactionRepo, s.repo, user.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newSubscriptions(series), nil
+}
+
+// findUser busca o usuário e verifica o acesso do usuário autenticado com authorize
+func (s *SubscriptionService) findUser(ctx context.Context, userExternalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.User, error) {
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := authorize(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func newSubscriptions(series []*entity.RecurringSeries) *Subscriptions {
+	subscriptions := &Subscriptions{
+		Series:      series,
+		MonthlyCost: make(map[string]int64),
+	}
+	if subscriptions.Series == nil {
+		subscriptions.Series = []*entity.RecurringSeries{}
+	}
+	for _, s := range series {
+		subscriptions.MonthlyCost[s.Currency] += s.MonthlyCost()
+	}
+	return subscriptions
+}
+
+// convertMonthlyCost soma o custo mensal das séries convertido para a moeda to,
+// o de cada série pela cotação da data da sua última cobrança
+func convertMonthlyCost(ctx context.Context, converter *currency.Converter, series []*entity.RecurringSeries, to string) (map[string]int64, error) {
+	converted := map[string]int64{to: 0}
+	for _, s := range series {
+		amount, err := converter.Convert(ctx, entity.Money{Amount: s.MonthlyCost(), Currency: s.Currency}, to, s.LastDate)
+		if err != nil {
+			return nil, err
+		}
+		converted[to] += amount.Amount
+	}
+	return converted, nil
+}
+
+// detectRecurring detecta as séries recorrentes no histórico recente do
+// usuário, incluindo as transações compartilhadas com as suas famílias, e
+// substitui as gravadas anteriormente
+func detectRecurring(ctx context.Context, transactionRepo repository.TransactionRepository, repo repository.RecurringSeriesRepository, userID int64) ([]*entity.RecurringSeries, error) {
+	now := time.Now()
+	transactions, err := transactionRepo.FindAccessibleByUserIDSince(ctx, userID, now.AddDate(0, 0, -recurrenceHistoryDays))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar histórico de transações: %w", err)
+	}
+
+	series := recurrence.Detect(transactions, now)
+	if err := repo.ReplaceForUser(ctx, userID, series); err != nil {
+		return nil, fmt.Errorf("erro ao salvar transações recorrentes: %w", err)
+	}
+	return series, nil
+}
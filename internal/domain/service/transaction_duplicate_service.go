@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransactionDuplicateNotFound = errors.New("Possível duplicata não encontrada")
+)
+
+type TransactionDuplicateService struct {
+	repo       repository.TransactionDuplicateRepository
+	userRepo   repository.UserRepository
+	transactor repository.Transactor
+}
+
+func NewTransactionDuplicateService(
+	repo repository.TransactionDuplicateRepository,
+	userRepo repository.UserRepository,
+	transactor repository.Transactor,
+) *TransactionDuplicateService {
+	return &TransactionDuplicateService{
+		repo:       repo,
+		userRepo:   userRepo,
+		transactor: transactor,
+	}
+}
+
+// ListDuplicates lista as possíveis duplicatas do usuário aguardando revisão
+func (s *TransactionDuplicateService) ListDuplicates(ctx context.Context, userExternalID uuid.UUID) ([]*entity.TransactionDuplicate, error) {
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := auth.AuthorizeRead(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindPendingByUserID(ctx, user.ID)
+}
+
+// MergeDuplicate confirma que as transações são a mesma: a importada por
+// último é excluída e seus documentos passam a ser origens da mantida, que é
+// retornada
+func (s *TransactionDuplicateService) MergeDuplicate(ctx context.Context, duplicateExternalID uuid.UUID) (*entity.Transaction, error) {
+	duplicate, err := s.findPending(ctx, duplicateExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.repo.Merge(ctx, duplicate)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return duplicate.DuplicateOf, nil
+}
+
+// DismissDuplicate indica que as transações são diferentes e mantém as duas
+func (s *TransactionDuplicateService) DismissDuplicate(ctx context.Context, duplicateExternalID uuid.UUID) (*entity.TransactionDuplicate, error) {
+	duplicate, err := s.findPending(ctx, duplicateExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := duplicate.Dismiss(); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateStatus(ctx, duplicate); err != nil {
+		return nil, err
+	}
+	return duplicate, nil
+}
+
+// findPending busca uma possível duplicata do usuário autenticado ainda não revisada
+func (s *TransactionDuplicateService) findPending(ctx context.Context, duplicateExternalID uuid.UUID) (*entity.TransactionDuplicate, error) {
+	duplicate, err := s.repo.FindByExternalID(ctx, duplicateExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if duplicate == nil {
+		return nil, ErrTransactionDuplicateNotFound
+	}
+	if err := auth.AuthorizeOwner(ctx, duplicate.UserID); err != nil {
+		return nil, err
+	}
+	if duplicate.Status != entity.DuplicateStatusPending {
+		return nil, entity.ErrDuplicateAlreadyReviewed
+	}
+	return duplicate, nil
+}
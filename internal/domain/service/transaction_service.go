@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/categorization"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransactionNotFound = errors.New("Transação não encontrada")
+)
+
+const (
+	// classifierTrainingSize limita o treino do classificador às transações
+	// categorizadas mais recentes do usuário
+	classifierTrainingSize = 5000
+	// autoCategorizeConfidence é a confiança mínima para que a importação
+	// aplique a sugestão do classificador a uma transação sem categoria
+	autoCategorizeConfidence = 0.8
+)
+
+type TransactionService struct {
+	repo         repository.TransactionRepository
+	userRepo     repository.UserRepository
+	documentRepo repository.DocumentRepository
+	categoryRepo repository.CategoryRepository
+	households   householdAccess
+}
+
+func NewTransactionService(
+	repo repository.TransactionRepository,
+	userRepo repository.UserRepository,
+	documentRepo repository.DocumentRepository,
+	householdRepo repository.HouseholdRepository,
+	categoryRepo repository.CategoryRepository,
+) *TransactionService {
+	return &TransactionService{
+		repo:         repo,
+		userRepo:     userRepo,
+		documentRepo: documentRepo,
+		categoryRepo: categoryRepo,
+		households:   householdAccess{repo: householdRepo},
+	}
+}
+
+// GetTransactionByExternalID obtém uma transação pelo seu ID externo
+func (s *TransactionService) GetTransactionByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Transaction, error) {
+	transaction, err := s.repo.FindByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+	if transaction == nil {
+		return nil, ErrTransactionNotFound
+	}
+	if err := s.households.authorize(ctx, transaction.UserID, transaction.HouseholdID, auth.AuthorizeRead, false); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// GetTransactionsByUserExternalID lista as transações de um usuário, incluindo as
+// dos documentos compartilhados com as famílias de que ele participa
+func (s *TransactionService) GetTransactionsByUserExternalID(ctx context.Context, userExternalID uuid.UUID, page, perPage int) ([]*entity.Transaction, int, error) {
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if user == nil {
+		return nil, 0, ErrUserNotFound
+	}
+	if err := auth.AuthorizeRead(ctx, user.ID); err != nil {
+		return nil, 0, err
+	}
+
+	page, perPage = normalizePagination(page, perPage)
+	offset := (page - 1) * perPage
+
+	total, err := s.repo.CountAccessibleByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	transactions, err := s.repo.FindAccessibleByUserID(ctx, user.ID, perPage, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if transactions == nil {
+		return []*entity.Transaction{}, total, nil
+	}
+
+	return transactions, total, nil
+}
+
+// GetTransactionsByDocumentExternalID lista as transações extraídas de um documento
+func (s *TransactionService) GetTransactionsByDocumentExternalID(ctx context.Context, documentExternalID uuid.UUID, page, perPage int) ([]*entity.Transaction, int, error) {
+	document, err := s.documentRepo.FindByExternalID(ctx, documentExternalID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if document == nil {
+		return nil, 0, ErrDocumentNotFound
+	}
+	if err := s.households.authorize(ctx, document.UserID, document.HouseholdID, auth.AuthorizeRead, false); err != nil {
+		return nil, 0, err
+	}
+
+	page, perPage = normalizePagination(page, perPage)
+	offset := (page - 1) * perPage
+
+	total, err := s.repo.CountByDocumentID(ctx, document.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	transactions, err := s.repo.FindByDocumentID(ctx, document.ID, perPage, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if transactions == nil {
+		return []*entity.Transaction{}, total, nil
+	}
+
+	return transactions, total, nil
+}
+
+// UpdateCategory corrige a categoria de uma transação. A correção é usada no
+// treino do classificador e mantida ao reaplicar as regras de categorização.
+func (s *TransactionService) UpdateCategory(ctx context.Context, externalID, categoryExternalID uuid.UUID) (*entity.Transaction, error) {
+	transaction, err := s.repo.FindByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+	if transaction == nil {
+		return nil, ErrTransactionNotFound
+	}
+	if err := s.households.authorize(ctx, transaction.UserID, transaction.HouseholdID, auth.AuthorizeOwner, true); err != nil {
+		return nil, err
+	}
+
+	// As categorias são do dono da transação, mesmo quando corrigida por outro membro da família
+	category, err := s.categoryRepo.FindByExternalID(ctx, categoryExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil || !category.IsAvailableTo(transaction.UserID) {
+		return nil, ErrCategoryNotFound
+	}
+
+	transaction.UpdateCategory(category, entity.CategorySourceUser)
+	if err := s.repo.UpdateCategory(ctx, transaction.ID, transaction.CategoryID, transaction.CategorySource); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// SuggestCategories sugere até limit categorias para uma transação com base
+// nas categorias que o dono da transação já informou para as demais
+func (s *TransactionService) SuggestCategories(ctx context.Context, externalID uuid.UUID, limit int) ([]categorization.Suggestion, error) {
+	transaction, err := s.GetTransactionByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+
+	classifier, err := trainClassifier(ctx, s.repo, transaction.UserID, transaction.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := classifier.Suggest(transaction, limit)
+	if suggestions == nil {
+		return []categorization.Suggestion{}, nil
+	}
+	return suggestions, nil
+}
+
+// GetTransactionSources lista os documentos que informaram a transação: o que
+// a criou e os que a repetiram e foram descartados como duplicados
+func (s *TransactionService) GetTransactionSources(ctx context.Context, externalID uuid.UUID) ([]*entity.TransactionSource, error) {
+	transaction, err := s.GetTransactionByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+
+	sources, err := s.repo.FindSources(ctx, transaction.ID)
+	if err != nil {
+		return nil, err
+	}
+	if sources == nil {
+		return []*entity.TransactionSource{}, nil
+	}
+	return sources, nil
+}
+
+// trainClassifier treina o classificador de categorias com as transações de
+// categoria confirmada do usuário, exceto as informadas em exclude
+func trainClassifier(ctx context.Context, repo repository.TransactionRepository, userID int64, exclude ...int64) (*categorization.Classifier, error) {
+	transactions, err := repo.FindConfirmedByUserID(ctx, userID, classifierTrainingSize)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar transações para o classificador: %w", err)
+	}
+
+	transactions = slices.DeleteFunc(transactions, func(transaction *entity.Transaction) bool {
+		return slices.Contains(exclude, transaction.ID)
+	})
+	return categorization.NewClassifier(transactions), nil
+}
+
+// normalizePagination aplica os valores padrão de paginação
+func normalizePagination(page, perPage int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = 10
+	}
+	return page, perPage
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/repository"
+	"finance-assistant/internal/domain/transfer"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransferNotFound              = errors.New("Transferência não encontrada")
+	ErrTransactionAlreadyTransferred = errors.New("A transação já faz parte de outra transferência")
+)
+
+type TransferService struct {
+	repo            repository.TransferRepository
+	userRepo        repository.UserRepository
+	transactionRepo repository.TransactionRepository
+	transactor      repository.Transactor
+	windowDays      int
+}
+
+func NewTransferService(
+	repo repository.TransferRepository,
+	userRepo repository.UserRepository,
+	transactionRepo repository.TransactionRepository,
+	transactor repository.Transactor,
+	windowDays int,
+) *TransferService {
+	return &TransferService{
+		repo:            repo,
+		userRepo:        userRepo,
+		transactionRepo: transactionRepo,
+		transactor:      transactor,
+		windowDays:      windowDays,
+	}
+}
+
+// ListTransfers lista as transferências entre contas do usuário; status vazio
+// lista todas
+func (s *TransferService) ListTransfers(ctx context.Context, userExternalID uuid.UUID, status entity.TransferStatus) ([]*entity.Transfer, error) {
+	if status != "" && !status.IsValid() {
+		return nil, entity.ErrInvalidTransferStatus
+	}
+
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindByUserID(ctx, user.ID, status)
+}
+
+// DetectTransfers procura transferências em todo o histórico de transações do
+// usuário e retorna as novas sugestões
+func (s *TransferService) DetectTransfers(ctx context.Context, userExternalID uuid.UUID) ([]*entity.Transfer, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfers []*entity.Transfer
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		transfers, err = matchTransfers(ctx, s.transactionRepo, s.repo, user.ID, time.Time{}, s.windowDays)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if transfers == nil {
+		return []*entity.Transfer{}, nil
+	}
+	return transfers, nil
+}
+
+// LinkTransfer liga manualmente duas transações do usuário, um débito e um
+// crédito de mesmo valor em contas diferentes, como uma transferência confirmada
+func (s *TransferService) LinkTransfer(ctx context.Context, userExternalID, firstExternalID, secondExternalID uuid.UUID) (*entity.Transfer, error) {
+	user, err := s.findUser(ctx, userExternalID, auth.AuthorizeOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	first, err := s.findTransaction(ctx, user.ID, firstExternalID)
+	if err != nil {
+		return nil, err
+	}
+	second, err := s.findTransaction(ctx, user.ID, secondExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	linked, err := entity.NewTransfer(first, second, entity.TransferStatusConfirmed)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Um par já sugerido ou rejeitado é confirmado em vez de duplicado
+		existing, err := s.checkAvailable(ctx, linked)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			if err := existing.UpdateStatus(entity.TransferStatusConfirmed); err != nil {
+				return err
+			}
+			linked = existing
+			return s.repo.UpdateStatus(ctx, linked)
+		}
+		return s.repo.Create(ctx, linked)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return linked, nil
+}
+
+// UpdateTransferStatus confirma ou rejeita uma transferência. Rejeitar desfaz a
+// ligação entre as transações, que voltam a contar como gastos e receitas.
+func (s *TransferService) UpdateTransferStatus(ctx context.Context, transferExternalID uuid.UUID, status entity.TransferStatus) (*entity.Transfer, error) {
+	transfer, err := s.repo.FindByExternalID(ctx, transferExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if transfer == nil {
+		return nil, ErrTransferNotFound
+	}
+	if err := auth.AuthorizeOwner(ctx, transfer.UserID); err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if !transfer.IsActive() && status != entity.TransferStatusRejected {
+			if _, err := s.checkAvailable(ctx, transfer); err != nil {
+				return err
+			}
+		}
+		if err := transfer.UpdateStatus(status); err != nil {
+			return err
+		}
+		return s.repo.UpdateStatus(ctx, transfer)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+// checkAvailable verifica que as transações da transferência não fazem parte de
+// outra transferência não rejeitada e retorna a já gravada para o mesmo par
+func (s *TransferService) checkAvailable(ctx context.Context, transfer *entity.Transfer) (*entity.Transfer, error) {
+	var existing *entity.Transfer
+	for _, transactionID := range []int64{transfer.Outgoing.TransactionID, transfer.Incoming.TransactionID} {
+		transfers, err := s.repo.FindByTransactionID(ctx, transactionID)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range transfers {
+			switch {
+			case t.Links(transfer.Outgoing.TransactionID, transfer.Incoming.TransactionID):
+				existing = t
+			case t.IsActive():
+				return nil, ErrTransactionAlreadyTransferred
+			}
+		}
+	}
+	return existing, nil
+}
+
+// findTransaction busca uma transação do usuário
+func (s *TransferService) findTransaction(ctx context.Context, userID int64, transactionExternalID uuid.UUID) (*entity.Transaction, error) {
+	transaction, err := s.transactionRepo.FindByExternalID(ctx, transactionExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if transaction == nil || transaction.UserID != userID {
+		return nil, ErrTransactionNotFound
+	}
+	return transaction, nil
+}
+
+// findUser busca o usuário e verifica o acesso do usuário autenticado com authorize
+func (s *TransferService) findUser(ctx context.Context, userExternalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.User, error) {
+	user, err := s.userRepo.FindByExternalID(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := authorize(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// matchTransfers procura transferências entre as contas do usuário nas
+// transações a partir de since e grava as encontradas como sugestões
+func matchTransfers(ctx context.Context, transactionRepo repository.TransactionRepository, repo repository.TransferRepository, userID int64, since time.Time, windowDays int) ([]*entity.Transfer, error) {
+	transactions, err := transactionRepo.FindByUserIDSince(ctx, userID, since)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar histórico de transações: %w", err)
+	}
+	existing, err := repo.FindByUserID(ctx, userID, "")
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar transferências: %w", err)
+	}
+
+	transfers := transfer.Match(transactions, existing, windowDays)
+	for _, t := range transfers {
+		if err := repo.Create(ctx, t); err != nil {
+			return nil, fmt.Errorf("erro ao salvar transferência: %w", err)
+		}
+	}
+	return transfers, nil
+}
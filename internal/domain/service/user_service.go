@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"finance-assistant/internal/domain/auth"
 	"finance-assistant/internal/domain/entity"
 	"finance-assistant/internal/domain/repository"
 	"github.com/google/uuid"
@@ -12,6 +13,7 @@ import (
 var (
 	ErrUserNotFound     = errors.New("usuário não encontrado")
 	ErrEmailAlreadyUsed = errors.New("email já está em uso")
+	ErrOwnRoleChange    = errors.New("não é possível alterar o próprio papel")
 )
 
 type UserService struct {
@@ -24,7 +26,8 @@ func NewUserService(repo repository.UserRepository) *UserService {
 	}
 }
 
-func (s *UserService) CreateUser(ctx context.Context, name, email, phone string) (*entity.User, error) {
+// CreateUser cadastra um novo usuário com a senha usada no login
+func (s *UserService) CreateUser(ctx context.Context, name, email, phone, password string) (*entity.User, error) {
 	// Verifica se já existe um usuário com este email
 	existingUser, err := s.repo.FindByEmail(ctx, email)
 	if err == nil && existingUser != nil {
@@ -35,6 +38,9 @@ func (s *UserService) CreateUser(ctx context.Context, name, email, phone string)
 	if err != nil {
 		return nil, err
 	}
+	if err := user.SetPassword(password); err != nil {
+		return nil, err
+	}
 
 	if err := s.repo.Create(ctx, user); err != nil {
 		return nil, err
@@ -54,25 +60,16 @@ func (s *UserService) GetUserByID(ctx context.Context, id int64) (*entity.User,
 	return user, nil
 }
 
+// GetUserByExternalID obtém um usuário; acessível ao próprio usuário, ao suporte e aos administradores
 func (s *UserService) GetUserByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.User, error) {
-	user, err := s.repo.FindByExternalID(ctx, externalID)
-	if err != nil {
-		return nil, err
-	}
-	if user == nil {
-		return nil, ErrUserNotFound
-	}
-	return user, nil
+	return s.findAuthorizedUser(ctx, externalID, auth.AuthorizeRead)
 }
 
 func (s *UserService) UpdateUser(ctx context.Context, externalID uuid.UUID, name, email, phone string) (*entity.User, error) {
-	user, err := s.repo.FindByExternalID(ctx, externalID)
+	user, err := s.findAuthorizedUser(ctx, externalID, auth.AuthorizeOwner)
 	if err != nil {
 		return nil, err
 	}
-	if user == nil {
-		return nil, ErrUserNotFound
-	}
 
 	// Se o email for alterado, verificar se já está em uso
 	if email != "" && email != user.Email {
@@ -94,18 +91,20 @@ func (s *UserService) UpdateUser(ctx context.Context, externalID uuid.UUID, name
 }
 
 func (s *UserService) DeleteUser(ctx context.Context, externalID uuid.UUID) error {
-	user, err := s.repo.FindByExternalID(ctx, externalID)
+	user, err := s.findAuthorizedUser(ctx, externalID, auth.AuthorizeOwner)
 	if err != nil {
 		return err
 	}
-	if user == nil {
-		return ErrUserNotFound
-	}
 
 	return s.repo.Delete(ctx, user.ID)
 }
 
+// ListUsers lista todos os usuários; restrito ao suporte e aos administradores
 func (s *UserService) ListUsers(ctx context.Context, page, perPage int) ([]*entity.User, error) {
+	if err := auth.RequireRole(ctx, entity.RoleSupport, entity.RoleAdmin); err != nil {
+		return nil, err
+	}
+
 	if page < 1 {
 		page = 1
 	}
@@ -116,3 +115,47 @@ func (s *UserService) ListUsers(ctx context.Context, page, perPage int) ([]*enti
 	offset := (page - 1) * perPage
 	return s.repo.List(ctx, perPage, offset)
 }
+
+// ChangeUserRole altera o papel de um usuário; restrito aos administradores
+func (s *UserService) ChangeUserRole(ctx context.Context, externalID uuid.UUID, role entity.UserRole) (*entity.User, error) {
+	if err := auth.RequireRole(ctx, entity.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, err := s.repo.FindByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	// Evita que o último administrador perca o acesso por engano
+	if principal, _ := auth.PrincipalFromContext(ctx); principal.UserID == user.ID {
+		return nil, ErrOwnRoleChange
+	}
+
+	if err := user.ChangeRole(role); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// findAuthorizedUser busca o usuário e verifica o acesso do usuário autenticado com authorize
+func (s *UserService) findAuthorizedUser(ctx context.Context, externalID uuid.UUID, authorize func(context.Context, int64) error) (*entity.User, error) {
+	user, err := s.repo.FindByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if err := authorize(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var (
+	ErrBlobNotFound = errors.New("Arquivo não encontrado no armazenamento")
+)
+
+// BlobStore armazena o conteúdo binário dos documentos fora do banco de dados.
+// As chaves são caminhos relativos separados por "/" (ex: documents/1/<uuid>).
+type BlobStore interface {
+	// Put grava o conteúdo sob a chave informada, substituindo o existente.
+	// size pode ser -1 quando o tamanho não é conhecido antecipadamente.
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	// Get abre o conteúdo armazenado; o chamador deve fechar o leitor
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Stat retorna o tamanho do conteúdo sem abri-lo, ou ErrBlobNotFound
+	Stat(ctx context.Context, key string) (int64, error)
+	// GetRange abre length bytes do conteúdo a partir de offset
+	GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+	// Delete remove o conteúdo; chaves inexistentes não são consideradas erro
+	Delete(ctx context.Context, key string) error
+}
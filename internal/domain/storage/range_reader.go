@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var errNegativePosition = errors.New("posição negativa")
+
+// RangeReader expõe um conteúdo do BlobStore como io.ReadSeekCloser, abrindo
+// o trecho a partir da posição atual sob demanda. Permite servir requisições
+// HTTP Range sem carregar o arquivo inteiro em memória.
+type RangeReader struct {
+	ctx    context.Context
+	store  BlobStore
+	key    string
+	size   int64
+	offset int64
+	reader io.ReadCloser
+}
+
+// OpenRange verifica com Stat que o conteúdo existe, de forma que um conteúdo
+// ausente seja detectado antes que qualquer byte seja enviado ao cliente. O
+// trecho só é aberto no primeiro Read, depois dos Seek feitos por http.ServeContent.
+func OpenRange(ctx context.Context, store BlobStore, key string, size int64) (*RangeReader, error) {
+	if _, err := store.Stat(ctx, key); err != nil {
+		return nil, err
+	}
+	return &RangeReader{
+		ctx:   ctx,
+		store: store,
+		key:   key,
+		size:  size,
+	}, nil
+}
+
+func (r *RangeReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+	if r.reader == nil {
+		reader, err := r.store.GetRange(r.ctx, r.key, r.offset, r.size-r.offset)
+		if err != nil {
+			return 0, err
+		}
+		r.reader = reader
+	}
+
+	n, err := r.reader.Read(p)
+	r.offset += int64(n)
+	return n, err
+}
+
+func (r *RangeReader) Seek(offset int64, whence int) (int64, error) {
+	var position int64
+	switch whence {
+	case io.SeekStart:
+		position = offset
+	case io.SeekCurrent:
+		position = r.offset + offset
+	case io.SeekEnd:
+		position = r.size + offset
+	default:
+		return 0, errors.New("whence inválido")
+	}
+	if position < 0 {
+		return 0, errNegativePosition
+	}
+
+	// Mudança de posição descarta o leitor atual; o próximo Read abre o novo trecho
+	if position != r.offset {
+		r.closeReader()
+	}
+	r.offset = position
+	return position, nil
+}
+
+func (r *RangeReader) Close() error {
+	return r.closeReader()
+}
+
+func (r *RangeReader) closeReader() error {
+	if r.reader == nil {
+		return nil
+	}
+	err := r.reader.Close()
+	r.reader = nil
+	return err
+}
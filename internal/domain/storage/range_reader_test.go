@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// memoryBlobStore guarda os conteúdos em memória e conta as chamadas ao backend
+type memoryBlobStore struct {
+	objects map[string][]byte
+	stats   int
+	ranges  []string
+}
+
+func (s *memoryBlobStore) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	s.objects[key] = data
+	return nil
+}
+
+func (s *memoryBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.GetRange(ctx, key, 0, int64(len(s.objects[key])))
+}
+
+func (s *memoryBlobStore) Stat(ctx context.Context, key string) (int64, error) {
+	s.stats++
+	data, ok := s.objects[key]
+	if !ok {
+		return 0, ErrBlobNotFound
+	}
+	return int64(len(data)), nil
+}
+
+func (s *memoryBlobStore) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, ErrBlobNotFound
+	}
+	s.ranges = append(s.ranges, string(data[offset:offset+length]))
+	return io.NopCloser(bytes.NewReader(data[offset : offset+length])), nil
+}
+
+func (s *memoryBlobStore) Delete(ctx context.Context, key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+func TestOpenRangeNotFound(t *testing.T) {
+	store := &memoryBlobStore{objects: map[string][]byte{}}
+
+	if _, err := OpenRange(context.Background(), store, "documents/1/inexistente", 10); !errors.Is(err, ErrBlobNotFound) {
+		t.Fatalf("OpenRange() erro = %v, esperado ErrBlobNotFound", err)
+	}
+	if len(store.ranges) != 0 {
+		t.Errorf("OpenRange() abriu %d trechos, esperado nenhum", len(store.ranges))
+	}
+}
+
+func TestRangeReaderServeContent(t *testing.T) {
+	content := "0123456789abcdefghij"
+
+	tests := []struct {
+		name       string
+		rangeValue string
+		wantStatus int
+		wantBody   string
+		wantOpened string
+	}{
+		{name: "conteúdo inteiro", wantStatus: http.StatusOK, wantBody: content, wantOpened: content},
+		{name: "trecho", rangeValue: "bytes=5-9", wantStatus: http.StatusPartialContent, wantBody: "56789", wantOpened: content[5:]},
+		{name: "sufixo", rangeValue: "bytes=-4", wantStatus: http.StatusPartialContent, wantBody: "ghij", wantOpened: "ghij"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &memoryBlobStore{objects: map[string][]byte{"documents/1/a": []byte(content)}}
+			reader, err := OpenRange(context.Background(), store, "documents/1/a", int64(len(content)))
+			if err != nil {
+				t.Fatalf("OpenRange() erro = %v", err)
+			}
+			defer reader.Close()
+
+			req := httptest.NewRequest(http.MethodGet, "/documents/a/download", nil)
+			if tt.rangeValue != "" {
+				req.Header.Set("Range", tt.rangeValue)
+			}
+			rec := httptest.NewRecorder()
+			rec.Header().Set("Content-Type", "application/x-ofx")
+			http.ServeContent(rec, req, "a.ofx", time.Time{}, reader)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, esperado %d", rec.Code, tt.wantStatus)
+			}
+			if body := rec.Body.String(); body != tt.wantBody {
+				t.Errorf("corpo = %q, esperado %q", body, tt.wantBody)
+			}
+			// Os Seek de ServeContent não podem abrir trechos: uma única leitura no backend
+			if store.stats != 1 || len(store.ranges) != 1 || store.ranges[0] != tt.wantOpened {
+				t.Errorf("backend: %d Stat e trechos %q, esperado 1 Stat e [%q]", store.stats, store.ranges, tt.wantOpened)
+			}
+		})
+	}
+}
+
+func TestRangeReaderSeek(t *testing.T) {
+	store := &memoryBlobStore{objects: map[string][]byte{"documents/1/a": []byte("0123456789")}}
+	reader, err := OpenRange(context.Background(), store, "documents/1/a", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(reader, buf); err != nil || string(buf) != "012" {
+		t.Fatalf("Read() = %q, %v", buf, err)
+	}
+	if position, err := reader.Seek(-2, io.SeekEnd); err != nil || position != 8 {
+		t.Fatalf("Seek() = %d, %v", position, err)
+	}
+	rest, err := io.ReadAll(reader)
+	if err != nil || string(rest) != "89" {
+		t.Errorf("ReadAll() = %q, %v", rest, err)
+	}
+	if _, err := reader.Seek(-1, io.SeekStart); !errors.Is(err, errNegativePosition) {
+		t.Errorf("Seek() negativo erro = %v", err)
+	}
+	if got := strings.Join(store.ranges, ","); got != "0123456789,89" {
+		t.Errorf("trechos abertos = %s", got)
+	}
+}
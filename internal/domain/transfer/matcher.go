@@ -0,0 +1,117 @@
+package transfer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+// hintWords são as palavras das descrições que indicam uma transferência
+// (Pix, TED, DOC, TEF)
+var hintWords = []string{"PIX", "TED", "DOC", "TEF"}
+
+// hintPrefixes são os prefixos de palavras que indicam uma transferência ou o
+// pagamento da fatura do cartão (ex: "TRANSF", "TRANSFERENCIA", "PAGTO FATURA")
+var hintPrefixes = []string{"TRANSF", "PAGAMENTO", "PAGTO", "PGTO", "FATURA", "PAYMENT"}
+
+// Match procura nas transações do usuário débitos e créditos de mesmo valor e
+// moeda, em contas diferentes e com até windowDays dias de diferença, em que
+// ao menos uma das descrições indica uma transferência. Transações que já
+// fazem parte de uma transferência não rejeitada e os pares rejeitados em
+// existing são ignorados. Quando mais de um crédito é igualmente provável
+// para um débito, nenhum é sugerido.
+func Match(transactions []*entity.Transaction, existing []*entity.Transfer, windowDays int) []*entity.Transfer {
+	used := make(map[int64]bool)
+	rejected := make(map[[2]int64]bool)
+	for _, transfer := range existing {
+		if transfer.IsActive() {
+			used[transfer.Outgoing.TransactionID] = true
+			used[transfer.Incoming.TransactionID] = true
+		} else {
+			rejected[[2]int64{transfer.Outgoing.TransactionID, transfer.Incoming.TransactionID}] = true
+		}
+	}
+
+	var debits []*entity.Transaction
+	credits := make(map[string][]*entity.Transaction)
+	for _, transaction := range transactions {
+		if transaction.TransferID != 0 || used[transaction.ID] || transaction.Amount == 0 {
+			continue
+		}
+		if transaction.IsDebit() {
+			debits = append(debits, transaction)
+		} else {
+			key := amountKey(transaction.Currency, transaction.Amount)
+			credits[key] = append(credits[key], transaction)
+		}
+	}
+	slices.SortStableFunc(debits, func(a, b *entity.Transaction) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	var matched []*entity.Transfer
+	for _, debit := range debits {
+		var best *entity.Transaction
+		bestDays, ambiguous := 0, false
+		for _, credit := range credits[amountKey(debit.Currency, -debit.Amount)] {
+			days := daysBetween(debit, credit)
+			if used[credit.ID] || days > windowDays || entity.SameAccount(debit, credit) ||
+				rejected[[2]int64{debit.ID, credit.ID}] ||
+				(!hasHint(debit.Description) && !hasHint(credit.Description)) {
+				continue
+			}
+			switch {
+			case best == nil || days < bestDays:
+				best, bestDays, ambiguous = credit, days, false
+			case days == bestDays:
+				ambiguous = true
+			}
+		}
+		if best == nil || ambiguous {
+			continue
+		}
+
+		transfer, err := entity.NewTransfer(debit, best, entity.TransferStatusSuggested)
+		if err != nil {
+			continue
+		}
+		used[debit.ID] = true
+		used[best.ID] = true
+		matched = append(matched, transfer)
+	}
+	return matched
+}
+
+// hasHint indica se a descrição menciona uma transferência
+func hasHint(description string) bool {
+	words := strings.FieldsFunc(strings.ToUpper(description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if slices.Contains(hintWords, word) {
+			return true
+		}
+		for _, prefix := range hintPrefixes {
+			if strings.HasPrefix(word, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func amountKey(currency string, amount int64) string {
+	return fmt.Sprintf("%s:%d", currency, amount)
+}
+
+// daysBetween retorna a diferença absoluta, em dias, entre as datas das transações
+func daysBetween(a, b *entity.Transaction) int {
+	days := int(b.Date.Sub(a.Date).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
@@ -0,0 +1,150 @@
+package transfer
+
+import (
+	"testing"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+)
+
+func transaction(id, accountID int64, day string, amount int64, description string) *entity.Transaction {
+	date, err := time.Parse("2006-01-02", day)
+	if err != nil {
+		panic(err)
+	}
+	return &entity.Transaction{
+		ID:          id,
+		UserID:      1,
+		DocumentID:  accountID,
+		AccountID:   accountID,
+		Date:        date,
+		Amount:      amount,
+		Currency:    "BRL",
+		Description: description,
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		transactions []*entity.Transaction
+		existing     []*entity.Transfer
+		want         [][2]int64 // Pares débito, crédito sugeridos
+	}{
+		{
+			name: "pagamento da fatura entre conta corrente e cartão",
+			transactions: []*entity.Transaction{
+				transaction(1, 10, "2024-03-05", -150000, "PAGTO FATURA CARTAO"),
+				transaction(2, 20, "2024-03-06", 150000, "PAGAMENTO RECEBIDO"),
+			},
+			want: [][2]int64{{1, 2}},
+		},
+		{
+			name: "Pix entre contas com a indicação apenas no débito",
+			transactions: []*entity.Transaction{
+				transaction(1, 10, "2024-03-05", -5000, "PIX ENVIADO"),
+				transaction(2, 20, "2024-03-05", 5000, "CREDITO EM CONTA"),
+			},
+			want: [][2]int64{{1, 2}},
+		},
+		{
+			name: "mesma conta não é transferência",
+			transactions: []*entity.Transaction{
+				transaction(1, 10, "2024-03-05", -5000, "PIX ENVIADO"),
+				transaction(2, 10, "2024-03-05", 5000, "PIX RECEBIDO"),
+			},
+		},
+		{
+			name: "sem indicação de transferência nas descrições",
+			transactions: []*entity.Transaction{
+				transaction(1, 10, "2024-03-05", -5000, "MERCADO"),
+				transaction(2, 20, "2024-03-05", 5000, "ESTORNO"),
+			},
+		},
+		{
+			name: "fora da janela de dias",
+			transactions: []*entity.Transaction{
+				transaction(1, 10, "2024-03-01", -5000, "TED ENVIADA"),
+				transaction(2, 20, "2024-03-05", 5000, "TED RECEBIDA"),
+			},
+		},
+		{
+			name: "valores diferentes",
+			transactions: []*entity.Transaction{
+				transaction(1, 10, "2024-03-05", -5000, "TED ENVIADA"),
+				transaction(2, 20, "2024-03-05", 5001, "TED RECEBIDA"),
+			},
+		},
+		{
+			name: "crédito mais próximo da data do débito",
+			transactions: []*entity.Transaction{
+				transaction(1, 10, "2024-03-05", -5000, "TED ENVIADA"),
+				transaction(2, 20, "2024-03-07", 5000, "TED RECEBIDA"),
+				transaction(3, 30, "2024-03-06", 5000, "TED RECEBIDA"),
+			},
+			want: [][2]int64{{1, 3}},
+		},
+		{
+			name: "créditos igualmente prováveis não são sugeridos",
+			transactions: []*entity.Transaction{
+				transaction(1, 10, "2024-03-05", -5000, "TED ENVIADA"),
+				transaction(2, 20, "2024-03-06", 5000, "TED RECEBIDA"),
+				transaction(3, 30, "2024-03-06", 5000, "TED RECEBIDA"),
+			},
+		},
+		{
+			name: "cada crédito é usado por um único débito",
+			transactions: []*entity.Transaction{
+				transaction(1, 10, "2024-03-05", -5000, "TED ENVIADA"),
+				transaction(2, 10, "2024-03-06", -5000, "TED ENVIADA"),
+				transaction(3, 20, "2024-03-06", 5000, "TED RECEBIDA"),
+			},
+			want: [][2]int64{{1, 3}},
+		},
+		{
+			name: "par rejeitado não volta a ser sugerido",
+			transactions: []*entity.Transaction{
+				transaction(1, 10, "2024-03-05", -5000, "TED ENVIADA"),
+				transaction(2, 20, "2024-03-05", 5000, "TED RECEBIDA"),
+			},
+			existing: []*entity.Transfer{{
+				Outgoing: entity.TransferLeg{TransactionID: 1},
+				Incoming: entity.TransferLeg{TransactionID: 2},
+				Status:   entity.TransferStatusRejected,
+			}},
+		},
+		{
+			name: "transação de transferência existente não é ligada de novo",
+			transactions: []*entity.Transaction{
+				transaction(1, 10, "2024-03-05", -5000, "TED ENVIADA"),
+				transaction(2, 20, "2024-03-05", 5000, "TED RECEBIDA"),
+				transaction(3, 30, "2024-03-05", 5000, "TED RECEBIDA"),
+			},
+			existing: []*entity.Transfer{{
+				Outgoing: entity.TransferLeg{TransactionID: 9},
+				Incoming: entity.TransferLeg{TransactionID: 3},
+				Status:   entity.TransferStatusSuggested,
+			}},
+			want: [][2]int64{{1, 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Match(tt.transactions, tt.existing, 3)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Match() sugeriu %d transferências, esperadas %d", len(got), len(tt.want))
+			}
+			for i, pair := range tt.want {
+				transfer := got[i]
+				if !transfer.Links(pair[0], pair[1]) {
+					t.Errorf("transferência %d liga %d e %d, esperado %d e %d", i,
+						transfer.Outgoing.TransactionID, transfer.Incoming.TransactionID, pair[0], pair[1])
+				}
+				if transfer.Status != entity.TransferStatusSuggested {
+					t.Errorf("transferência %d com status %s, esperado %s", i, transfer.Status, entity.TransferStatusSuggested)
+				}
+			}
+		})
+	}
+}
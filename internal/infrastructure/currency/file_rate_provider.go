@@ -0,0 +1,178 @@
+package currency
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	domaincurrency "finance-assistant/internal/domain/currency"
+	"finance-assistant/internal/domain/entity"
+)
+
+// dailyRate é o valor exato de uma unidade da moeda, na moeda base, em um dia
+type dailyRate struct {
+	date time.Time
+	rate *big.Rat
+}
+
+// FileRateProvider fornece as cotações de um arquivo local carregado em
+// memória. São aceitos dois formatos:
+//
+//   - o CSV de cotações do Banco Central (PTAX), separado por ";", com a data
+//     (DDMMAAAA), o código e o tipo da moeda, a moeda e as taxas de compra e
+//     de venda em reais; a taxa de venda é usada
+//   - um CSV separado por ",", com a data (AAAA-MM-DD), a moeda e o valor de
+//     uma unidade dela na moeda base, com ou sem cabeçalho
+type FileRateProvider struct {
+	base  string
+	rates map[string][]dailyRate
+}
+
+// NewFileRateProvider carrega as cotações do arquivo em path, cotadas na moeda base
+func NewFileRateProvider(path, base string) (*FileRateProvider, error) {
+	base, err := entity.ParseCurrency(base)
+	if err != nil {
+		return nil, fmt.Errorf("moeda base das cotações inválida: %w", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao abrir arquivo de cotações: %w", err)
+	}
+
+	provider := &FileRateProvider{
+		base:  base,
+		rates: make(map[string][]dailyRate),
+	}
+	if err := provider.load(content); err != nil {
+		return nil, fmt.Errorf("erro ao ler arquivo de cotações %s: %w", path, err)
+	}
+	return provider, nil
+}
+
+func (p *FileRateProvider) Rate(ctx context.Context, from, to string, date time.Time) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	fromRate, err := p.lookup(from, date)
+	if err != nil {
+		return nil, err
+	}
+	toRate, err := p.lookup(to, date)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Rat).Quo(fromRate, toRate), nil
+}
+
+// lookup retorna o valor de uma unidade da moeda na moeda base pela cotação
+// mais recente até a data
+func (p *FileRateProvider) lookup(currency string, date time.Time) (*big.Rat, error) {
+	if currency == p.base {
+		return big.NewRat(1, 1), nil
+	}
+
+	rates := p.rates[currency]
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	i, found := slices.BinarySearchFunc(rates, day, func(r dailyRate, day time.Time) int {
+		return r.date.Compare(day)
+	})
+	if found {
+		return rates[i].rate, nil
+	}
+	if i == 0 {
+		return nil, domaincurrency.ErrRateNotFound
+	}
+	return rates[i-1].rate, nil
+}
+
+func (p *FileRateProvider) load(content []byte) error {
+	// O formato é identificado pelo separador da primeira linha
+	firstLine, _, _ := strings.Cut(string(content), "\n")
+	ptax := strings.Contains(firstLine, ";")
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	if ptax {
+		reader.Comma = ';'
+	}
+
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		// Cabeçalho do CSV simples: a primeira linha sem uma data na primeira coluna
+		if line == 1 && !ptax && !isDate(record[0]) {
+			continue
+		}
+
+		currency, rate, err := parseRecord(record, ptax)
+		if err != nil {
+			return fmt.Errorf("linha %d: %w", line, err)
+		}
+		p.rates[currency] = append(p.rates[currency], rate)
+	}
+
+	for currency, rates := range p.rates {
+		slices.SortFunc(rates, func(a, b dailyRate) int {
+			return a.date.Compare(b.date)
+		})
+		p.rates[currency] = slices.CompactFunc(rates, func(a, b dailyRate) bool {
+			return a.date.Equal(b.date)
+		})
+	}
+	return nil
+}
+
+func isDate(value string) bool {
+	_, err := time.Parse("2006-01-02", strings.TrimSpace(value))
+	return err == nil
+}
+
+// parseRecord lê a moeda e a cotação de uma linha no formato PTAX ou no CSV simples
+func parseRecord(record []string, ptax bool) (string, dailyRate, error) {
+	var date, currency, rate string
+	layout := "2006-01-02"
+	if ptax {
+		if len(record) < 6 {
+			return "", dailyRate{}, errors.New("esperadas ao menos 6 colunas")
+		}
+		date, currency, rate = record[0], record[3], strings.ReplaceAll(record[5], ",", ".")
+		layout = "02012006"
+	} else {
+		if len(record) != 3 {
+			return "", dailyRate{}, errors.New("esperadas 3 colunas: data, moeda e cotação")
+		}
+		date, currency, rate = record[0], record[1], record[2]
+	}
+
+	parsedDate, err := time.Parse(layout, strings.TrimSpace(date))
+	if err != nil {
+		return "", dailyRate{}, fmt.Errorf("data inválida: %s", date)
+	}
+	currency, err = entity.ParseCurrency(currency)
+	if err != nil {
+		return "", dailyRate{}, err
+	}
+	// A cotação é lida como decimal exato, sem arredondamento de ponto flutuante
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || value.Sign() <= 0 {
+		return "", dailyRate{}, fmt.Errorf("cotação inválida: %s", rate)
+	}
+	return currency, dailyRate{date: parsedDate, rate: value}, nil
+}
@@ -0,0 +1,117 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	domaincurrency "finance-assistant/internal/domain/currency"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestFileRateProvider(t *testing.T) {
+	ptax, err := NewFileRateProvider(filepath.Join("testdata", "ptax.csv"), "BRL")
+	if err != nil {
+		t.Fatalf("NewFileRateProvider(ptax) erro = %v", err)
+	}
+	simple, err := NewFileRateProvider(filepath.Join("testdata", "rates.csv"), "eur")
+	if err != nil {
+		t.Fatalf("NewFileRateProvider(rates) erro = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		provider *FileRateProvider
+		from, to string
+		date     time.Time
+		want     string // Cotação exata, como fração ou decimal
+		wantErr  error
+	}{
+		{name: "PTAX usa a taxa de venda", provider: ptax, from: "USD", to: "BRL", date: day(2024, 1, 2), want: "4.8913"},
+		{name: "PTAX de outra moeda", provider: ptax, from: "EUR", to: "BRL", date: day(2024, 1, 3), want: "5.3742"},
+		{name: "moeda base para cotada", provider: ptax, from: "BRL", to: "USD", date: day(2024, 1, 3), want: "10000/49197"},
+		{name: "entre duas cotadas", provider: ptax, from: "EUR", to: "USD", date: day(2024, 1, 5), want: "53528/48987"},
+		{name: "feriado usa o dia anterior", provider: ptax, from: "USD", to: "BRL", date: day(2024, 1, 4), want: "4.9197"},
+		{name: "fim de semana usa a sexta-feira", provider: ptax, from: "USD", to: "BRL", date: day(2024, 1, 7), want: "4.8987"},
+		{name: "horário do dia é ignorado", provider: ptax, from: "USD", to: "BRL", date: time.Date(2024, 1, 9, 23, 59, 0, 0, time.UTC), want: "4.9018"},
+		{name: "cotações de dias diferentes", provider: ptax, from: "USD", to: "EUR", date: day(2024, 1, 9), want: "49018/53528"},
+		{name: "antes da primeira cotação", provider: ptax, from: "USD", to: "BRL", date: day(2024, 1, 1), wantErr: domaincurrency.ErrRateNotFound},
+		{name: "moeda sem cotação", provider: ptax, from: "GBP", to: "BRL", date: day(2024, 1, 5), wantErr: domaincurrency.ErrRateNotFound},
+		{name: "mesma moeda sem cotação", provider: ptax, from: "GBP", to: "GBP", date: day(2024, 1, 5), want: "1"},
+		{name: "CSV simples com cabeçalho", provider: simple, from: "USD", to: "EUR", date: day(2024, 1, 2), want: "0.91"},
+		{name: "CSV simples fora de ordem", provider: simple, from: "USD", to: "EUR", date: day(2024, 1, 10), want: "0.92"},
+		{name: "CSV simples entre duas cotadas", provider: simple, from: "JPY", to: "USD", date: day(2024, 1, 3), want: "63/9200"},
+		{name: "CSV simples na moeda base", provider: simple, from: "EUR", to: "USD", date: day(2024, 1, 2), want: "100/91"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.provider.Rate(context.Background(), tt.from, tt.to, tt.date)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Rate() erro = %v, esperado %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Rate() erro = %v", err)
+			}
+			want, _ := new(big.Rat).SetString(tt.want)
+			if got.Cmp(want) != 0 {
+				t.Errorf("Rate() = %s, esperado %s", got.RatString(), want.RatString())
+			}
+		})
+	}
+}
+
+func TestFileRateProviderInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		base    string
+	}{
+		{name: "moeda base inválida", content: "2024-01-02,USD,0.91\n", base: "REAL"},
+		{name: "cotação inválida", content: "date,currency,rate\n2024-01-02,USD,abc\n", base: "BRL"},
+		{name: "cotação negativa na primeira linha", content: "2024-01-02,USD,-1\n", base: "BRL"},
+		{name: "cotação zero", content: "2024-01-02,USD,0.91\n2024-01-03,USD,0\n", base: "BRL"},
+		{name: "data inválida", content: "date,currency,rate\n02/01/2024,USD,0.91\n", base: "BRL"},
+		{name: "colunas faltando", content: "date,currency,rate\n2024-01-02,USD\n", base: "BRL"},
+		{name: "PTAX com colunas faltando", content: "02012024;220;A;USD;4,8507\n", base: "BRL"},
+		{name: "PTAX com moeda inválida", content: "02012024;220;A;US;4,8507;4,8513\n", base: "BRL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rates.csv")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := NewFileRateProvider(path, tt.base); err == nil {
+				t.Error("NewFileRateProvider() deveria falhar")
+			}
+		})
+	}
+
+	if _, err := NewFileRateProvider(filepath.Join(t.TempDir(), "inexistente.csv"), "BRL"); err == nil {
+		t.Error("NewFileRateProvider() de arquivo inexistente deveria falhar")
+	}
+}
+
+func TestNoRateProvider(t *testing.T) {
+	provider := noRateProvider{}
+
+	rate, err := provider.Rate(context.Background(), "BRL", "BRL", day(2024, 1, 2))
+	if err != nil || rate.Cmp(big.NewRat(1, 1)) != 0 {
+		t.Errorf("Rate() = %v, %v, esperado 1", rate, err)
+	}
+	if _, err := provider.Rate(context.Background(), "USD", "BRL", day(2024, 1, 2)); !errors.Is(err, domaincurrency.ErrRateNotFound) {
+		t.Errorf("Rate() erro = %v, esperado ErrRateNotFound", err)
+	}
+}
@@ -0,0 +1,29 @@
+package currency
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"finance-assistant/config"
+	domaincurrency "finance-assistant/internal/domain/currency"
+)
+
+// NewRateProvider cria o provedor de cotações a partir de FX_RATES_FILE. Sem
+// arquivo configurado, apenas valores já na moeda pedida são aceitos.
+func NewRateProvider(cfg *config.Config) (domaincurrency.FXRateProvider, error) {
+	if cfg.FXRatesFile == "" {
+		return noRateProvider{}, nil
+	}
+	return NewFileRateProvider(cfg.FXRatesFile, cfg.FXBaseCurrency)
+}
+
+// noRateProvider não tem cotações
+type noRateProvider struct{}
+
+func (noRateProvider) Rate(ctx context.Context, from, to string, date time.Time) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+	return nil, domaincurrency.ErrRateNotFound
+}
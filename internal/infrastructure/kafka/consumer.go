@@ -0,0 +1,164 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"finance-assistant/config"
+	"finance-assistant/internal/domain/messaging"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+const (
+	commitInterval    = time.Second
+	pollTimeoutMillis = 100
+)
+
+// Consumer representa um consumidor Kafka baseado em consumer group
+type Consumer struct {
+	consumer *kafka.Consumer
+	topic    string
+	tracker  *offsetTracker
+}
+
+// NewConsumer cria um novo consumidor Kafka com confirmação manual de offsets
+func NewConsumer(cfg *config.Config) (*Consumer, error) {
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":        cfg.KafkaBrokers[0],
+		"client.id":                "finance-assistant-worker",
+		"group.id":                 cfg.KafkaGroupID,
+		"auto.offset.reset":        "earliest",
+		"enable.auto.commit":       false,
+		"fetch.message.max.bytes":  16777216, // 16MB
+		"socket.keepalive.enable":  "true",
+		"reconnect.backoff.ms":     "100",
+		"reconnect.backoff.max.ms": "10000",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar consumidor Kafka: %w", err)
+	}
+
+	log.Printf("Consumidor Kafka conectado ao broker: %s (grupo %s)", cfg.KafkaBrokers[0], cfg.KafkaGroupID)
+
+	return &Consumer{
+		consumer: consumer,
+		topic:    cfg.KafkaTopic,
+		tracker:  newOffsetTracker(),
+	}, nil
+}
+
+// Run consome mensagens até o contexto ser cancelado, executando até
+// concurrency handlers em paralelo. Os offsets só são confirmados depois que
+// o handler retorna sem erro ou, esgotadas as tentativas, depois que a falha
+// é entregue a fail. Ao encerrar, aguarda os handlers em andamento.
+func (c *Consumer) Run(ctx context.Context, concurrency int, handler messaging.DocumentHandler, fail messaging.DocumentFailureHandler) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	if err := c.consumer.SubscribeTopics([]string{c.topic}, c.rebalance); err != nil {
+		return fmt.Errorf("erro ao assinar tópico %s: %w", c.topic, err)
+	}
+	log.Printf("Consumindo tópico %s com concorrência %d", c.topic, concurrency)
+
+	// Handlers usam um contexto que não é cancelado no desligamento, para que
+	// atualizações em andamento no banco terminem; ctx só interrompe as retentativas
+	handlerCtx := context.WithoutCancel(ctx)
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	lastCommit := time.Now()
+
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		default:
+		}
+
+		if time.Since(lastCommit) >= commitInterval {
+			c.commit()
+			lastCommit = time.Now()
+		}
+
+		switch ev := c.consumer.Poll(pollTimeoutMillis).(type) {
+		case *kafka.Message:
+			tp := ev.TopicPartition
+
+			var message messaging.DocumentMessage
+			if err := json.Unmarshal(ev.Value, &message); err != nil {
+				// Mensagem malformada nunca poderá ser processada; apenas descartar
+				log.Printf("Mensagem inválida em %v: %v", tp, err)
+				c.tracker.Start(tp)
+				c.tracker.Done(tp)
+				continue
+			}
+
+			c.tracker.Start(tp)
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				break loop
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				if messaging.HandleWithRetry(ctx, handlerCtx, &message, handler, fail) {
+					c.tracker.Done(tp)
+				}
+			}()
+		case kafka.Error:
+			log.Printf("Erro Kafka: %v", ev)
+		}
+	}
+
+	log.Println("Aguardando mensagens em processamento...")
+	wg.Wait()
+	c.commit()
+
+	return nil
+}
+
+// commit confirma no broker os offsets já concluídos
+func (c *Consumer) commit() {
+	offsets := c.tracker.Commitable()
+	if len(offsets) == 0 {
+		return
+	}
+
+	if _, err := c.consumer.CommitOffsets(offsets); err != nil {
+		log.Printf("Erro ao confirmar offsets: %v", err)
+		return
+	}
+	c.tracker.Committed(offsets)
+}
+
+// rebalance confirma os offsets concluídos antes de perder as partições
+func (c *Consumer) rebalance(consumer *kafka.Consumer, ev kafka.Event) error {
+	switch e := ev.(type) {
+	case kafka.AssignedPartitions:
+		log.Printf("Partições atribuídas: %v", e.Partitions)
+	case kafka.RevokedPartitions:
+		log.Printf("Partições revogadas: %v", e.Partitions)
+		c.commit()
+		c.tracker.Forget(e.Partitions)
+	}
+	return nil
+}
+
+// Close fecha o consumidor, deixando o grupo
+func (c *Consumer) Close() {
+	if err := c.consumer.Close(); err != nil {
+		log.Printf("Erro ao fechar consumidor Kafka: %v", err)
+		return
+	}
+	log.Println("Consumidor Kafka fechado")
+}
@@ -0,0 +1,116 @@
+package kafka
+
+import (
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+// offsetTracker acompanha mensagens em processamento concorrente e calcula,
+// por partição, o maior offset contíguo já concluído. Assim um offset só é
+// confirmado quando todas as mensagens anteriores da partição terminaram.
+type offsetTracker struct {
+	mu         sync.Mutex
+	partitions map[partitionKey]*partitionState
+}
+
+type partitionKey struct {
+	topic     string
+	partition int32
+}
+
+type partitionState struct {
+	inFlight  []*trackedOffset
+	committed kafka.Offset
+	ready     kafka.Offset
+}
+
+type trackedOffset struct {
+	offset kafka.Offset
+	done   bool
+}
+
+func newOffsetTracker() *offsetTracker {
+	return &offsetTracker{
+		partitions: make(map[partitionKey]*partitionState),
+	}
+}
+
+// Start registra uma mensagem recebida, antes de iniciar seu processamento
+func (t *offsetTracker) Start(tp kafka.TopicPartition) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := partitionKey{topic: *tp.Topic, partition: tp.Partition}
+	state, ok := t.partitions[key]
+	if !ok {
+		state = &partitionState{committed: kafka.OffsetInvalid, ready: kafka.OffsetInvalid}
+		t.partitions[key] = state
+	}
+	state.inFlight = append(state.inFlight, &trackedOffset{offset: tp.Offset})
+}
+
+// Done marca uma mensagem como concluída
+func (t *offsetTracker) Done(tp kafka.TopicPartition) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, ok := t.partitions[partitionKey{topic: *tp.Topic, partition: tp.Partition}]
+	if !ok {
+		return
+	}
+	for _, tracked := range state.inFlight {
+		if tracked.offset == tp.Offset {
+			tracked.done = true
+			break
+		}
+	}
+
+	// Avançar enquanto o início da fila estiver concluído
+	for len(state.inFlight) > 0 && state.inFlight[0].done {
+		state.ready = state.inFlight[0].offset + 1
+		state.inFlight = state.inFlight[1:]
+	}
+}
+
+// Commitable retorna os offsets que podem ser confirmados no broker
+func (t *offsetTracker) Commitable() []kafka.TopicPartition {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var offsets []kafka.TopicPartition
+	for key, state := range t.partitions {
+		if state.ready == kafka.OffsetInvalid || state.ready == state.committed {
+			continue
+		}
+		topic := key.topic
+		offsets = append(offsets, kafka.TopicPartition{
+			Topic:     &topic,
+			Partition: key.partition,
+			Offset:    state.ready,
+		})
+	}
+	return offsets
+}
+
+// Committed registra que os offsets foram confirmados com sucesso
+func (t *offsetTracker) Committed(offsets []kafka.TopicPartition) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, tp := range offsets {
+		if state, ok := t.partitions[partitionKey{topic: *tp.Topic, partition: tp.Partition}]; ok {
+			state.committed = tp.Offset
+		}
+	}
+}
+
+// Forget descarta o estado de partições revogadas no rebalanceamento
+func (t *offsetTracker) Forget(partitions []kafka.TopicPartition) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, tp := range partitions {
+		delete(t.partitions, partitionKey{topic: *tp.Topic, partition: tp.Partition})
+	}
+}
@@ -1,13 +1,12 @@
 package kafka
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"finance-assistant/config"
-	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/messaging"
 	"github.com/confluentinc/confluent-kafka-go/kafka"
 )
 
@@ -17,20 +16,6 @@ type Producer struct {
 	topic    string
 }
 
-// DocumentMessage representa a mensagem que será enviada para o Kafka
-type DocumentMessage struct {
-	ID           string    `json:"id"`
-	ExternalID   string    `json:"external_id"`
-	UserID       string    `json:"user_id"`
-	DocumentType string    `json:"document_type"`
-	Filename     string    `json:"filename"`
-	ContentType  string    `json:"content_type"`
-	FileContent  string    `json:"file_content"`
-	Categories   []string  `json:"categories"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
-}
-
 // NewProducer cria um novo produtor Kafka
 func NewProducer(cfg *config.Config) (*Producer, error) {
 	// Configurações adicionais para o produtor
@@ -38,6 +23,7 @@ func NewProducer(cfg *config.Config) (*Producer, error) {
 		"bootstrap.servers":        cfg.KafkaBrokers[0],
 		"client.id":                "finance-assistant",
 		"acks":                     "all",
+		"enable.idempotence":       true,     // Reenvios internos do produtor não duplicam mensagens
 		"delivery.timeout.ms":      "30000",  // 30 segundos timeout
 		"request.timeout.ms":       "15000",  // 15 segundo timeout
 		"message.max.bytes":        16777216, // 16MB
@@ -77,91 +63,49 @@ func NewProducer(cfg *config.Config) (*Producer, error) {
 	}, nil
 }
 
-// SendDocument envia um documento para o Kafka
-func (p *Producer) SendDocument(document *entity.Document) error {
-	log.Printf("Enviando documento %s para processamento...", document.ExternalID)
-
-	// Converter documento para mensagem
-	message := DocumentMessage{
-		ID:           fmt.Sprintf("%d", document.ID),
-		ExternalID:   document.ExternalID.String(),
-		UserID:       fmt.Sprintf("%d", document.UserID),
-		DocumentType: document.DocumentType,
-		Filename:     document.Filename,
-		ContentType:  document.ContentType,
-		FileContent:  document.FileContent,
-		Categories:   document.Categories,
-		CreatedAt:    document.CreatedAt,
-		UpdatedAt:    document.UpdatedAt,
+// PublishDocument envia uma mensagem ao tópico configurado e aguarda a confirmação
+// de entrega. Não há timeout próprio: delivery.timeout.ms garante que o relatório
+// de entrega chegue, evitando tratar como falha uma mensagem entregue com atraso.
+func (p *Producer) PublishDocument(ctx context.Context, message messaging.Message) error {
+	kafkaHeaders := []kafka.Header{
+		{
+			Key:   "content_type",
+			Value: []byte("application/json"),
+		},
+		{
+			Key:   "source",
+			Value: []byte("finance-assistant"),
+		},
 	}
-
-	// Converter mensagem para JSON
-	messageJSON, err := json.Marshal(message)
-	if err != nil {
-		return fmt.Errorf("erro ao serializar mensagem: %w", err)
+	for name, value := range message.Headers {
+		kafkaHeaders = append(kafkaHeaders, kafka.Header{Key: name, Value: []byte(value)})
 	}
 
-	// Criar tópico se não existir (opcional)
-	// Isso requer permissões administrativas no Kafka
-	/*
-		adminClient, err := kafka.NewAdminClientFromProducer(p.producer)
-		if err == nil {
-			topicResults, err := adminClient.CreateTopics(
-				[]kafka.TopicSpecification{{
-					Topic:             p.topic,
-					NumPartitions:     1,
-					ReplicationFactor: 1,
-				}},
-				kafka.SetAdminOperationTimeout(time.Second*10),
-			)
-			if err != nil {
-				log.Printf("Aviso: Não foi possível criar tópico: %v", err)
-			}
-			for _, result := range topicResults {
-				if result.Error.Code() != kafka.ErrNoError &&
-				   result.Error.Code() != kafka.ErrTopicAlreadyExists {
-					log.Printf("Aviso: Erro ao criar tópico %s: %v", result.Topic, result.Error.String())
-				}
-			}
-			adminClient.Close()
-		}
-	*/
-
-	// Enviar mensagem com timeout
 	deliveryChan := make(chan kafka.Event, 1)
-	err = p.producer.Produce(&kafka.Message{
+	err := p.producer.Produce(&kafka.Message{
 		TopicPartition: kafka.TopicPartition{
 			Topic:     &p.topic,
 			Partition: kafka.PartitionAny,
 		},
-		Value: messageJSON,
-		Key:   []byte(document.ExternalID.String()),
-		Headers: []kafka.Header{
-			{
-				Key:   "content_type",
-				Value: []byte("application/json"),
-			},
-			{
-				Key:   "source",
-				Value: []byte("finance-assistant"),
-			},
-		},
+		Value:   message.Payload,
+		Key:     []byte(message.Key),
+		Headers: kafkaHeaders,
 	}, deliveryChan)
 	if err != nil {
 		return fmt.Errorf("erro ao produzir mensagem: %w", err)
 	}
 
-	// Aguardar confirmação de entrega com timeout
+	// Aguardar confirmação de entrega
 	select {
 	case e := <-deliveryChan:
 		m := e.(*kafka.Message)
 		if m.TopicPartition.Error != nil {
 			return fmt.Errorf("erro ao entregar mensagem: %w", m.TopicPartition.Error)
 		}
-		log.Printf("Documento %s enviado com sucesso para o tópico %s [%d] @ %v",
-			document.ExternalID, *m.TopicPartition.Topic, m.TopicPartition.Partition, m.TopicPartition.Offset)
-	case <-time.After(5 * time.Second):
-		return fmt.Errorf("timeout ao aguardar confirmação de entrega")
+		log.Printf("Mensagem %s enviada com sucesso para o tópico %s [%d] @ %v",
+			message.Key, *m.TopicPartition.Topic, m.TopicPartition.Partition, m.TopicPartition.Offset)
+	case <-ctx.Done():
+		return fmt.Errorf("confirmação de entrega não recebida: %w", ctx.Err())
 	}
 
 	return nil
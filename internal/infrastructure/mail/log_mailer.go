@@ -0,0 +1,21 @@
+package mail
+
+import (
+	"context"
+	"log"
+
+	domainmail "finance-assistant/internal/domain/mail"
+)
+
+// LogMailer apenas registra os emails no log; usado em desenvolvimento e
+// quando não há servidor SMTP configurado
+type LogMailer struct{}
+
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(ctx context.Context, message domainmail.Message) error {
+	log.Printf("Email para %s: %s\n%s", message.To, message.Subject, message.Body)
+	return nil
+}
@@ -0,0 +1,31 @@
+package mail
+
+import (
+	"fmt"
+
+	"finance-assistant/config"
+	domainmail "finance-assistant/internal/domain/mail"
+)
+
+const (
+	DriverLog  = "log"
+	DriverSMTP = "smtp"
+)
+
+// NewMailer cria o envio de emails conforme MAIL_DRIVER
+func NewMailer(cfg *config.Config) (domainmail.Mailer, error) {
+	switch cfg.MailDriver {
+	case DriverLog, "":
+		return NewLogMailer(), nil
+	case DriverSMTP:
+		return NewSMTPMailer(SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.MailFrom,
+		})
+	default:
+		return nil, fmt.Errorf("driver de email desconhecido: %s", cfg.MailDriver)
+	}
+}
@@ -0,0 +1,67 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	domainmail "finance-assistant/internal/domain/mail"
+)
+
+// SMTPConfig reúne as configurações do servidor SMTP
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // Vazio quando o servidor não exige autenticação
+	Password string
+	From     string
+}
+
+// SMTPMailer envia os emails por um servidor SMTP
+type SMTPMailer struct {
+	address string
+	auth    smtp.Auth
+	from    string
+}
+
+func NewSMTPMailer(cfg SMTPConfig) (*SMTPMailer, error) {
+	if cfg.Host == "" || cfg.From == "" {
+		return nil, errors.New("SMTP_HOST e MAIL_FROM são obrigatórios com MAIL_DRIVER=smtp")
+	}
+
+	mailer := &SMTPMailer{
+		address: net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		from:    cfg.From,
+	}
+	if cfg.Username != "" {
+		mailer.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return mailer, nil
+}
+
+// Send entrega a mensagem ao servidor; net/smtp não aceita contexto, então o
+// cancelamento só é verificado antes do envio
+func (m *SMTPMailer) Send(ctx context.Context, message domainmail.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var content strings.Builder
+	fmt.Fprintf(&content, "From: %s\r\n", m.from)
+	fmt.Fprintf(&content, "To: %s\r\n", message.To)
+	fmt.Fprintf(&content, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	content.WriteString("MIME-Version: 1.0\r\n")
+	content.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	content.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	content.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+
+	if err := smtp.SendMail(m.address, m.auth, m.from, []string{message.To}, []byte(content.String())); err != nil {
+		return fmt.Errorf("erro ao enviar email: %w", err)
+	}
+	return nil
+}
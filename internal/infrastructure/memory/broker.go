@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"sync"
+
+	"finance-assistant/internal/domain/messaging"
+)
+
+// defaultBufferSize é a quantidade de mensagens aguardando processamento antes
+// que a publicação passe a bloquear
+const defaultBufferSize = 1000
+
+var ErrBrokerClosed = errors.New("broker em memória encerrado")
+
+// Broker é um broker de documentos em processo, baseado em canal. Implementa
+// messaging.DocumentPublisher e messaging.DocumentConsumer para executar o fluxo
+// de upload e processamento em um único binário (desenvolvimento local e testes).
+// As mensagens não são persistidas: as que aguardam processamento no
+// desligamento são perdidas.
+type Broker struct {
+	messages chan messaging.Message
+	done     chan struct{}
+	once     sync.Once
+}
+
+func NewBroker(bufferSize int) *Broker {
+	if bufferSize < 1 {
+		bufferSize = defaultBufferSize
+	}
+
+	return &Broker{
+		messages: make(chan messaging.Message, bufferSize),
+		done:     make(chan struct{}),
+	}
+}
+
+// PublishDocument enfileira a mensagem; a entrega é confirmada ao entrar na fila
+func (b *Broker) PublishDocument(ctx context.Context, message messaging.Message) error {
+	select {
+	case <-b.done:
+		return ErrBrokerClosed
+	default:
+	}
+
+	select {
+	case b.messages <- message:
+		return nil
+	case <-b.done:
+		return ErrBrokerClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Run consome mensagens até o contexto ser cancelado ou o broker ser fechado,
+// executando até concurrency handlers em paralelo
+func (b *Broker) Run(ctx context.Context, concurrency int, handler messaging.DocumentHandler, fail messaging.DocumentFailureHandler) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	log.Printf("Consumindo mensagens do broker em memória com concorrência %d", concurrency)
+
+	// Sem consumidores ninguém lerá a fila: novas publicações passam a falhar e
+	// os eventos permanecem pendentes no outbox
+	defer b.Close()
+
+	// Handlers usam um contexto que não é cancelado no desligamento, para que
+	// atualizações em andamento no banco terminem; ctx só interrompe as retentativas
+	handlerCtx := context.WithoutCancel(ctx)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-b.done:
+					return
+				case message := <-b.messages:
+					b.handle(ctx, handlerCtx, message, handler, fail)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	return nil
+}
+
+func (b *Broker) handle(ctx, handlerCtx context.Context, message messaging.Message, handler messaging.DocumentHandler, fail messaging.DocumentFailureHandler) {
+	var document messaging.DocumentMessage
+	if err := json.Unmarshal(message.Payload, &document); err != nil {
+		// Mensagem malformada nunca poderá ser processada; apenas descartar
+		log.Printf("Mensagem inválida para a chave %s: %v", message.Key, err)
+		return
+	}
+
+	if !messaging.HandleWithRetry(ctx, handlerCtx, &document, handler, fail) {
+		log.Printf("Processamento do documento %s interrompido pelo desligamento", document.ExternalID)
+	}
+}
+
+// Close encerra o broker; publicações e consumidores em andamento são interrompidos
+func (b *Broker) Close() {
+	b.once.Do(func() {
+		close(b.done)
+		log.Println("Broker em memória fechado")
+	})
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const accountSelect = `
+		SELECT
+			id, external_id, user_id, name, institution, account_type, currency, masked_number,
+			opening_balance, source_account, created_at, updated_at
+		FROM accounts
+`
+
+type PostgresAccountRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresAccountRepository(db *sqlx.DB) *PostgresAccountRepository {
+	return &PostgresAccountRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresAccountRepository) Create(ctx context.Context, account *entity.Account) error {
+	query := `
+		INSERT INTO accounts (
+			external_id, user_id, name, institution, account_type, currency, masked_number,
+			opening_balance, source_account, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id
+	`
+
+	err := database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		account.ExternalID,
+		account.UserID,
+		account.Name,
+		account.Institution,
+		account.Type,
+		account.Currency,
+		account.MaskedNumber,
+		account.OpeningBalance,
+		account.SourceAccount,
+		account.CreatedAt,
+		account.UpdatedAt,
+	).Scan(&account.ID)
+
+	if err != nil {
+		return fmt.Errorf("error creating account: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAccountRepository) FindByID(ctx context.Context, id int64) (*entity.Account, error) {
+	var account entity.Account
+
+	query := accountSelect + `
+		WHERE id = $1
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &account, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding account by ID: %w", err)
+	}
+
+	return &account, nil
+}
+
+func (r *PostgresAccountRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Account, error) {
+	var account entity.Account
+
+	query := accountSelect + `
+		WHERE external_id = $1
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &account, query, externalID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding account by external ID: %w", err)
+	}
+
+	return &account, nil
+}
+
+func (r *PostgresAccountRepository) FindByUserID(ctx context.Context, userID int64) ([]*entity.Account, error) {
+	var accounts []*entity.Account
+
+	query := accountSelect + `
+		WHERE user_id = $1
+		ORDER BY name, id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &accounts, query, userID); err != nil {
+		return nil, fmt.Errorf("error finding accounts by user ID: %w", err)
+	}
+
+	return accounts, nil
+}
+
+func (r *PostgresAccountRepository) Update(ctx context.Context, account *entity.Account) error {
+	query := `
+		UPDATE accounts
+		SET name = $1, institution = $2, account_type = $3, currency = $4, masked_number = $5,
+			opening_balance = $6, updated_at = $7
+		WHERE id = $8
+	`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		account.Name,
+		account.Institution,
+		account.Type,
+		account.Currency,
+		account.MaskedNumber,
+		account.OpeningBalance,
+		account.UpdatedAt,
+		account.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating account: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no account found with ID: %d", account.ID)
+	}
+
+	return nil
+}
+
+func (r *PostgresAccountRepository) UpdateSourceAccount(ctx context.Context, id int64, sourceAccount string) error {
+	query := `UPDATE accounts SET source_account = $1, updated_at = NOW() WHERE id = $2`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, sourceAccount, id); err != nil {
+		return fmt.Errorf("error updating account source: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAccountRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM accounts WHERE id = $1`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("error deleting account: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no account found with ID: %d", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresAccountStatementRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresAccountStatementRepository(db *sqlx.DB) *PostgresAccountStatementRepository {
+	return &PostgresAccountStatementRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresAccountStatementRepository) ReplaceForDocument(ctx context.Context, documentID int64, statements []*entity.AccountStatement) error {
+	conn := database.Conn(ctx, r.db)
+
+	query := `DELETE FROM account_statements WHERE document_id = $1`
+	if _, err := conn.ExecContext(ctx, query, documentID); err != nil {
+		return fmt.Errorf("error deleting account statements: %w", err)
+	}
+
+	query = `
+		INSERT INTO account_statements (
+			account_id, document_id, source_account, start_date, end_date, opening_balance,
+			closing_balance, balance_date, expected_balance, difference, status, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id
+	`
+
+	for _, statement := range statements {
+		err := conn.QueryRowxContext(
+			ctx,
+			query,
+			statement.AccountID,
+			documentID,
+			statement.SourceAccount,
+			statement.StartDate,
+			statement.EndDate,
+			statement.OpeningBalance,
+			statement.ClosingBalance,
+			statement.BalanceDate,
+			statement.ExpectedBalance,
+			statement.Difference,
+			statement.Status,
+			statement.CreatedAt,
+		).Scan(&statement.ID)
+		if err != nil {
+			return fmt.Errorf("error creating account statement: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresAccountStatementRepository) FindByAccountID(ctx context.Context, accountID int64) ([]*entity.AccountStatement, error) {
+	var statements []*entity.AccountStatement
+
+	query := `
+		SELECT
+			s.id, s.account_id, s.document_id, d.external_id AS document_external_id,
+			s.source_account, s.start_date, s.end_date, s.opening_balance, s.closing_balance, s.balance_date,
+			s.expected_balance, s.difference, s.status, s.created_at
+		FROM account_statements s
+		JOIN documents d ON d.id = s.document_id
+		WHERE s.account_id = $1
+		ORDER BY s.start_date, s.end_date, s.id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &statements, query, accountID); err != nil {
+		return nil, fmt.Errorf("error finding account statements by account ID: %w", err)
+	}
+
+	return statements, nil
+}
+
+func (r *PostgresAccountStatementRepository) UpdateReconciliation(ctx context.Context, statement *entity.AccountStatement) error {
+	query := `
+		UPDATE account_statements
+		SET expected_balance = $1, difference = $2, status = $3
+		WHERE id = $4
+	`
+
+	_, err := database.Conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		statement.ExpectedBalance,
+		statement.Difference,
+		statement.Status,
+		statement.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating account statement reconciliation: %w", err)
+	}
+
+	return nil
+}
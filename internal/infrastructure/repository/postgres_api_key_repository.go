@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const apiKeySelect = `
+		SELECT
+			id, external_id, user_id, name, prefix, key_hash, scopes,
+			expires_at, last_used_at, revoked_at, created_at
+		FROM api_keys
+`
+
+// apiKeyRow representa a linha do banco; scopes é JSONB
+type apiKeyRow struct {
+	ID         int64      `db:"id"`
+	ExternalID uuid.UUID  `db:"external_id"`
+	UserID     int64      `db:"user_id"`
+	Name       string     `db:"name"`
+	Prefix     string     `db:"prefix"`
+	KeyHash    string     `db:"key_hash"`
+	Scopes     []byte     `db:"scopes"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+func (row *apiKeyRow) toEntity() (*entity.APIKey, error) {
+	var scopes []string
+	if err := json.Unmarshal(row.Scopes, &scopes); err != nil {
+		return nil, fmt.Errorf("error unmarshaling scopes: %w", err)
+	}
+
+	return &entity.APIKey{
+		ID:         row.ID,
+		ExternalID: row.ExternalID,
+		UserID:     row.UserID,
+		Name:       row.Name,
+		Prefix:     row.Prefix,
+		KeyHash:    row.KeyHash,
+		Scopes:     scopes,
+		ExpiresAt:  row.ExpiresAt,
+		LastUsedAt: row.LastUsedAt,
+		RevokedAt:  row.RevokedAt,
+		CreatedAt:  row.CreatedAt,
+	}, nil
+}
+
+type PostgresAPIKeyRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresAPIKeyRepository(db *sqlx.DB) *PostgresAPIKeyRepository {
+	return &PostgresAPIKeyRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresAPIKeyRepository) Create(ctx context.Context, key *entity.APIKey) error {
+	scopesJSON, err := json.Marshal(key.Scopes)
+	if err != nil {
+		return fmt.Errorf("error marshaling scopes: %w", err)
+	}
+
+	query := `
+		INSERT INTO api_keys (external_id, user_id, name, prefix, key_hash, scopes, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	err = database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		key.ExternalID,
+		key.UserID,
+		key.Name,
+		key.Prefix,
+		key.KeyHash,
+		scopesJSON,
+		key.ExpiresAt,
+		key.CreatedAt,
+	).Scan(&key.ID)
+
+	if err != nil {
+		return fmt.Errorf("error creating api key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAPIKeyRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.APIKey, error) {
+	return r.findOne(ctx, apiKeySelect+" WHERE external_id = $1", externalID)
+}
+
+func (r *PostgresAPIKeyRepository) FindByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	return r.findOne(ctx, apiKeySelect+" WHERE key_hash = $1", keyHash)
+}
+
+func (r *PostgresAPIKeyRepository) findOne(ctx context.Context, query string, arg any) (*entity.APIKey, error) {
+	var row apiKeyRow
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &row, query, arg); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding api key: %w", err)
+	}
+
+	return row.toEntity()
+}
+
+func (r *PostgresAPIKeyRepository) FindByUserID(ctx context.Context, userID int64) ([]*entity.APIKey, error) {
+	var rows []apiKeyRow
+
+	query := apiKeySelect + " WHERE user_id = $1 ORDER BY created_at DESC"
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &rows, query, userID); err != nil {
+		return nil, fmt.Errorf("error finding api keys by user ID: %w", err)
+	}
+
+	keys := make([]*entity.APIKey, 0, len(rows))
+	for i := range rows {
+		key, err := rows[i].toEntity()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (r *PostgresAPIKeyRepository) Revoke(ctx context.Context, id int64) error {
+	query := `
+		UPDATE api_keys
+		SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("error revoking api key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAPIKeyRepository) TouchLastUsed(ctx context.Context, id int64) error {
+	// Atualizar no máximo uma vez por minuto evita uma escrita a cada requisição
+	query := `
+		UPDATE api_keys
+		SET last_used_at = NOW()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("error updating api key last use: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresAuthSessionRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresAuthSessionRepository(db *sqlx.DB) *PostgresAuthSessionRepository {
+	return &PostgresAuthSessionRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresAuthSessionRepository) Create(ctx context.Context, session *entity.AuthSession) error {
+	query := `
+		INSERT INTO auth_sessions (id, user_id, refresh_token_hash, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := database.Conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		session.ID,
+		session.UserID,
+		session.RefreshTokenHash,
+		session.ExpiresAt,
+		session.CreatedAt,
+		session.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating auth session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAuthSessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.AuthSession, error) {
+	var session entity.AuthSession
+
+	query := `
+		SELECT id, user_id, refresh_token_hash, expires_at, revoked_at, created_at, updated_at
+		FROM auth_sessions
+		WHERE id = $1
+	`
+
+	err := database.Conn(ctx, r.db).GetContext(ctx, &session, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding auth session by ID: %w", err)
+	}
+
+	return &session, nil
+}
+
+func (r *PostgresAuthSessionRepository) RotateRefreshToken(ctx context.Context, id uuid.UUID, currentHash, newHash string, expiresAt time.Time) (bool, error) {
+	query := `
+		UPDATE auth_sessions
+		SET refresh_token_hash = $1, expires_at = $2, updated_at = NOW()
+		WHERE id = $3 AND refresh_token_hash = $4 AND revoked_at IS NULL AND expires_at > NOW()
+	`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(ctx, query, newHash, expiresAt, id, currentHash)
+	if err != nil {
+		return false, fmt.Errorf("error rotating refresh token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (r *PostgresAuthSessionRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE auth_sessions
+		SET revoked_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("error revoking auth session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAuthSessionRepository) RevokeAllByUserID(ctx context.Context, userID int64, exceptID uuid.UUID) error {
+	query := `
+		UPDATE auth_sessions
+		SET revoked_at = NOW(), updated_at = NOW()
+		WHERE user_id = $1 AND id <> $2 AND revoked_at IS NULL
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, userID, exceptID); err != nil {
+		return fmt.Errorf("error revoking user auth sessions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"finance-assistant/internal/domain/entity"
+	domainrepo "finance-assistant/internal/domain/repository"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const categorySelect = `
+		SELECT
+			c.id, c.external_id, COALESCE(c.user_id, 0) AS user_id, COALESCE(c.parent_id, 0) AS parent_id,
+			COALESCE(p.external_id, '00000000-0000-0000-0000-000000000000') AS parent_external_id,
+			c.name, c.slug, c.icon, c.color, c.created_at, c.updated_at
+		FROM categories c
+		LEFT JOIN categories p ON p.id = c.parent_id
+`
+
+type PostgresCategoryRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresCategoryRepository(db *sqlx.DB) *PostgresCategoryRepository {
+	return &PostgresCategoryRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresCategoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	query := `
+		INSERT INTO categories (
+			external_id, user_id, parent_id, name, slug, icon, color, created_at, updated_at
+		)
+		VALUES ($1, NULLIF($2, 0), NULLIF($3, 0), $4, $5, $6, $7, $8, $9)
+		RETURNING id
+	`
+
+	err := database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		category.ExternalID,
+		category.UserID,
+		category.ParentID,
+		category.Name,
+		category.Slug,
+		category.Icon,
+		category.Color,
+		category.CreatedAt,
+		category.UpdatedAt,
+	).Scan(&category.ID)
+
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == "idx_categories_owner_slug" {
+			return domainrepo.ErrDuplicateCategory
+		}
+		return fmt.Errorf("error creating category: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresCategoryRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Category, error) {
+	var category entity.Category
+
+	query := categorySelect + `
+		WHERE c.external_id = $1
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &category, query, externalID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding category by external ID: %w", err)
+	}
+
+	return &category, nil
+}
+
+func (r *PostgresCategoryRepository) FindByKey(ctx context.Context, userID int64, key string) (*entity.Category, error) {
+	var category entity.Category
+
+	query := categorySelect + `
+		WHERE c.slug = $2 AND (c.user_id = $1 OR c.user_id IS NULL)
+		ORDER BY c.user_id NULLS LAST
+		LIMIT 1
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &category, query, userID, key); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding category by key: %w", err)
+	}
+
+	return &category, nil
+}
+
+func (r *PostgresCategoryRepository) FindAvailable(ctx context.Context, userID int64) ([]*entity.Category, error) {
+	var categories []*entity.Category
+
+	query := categorySelect + `
+		WHERE c.user_id = $1 OR c.user_id IS NULL
+		ORDER BY c.parent_id NULLS FIRST, c.name, c.id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &categories, query, userID); err != nil {
+		return nil, fmt.Errorf("error finding available categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (r *PostgresCategoryRepository) FindSystem(ctx context.Context) ([]*entity.Category, error) {
+	var categories []*entity.Category
+
+	query := categorySelect + `
+		WHERE c.user_id IS NULL
+		ORDER BY c.parent_id NULLS FIRST, c.name, c.id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &categories, query); err != nil {
+		return nil, fmt.Errorf("error finding system categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (r *PostgresCategoryRepository) CountChildren(ctx context.Context, id int64) (int, error) {
+	query := `SELECT COUNT(*) FROM categories WHERE parent_id = $1`
+
+	var count int
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &count, query, id); err != nil {
+		return 0, fmt.Errorf("error counting child categories: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *PostgresCategoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	query := `
+		UPDATE categories
+		SET parent_id = NULLIF($1, 0), name = $2, slug = $3, icon = $4, color = $5, updated_at = $6
+		WHERE id = $7
+	`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		category.ParentID,
+		category.Name,
+		category.Slug,
+		category.Icon,
+		category.Color,
+		category.UpdatedAt,
+		category.ID,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == "idx_categories_owner_slug" {
+			return domainrepo.ErrDuplicateCategory
+		}
+		return fmt.Errorf("error updating category: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no category found with ID: %d", category.ID)
+	}
+
+	return nil
+}
+
+// Delete exclui a categoria; categorias com subcategorias ou usadas por regras
+// de categorização retornam ErrCategoryInUse
+func (r *PostgresCategoryRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM categories WHERE id = $1`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+			return domainrepo.ErrCategoryInUse
+		}
+		return fmt.Errorf("error deleting category: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no category found with ID: %d", id)
+	}
+
+	return nil
+}
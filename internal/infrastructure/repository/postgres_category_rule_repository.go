@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const categoryRuleSelect = `
+		SELECT
+			r.id, r.external_id, r.user_id, r.name, r.category_id,
+			c.external_id AS category_external_id, c.name AS category, r.priority, r.enabled,
+			r.description_contains, r.description_regex, r.counterparty, r.source_account,
+			r.min_amount, r.max_amount, r.day_of_month, r.created_at, r.updated_at
+		FROM category_rules r
+		JOIN categories c ON c.id = r.category_id
+`
+
+type PostgresCategoryRuleRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresCategoryRuleRepository(db *sqlx.DB) *PostgresCategoryRuleRepository {
+	return &PostgresCategoryRuleRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresCategoryRuleRepository) Create(ctx context.Context, rule *entity.CategoryRule) error {
+	query := `
+		INSERT INTO category_rules (
+			external_id, user_id, name, category_id, priority, enabled,
+			description_contains, description_regex, counterparty, source_account,
+			min_amount, max_amount, day_of_month, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING id
+	`
+
+	err := database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		rule.ExternalID,
+		rule.UserID,
+		rule.Name,
+		rule.CategoryID,
+		rule.Priority,
+		rule.Enabled,
+		rule.DescriptionContains,
+		rule.DescriptionRegex,
+		rule.Counterparty,
+		rule.SourceAccount,
+		rule.MinAmount,
+		rule.MaxAmount,
+		rule.DayOfMonth,
+		rule.CreatedAt,
+		rule.UpdatedAt,
+	).Scan(&rule.ID)
+
+	if err != nil {
+		return fmt.Errorf("error creating category rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresCategoryRuleRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.CategoryRule, error) {
+	var rule entity.CategoryRule
+
+	query := categoryRuleSelect + `
+		WHERE r.external_id = $1
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &rule, query, externalID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding category rule by external ID: %w", err)
+	}
+
+	return &rule, nil
+}
+
+func (r *PostgresCategoryRuleRepository) FindByUserID(ctx context.Context, userID int64) ([]*entity.CategoryRule, error) {
+	var rules []*entity.CategoryRule
+
+	query := categoryRuleSelect + `
+		WHERE r.user_id = $1
+		ORDER BY r.priority, r.id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &rules, query, userID); err != nil {
+		return nil, fmt.Errorf("error finding category rules by user ID: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *PostgresCategoryRuleRepository) Update(ctx context.Context, rule *entity.CategoryRule) error {
+	query := `
+		UPDATE category_rules
+		SET name = $1, category_id = $2, priority = $3, enabled = $4,
+			description_contains = $5, description_regex = $6, counterparty = $7, source_account = $8,
+			min_amount = $9, max_amount = $10, day_of_month = $11, updated_at = $12
+		WHERE id = $13
+	`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		rule.Name,
+		rule.CategoryID,
+		rule.Priority,
+		rule.Enabled,
+		rule.DescriptionContains,
+		rule.DescriptionRegex,
+		rule.Counterparty,
+		rule.SourceAccount,
+		rule.MinAmount,
+		rule.MaxAmount,
+		rule.DayOfMonth,
+		rule.UpdatedAt,
+		rule.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating category rule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no category rule found with ID: %d", rule.ID)
+	}
+
+	return nil
+}
+
+func (r *PostgresCategoryRuleRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM category_rules WHERE id = $1`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("error deleting category rule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no category rule found with ID: %d", id)
+	}
+
+	return nil
+}
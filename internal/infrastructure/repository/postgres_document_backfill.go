@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// LegacyDocumentContent é um documento cujo conteúdo ainda está em base64 na coluna file_content
+type LegacyDocumentContent struct {
+	ID          int64     `db:"id"`
+	ExternalID  uuid.UUID `db:"external_id"`
+	UserID      int64     `db:"user_id"`
+	ContentType string    `db:"content_type"`
+	FileContent string    `db:"file_content"`
+}
+
+// FindLegacyContent retorna documentos ainda não migrados para o BlobStore
+func (r *PostgresDocumentRepository) FindLegacyContent(ctx context.Context, limit int) ([]LegacyDocumentContent, error) {
+	query := `
+		SELECT id, external_id, user_id, content_type, file_content
+		FROM documents
+		WHERE storage_key IS NULL AND file_content IS NOT NULL
+		ORDER BY id
+		LIMIT $1
+	`
+
+	var documents []LegacyDocumentContent
+	if err := r.db.SelectContext(ctx, &documents, query, limit); err != nil {
+		return nil, fmt.Errorf("error finding legacy document content: %w", err)
+	}
+
+	return documents, nil
+}
+
+// CompleteBlobMigration grava a referência do BlobStore e descarta o conteúdo em base64
+func (r *PostgresDocumentRepository) CompleteBlobMigration(ctx context.Context, id int64, storageKey string, size int64, sha256 string) error {
+	query := `
+		UPDATE documents d
+		SET storage_key = $1, size_bytes = $2, sha256 = $3, file_content = NULL,
+			-- Conteúdo repetido de documentos antigos é mantido como duplicata permitida
+			duplicate_allowed = EXISTS (
+				SELECT 1 FROM documents o
+				WHERE o.user_id = d.user_id AND o.sha256 = $3 AND o.id <> d.id AND NOT o.duplicate_allowed
+			)
+		WHERE d.id = $4 AND d.storage_key IS NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, storageKey, size, sha256, id); err != nil {
+		return fmt.Errorf("error completing blob migration: %w", err)
+	}
+
+	return nil
+}
@@ -8,10 +8,102 @@ import (
 	"fmt"
 
 	"finance-assistant/internal/domain/entity"
+	domainrepo "finance-assistant/internal/domain/repository"
+	"finance-assistant/internal/infrastructure/database"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
+// uniqueViolation é o código de erro do PostgreSQL para violação de índice único
+const uniqueViolation = "23505"
+
+// foreignKeyViolation é o código de erro do PostgreSQL para violação de chave estrangeira
+const foreignKeyViolation = "23503"
+
+const documentSelect = `
+		SELECT
+			id, external_id, user_id, document_type, filename, content_type,
+			COALESCE(storage_key, '') AS storage_key, COALESCE(size_bytes, 0) AS size_bytes,
+			COALESCE(sha256, '') AS sha256, duplicate_allowed, status,
+			COALESCE((
+				SELECT json_agg(json_build_object(
+					'id', c.id, 'external_id', c.external_id, 'user_id', COALESCE(c.user_id, 0),
+					'parent_id', COALESCE(c.parent_id, 0), 'name', c.name, 'slug', c.slug,
+					'icon', c.icon, 'color', c.color
+				) ORDER BY c.name)
+				FROM document_categories dc
+				JOIN categories c ON c.id = dc.category_id
+				WHERE dc.document_id = documents.id
+			), '[]') AS categories,
+			COALESCE(import_profile_id, 0) AS import_profile_id,
+			COALESCE(household_id, 0) AS household_id,
+			(SELECT h.external_id FROM households h WHERE h.id = documents.household_id) AS household_external_id,
+			COALESCE(account_id, 0) AS account_id,
+			(SELECT a.external_id FROM accounts a WHERE a.id = documents.account_id) AS account_external_id,
+			created_at, updated_at
+		FROM documents
+`
+
+// accessibleByUser restringe a consulta aos documentos do usuário ($1) e aos das
+// famílias de que ele participa
+const accessibleByUser = `
+		user_id = $1 OR household_id IN (SELECT household_id FROM household_members WHERE user_id = $1)
+`
+
+// documentRow representa a linha do banco; categories é o JSON das categorias associadas
+type documentRow struct {
+	ID                  int64                 `db:"id"`
+	ExternalID          uuid.UUID             `db:"external_id"`
+	UserID              int64                 `db:"user_id"`
+	DocumentType        string                `db:"document_type"`
+	Filename            string                `db:"filename"`
+	ContentType         string                `db:"content_type"`
+	StorageKey          string                `db:"storage_key"`
+	Size                int64                 `db:"size_bytes"`
+	SHA256              string                `db:"sha256"`
+	DuplicateAllowed    bool                  `db:"duplicate_allowed"`
+	Categories          []byte                `db:"categories"`
+	Status              entity.DocumentStatus `db:"status"`
+	ImportProfileID     int64                 `db:"import_profile_id"`
+	HouseholdID         int64                 `db:"household_id"`
+	HouseholdExternalID uuid.NullUUID         `db:"household_external_id"`
+	AccountID           int64                 `db:"account_id"`
+	AccountExternalID   uuid.NullUUID         `db:"account_external_id"`
+	CreatedAt           sql.NullTime          `db:"created_at"`
+	UpdatedAt           sql.NullTime          `db:"updated_at"`
+}
+
+func (row *documentRow) toEntity() (*entity.Document, error) {
+	// Converter categories de JSON para []*entity.Category
+	var categories []*entity.Category
+	if err := json.Unmarshal(row.Categories, &categories); err != nil {
+		return nil, fmt.Errorf("error unmarshaling categories: %w", err)
+	}
+
+	return &entity.Document{
+		ID:                  row.ID,
+		ExternalID:          row.ExternalID,
+		UserID:              row.UserID,
+		DocumentType:        row.DocumentType,
+		Filename:            row.Filename,
+		ContentType:         row.ContentType,
+		StorageKey:          row.StorageKey,
+		Size:                row.Size,
+		SHA256:              row.SHA256,
+		DuplicateAllowed:    row.DuplicateAllowed,
+		Categories:          categories,
+		Status:              row.Status,
+		ImportProfileID:     row.ImportProfileID,
+		HouseholdID:         row.HouseholdID,
+		HouseholdExternalID: row.HouseholdExternalID.UUID,
+		AccountID:           row.AccountID,
+		AccountExternalID:   row.AccountExternalID.UUID,
+		CreatedAt:           row.CreatedAt.Time,
+		UpdatedAt:           row.UpdatedAt.Time,
+	}, nil
+}
+
 type PostgresDocumentRepository struct {
 	db *sqlx.DB
 }
@@ -25,20 +117,15 @@ func NewPostgresDocumentRepository(db *sqlx.DB) *PostgresDocumentRepository {
 func (r *PostgresDocumentRepository) Create(ctx context.Context, document *entity.Document) error {
 	query := `
 		INSERT INTO documents (
-			external_id, user_id, document_type, filename, content_type, 
-			file_content, categories, status, created_at, updated_at
+			external_id, user_id, document_type, filename, content_type,
+			storage_key, size_bytes, sha256, duplicate_allowed, status, import_profile_id,
+			household_id, account_id, created_at, updated_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, 0), NULLIF($12, 0), NULLIF($13, 0), $14, $15)
 		RETURNING id
 	`
 
-	// Converter categories para JSON
-	categoriesJSON, err := json.Marshal(document.Categories)
-	if err != nil {
-		return fmt.Errorf("error marshaling categories: %w", err)
-	}
-
-	err = r.db.QueryRowContext(
+	err := database.Conn(ctx, r.db).QueryRowxContext(
 		ctx,
 		query,
 		document.ExternalID,
@@ -46,232 +133,149 @@ func (r *PostgresDocumentRepository) Create(ctx context.Context, document *entit
 		document.DocumentType,
 		document.Filename,
 		document.ContentType,
-		document.FileContent,
-		categoriesJSON,
+		document.StorageKey,
+		document.Size,
+		document.SHA256,
+		document.DuplicateAllowed,
 		document.Status,
+		document.ImportProfileID,
+		document.HouseholdID,
+		document.AccountID,
 		document.CreatedAt,
 		document.UpdatedAt,
 	).Scan(&document.ID)
 
 	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == "idx_documents_user_sha256_unique" {
+			return domainrepo.ErrDuplicateDocumentHash
+		}
 		return fmt.Errorf("error creating document: %w", err)
 	}
 
-	return nil
+	return r.insertCategories(ctx, document)
 }
 
-func (r *PostgresDocumentRepository) FindByID(ctx context.Context, id int64) (*entity.Document, error) {
+// insertCategories associa ao documento as suas categorias
+func (r *PostgresDocumentRepository) insertCategories(ctx context.Context, document *entity.Document) error {
+	if len(document.Categories) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(document.Categories))
+	for i, category := range document.Categories {
+		ids[i] = category.ID
+	}
+
 	query := `
-		SELECT
-			id, external_id, user_id, document_type, filename, content_type, 
-			file_content, categories, status, created_at, updated_at
-		FROM documents
-		WHERE id = $1
+		INSERT INTO document_categories (document_id, category_id)
+		SELECT $1, UNNEST($2::BIGINT[])
+		ON CONFLICT DO NOTHING
 	`
 
-	var documentDB struct {
-		ID           int64                 `db:"id"`
-		ExternalID   uuid.UUID             `db:"external_id"`
-		UserID       int64                 `db:"user_id"`
-		DocumentType string                `db:"document_type"`
-		Filename     string                `db:"filename"`
-		ContentType  string                `db:"content_type"`
-		FileContent  string                `db:"file_content"`
-		Categories   []byte                `db:"categories"`
-		Status       entity.DocumentStatus `db:"status"`
-		CreatedAt    sql.NullTime          `db:"created_at"`
-		UpdatedAt    sql.NullTime          `db:"updated_at"`
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, document.ID, pq.Array(ids)); err != nil {
+		return fmt.Errorf("error creating document categories: %w", err)
 	}
 
-	err := r.db.GetContext(ctx, &documentDB, query, id)
-	if err != nil {
+	return nil
+}
+
+func (r *PostgresDocumentRepository) FindByID(ctx context.Context, id int64) (*entity.Document, error) {
+	var row documentRow
+
+	query := documentSelect + `
+		WHERE id = $1
+	`
+
+	if err := r.db.GetContext(ctx, &row, query, id); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("error finding document by ID: %w", err)
 	}
 
-	// Converter categories de JSON para []string
-	var categories []string
-	if err := json.Unmarshal(documentDB.Categories, &categories); err != nil {
-		return nil, fmt.Errorf("error unmarshaling categories: %w", err)
-	}
-
-	// Converter para entity.Document
-	document := &entity.Document{
-		ID:           documentDB.ID,
-		ExternalID:   documentDB.ExternalID,
-		UserID:       documentDB.UserID,
-		DocumentType: documentDB.DocumentType,
-		Filename:     documentDB.Filename,
-		ContentType:  documentDB.ContentType,
-		FileContent:  documentDB.FileContent,
-		Categories:   categories,
-		Status:       documentDB.Status,
-		CreatedAt:    documentDB.CreatedAt.Time,
-		UpdatedAt:    documentDB.UpdatedAt.Time,
-	}
-
-	return document, nil
+	return row.toEntity()
 }
 
 func (r *PostgresDocumentRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Document, error) {
-	query := `
-		SELECT
-			id, external_id, user_id, document_type, filename, content_type, 
-			file_content, categories, status, created_at, updated_at
-		FROM documents
+	var row documentRow
+
+	query := documentSelect + `
 		WHERE external_id = $1
 	`
 
-	var documentDB struct {
-		ID           int64                 `db:"id"`
-		ExternalID   uuid.UUID             `db:"external_id"`
-		UserID       int64                 `db:"user_id"`
-		DocumentType string                `db:"document_type"`
-		Filename     string                `db:"filename"`
-		ContentType  string                `db:"content_type"`
-		FileContent  string                `db:"file_content"`
-		Categories   []byte                `db:"categories"`
-		Status       entity.DocumentStatus `db:"status"`
-		CreatedAt    sql.NullTime          `db:"created_at"`
-		UpdatedAt    sql.NullTime          `db:"updated_at"`
-	}
-
-	err := r.db.GetContext(ctx, &documentDB, query, externalID)
-	if err != nil {
+	if err := r.db.GetContext(ctx, &row, query, externalID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("error finding document by external ID: %w", err)
 	}
 
-	// Converter categories de JSON para []string
-	var categories []string
-	if err := json.Unmarshal(documentDB.Categories, &categories); err != nil {
-		return nil, fmt.Errorf("error unmarshaling categories: %w", err)
-	}
-
-	// Converter para entity.Document
-	document := &entity.Document{
-		ID:           documentDB.ID,
-		ExternalID:   documentDB.ExternalID,
-		UserID:       documentDB.UserID,
-		DocumentType: documentDB.DocumentType,
-		Filename:     documentDB.Filename,
-		ContentType:  documentDB.ContentType,
-		FileContent:  documentDB.FileContent,
-		Categories:   categories,
-		Status:       documentDB.Status,
-		CreatedAt:    documentDB.CreatedAt.Time,
-		UpdatedAt:    documentDB.UpdatedAt.Time,
-	}
-
-	return document, nil
+	return row.toEntity()
 }
 
 func (r *PostgresDocumentRepository) FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entity.Document, error) {
-	query := `
-		SELECT
-			id, external_id, user_id, document_type, filename, content_type, 
-			file_content, categories, status, created_at, updated_at
-		FROM documents
+	var rows []documentRow
+
+	query := documentSelect + `
 		WHERE user_id = $1
 		ORDER BY created_at DESC
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
-	if err != nil {
+	if err := r.db.SelectContext(ctx, &rows, query, userID, limit, offset); err != nil {
 		return nil, fmt.Errorf("error finding documents by user ID: %w", err)
 	}
-	defer rows.Close()
-
-	var documents []*entity.Document
-	for rows.Next() {
-		var documentDB struct {
-			ID           int64                 `db:"id"`
-			ExternalID   uuid.UUID             `db:"external_id"`
-			UserID       int64                 `db:"user_id"`
-			DocumentType string                `db:"document_type"`
-			Filename     string                `db:"filename"`
-			ContentType  string                `db:"content_type"`
-			FileContent  string                `db:"file_content"`
-			Categories   []byte                `db:"categories"`
-			Status       entity.DocumentStatus `db:"status"`
-			CreatedAt    sql.NullTime          `db:"created_at"`
-			UpdatedAt    sql.NullTime          `db:"updated_at"`
-		}
 
-		err := rows.Scan(
-			&documentDB.ID,
-			&documentDB.ExternalID,
-			&documentDB.UserID,
-			&documentDB.DocumentType,
-			&documentDB.Filename,
-			&documentDB.ContentType,
-			&documentDB.FileContent,
-			&documentDB.Categories,
-			&documentDB.Status,
-			&documentDB.CreatedAt,
-			&documentDB.UpdatedAt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("error scanning document row: %w", err)
-		}
+	return toDocuments(rows)
+}
 
-		// Converter categories de JSON para []string
-		var categories []string
-		if err := json.Unmarshal(documentDB.Categories, &categories); err != nil {
-			return nil, fmt.Errorf("error unmarshaling categories: %w", err)
-		}
+func (r *PostgresDocumentRepository) FindAccessibleByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entity.Document, error) {
+	var rows []documentRow
 
-		document := &entity.Document{
-			ID:           documentDB.ID,
-			ExternalID:   documentDB.ExternalID,
-			UserID:       documentDB.UserID,
-			DocumentType: documentDB.DocumentType,
-			Filename:     documentDB.Filename,
-			ContentType:  documentDB.ContentType,
-			FileContent:  documentDB.FileContent,
-			Categories:   categories,
-			Status:       documentDB.Status,
-			CreatedAt:    documentDB.CreatedAt.Time,
-			UpdatedAt:    documentDB.UpdatedAt.Time,
-		}
+	query := documentSelect + `
+		WHERE ` + accessibleByUser + `
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
 
-		documents = append(documents, document)
+	if err := r.db.SelectContext(ctx, &rows, query, userID, limit, offset); err != nil {
+		return nil, fmt.Errorf("error finding accessible documents by user ID: %w", err)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating document rows: %w", err)
+	return toDocuments(rows)
+}
+
+// FindByUserIDAndSHA256 busca os documentos do usuário com o mesmo hash de conteúdo, do mais antigo ao mais recente
+func (r *PostgresDocumentRepository) FindByUserIDAndSHA256(ctx context.Context, userID int64, sha256 string) ([]*entity.Document, error) {
+	var rows []documentRow
+
+	query := documentSelect + `
+		WHERE user_id = $1 AND sha256 = $2
+		ORDER BY id
+	`
+
+	if err := r.db.SelectContext(ctx, &rows, query, userID, sha256); err != nil {
+		return nil, fmt.Errorf("error finding documents by hash: %w", err)
 	}
 
-	return documents, nil
+	return toDocuments(rows)
 }
 
 func (r *PostgresDocumentRepository) Update(ctx context.Context, document *entity.Document) error {
 	query := `
 		UPDATE documents
-		SET document_type = $1, filename = $2, content_type = $3, 
-			file_content = $4, categories = $5, status = $6, updated_at = $7
-		WHERE id = $8
+		SET document_type = $1, filename = $2, content_type = $3,
+			status = $4, updated_at = $5
+		WHERE id = $6
 	`
 
-	// Converter categories para JSON
-	categoriesJSON, err := json.Marshal(document.Categories)
-	if err != nil {
-		return fmt.Errorf("error marshaling categories: %w", err)
-	}
-
-	result, err := r.db.ExecContext(
+	result, err := database.Conn(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		document.DocumentType,
 		document.Filename,
 		document.ContentType,
-		document.FileContent,
-		categoriesJSON,
 		document.Status,
 		document.UpdatedAt,
 		document.ID,
@@ -289,7 +293,13 @@ func (r *PostgresDocumentRepository) Update(ctx context.Context, document *entit
 		return fmt.Errorf("no document found with ID: %d", document.ID)
 	}
 
-	return nil
+	// Substituir as categorias associadas
+	query = `DELETE FROM document_categories WHERE document_id = $1`
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, document.ID); err != nil {
+		return fmt.Errorf("error deleting document categories: %w", err)
+	}
+
+	return r.insertCategories(ctx, document)
 }
 
 func (r *PostgresDocumentRepository) UpdateStatus(ctx context.Context, id int64, status entity.DocumentStatus) error {
@@ -316,8 +326,26 @@ func (r *PostgresDocumentRepository) UpdateStatus(ctx context.Context, id int64,
 	return nil
 }
 
+func (r *PostgresDocumentRepository) UpdateAccount(ctx context.Context, id, accountID int64) error {
+	query := `
+		UPDATE documents
+		SET account_id = NULLIF($1, 0), updated_at = NOW()
+		WHERE id = $2
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, accountID, id); err != nil {
+		return fmt.Errorf("error updating document account: %w", err)
+	}
+
+	return nil
+}
+
+// Delete exclui o documento e as transações importadas dele, exceto as também
+// informadas por outros documentos, que passam para o mais antigo deles
 func (r *PostgresDocumentRepository) Delete(ctx context.Context, id int64) error {
-	query := `DELETE FROM documents WHERE id = $1`
+	query := moveSharedTransactions + `
+		DELETE FROM documents WHERE id = $1
+	`
 
 	result, err := r.db.ExecContext(ctx, query, id)
 	if err != nil {
@@ -337,91 +365,50 @@ func (r *PostgresDocumentRepository) Delete(ctx context.Context, id int64) error
 }
 
 func (r *PostgresDocumentRepository) List(ctx context.Context, limit, offset int) ([]*entity.Document, error) {
-	query := `
-		SELECT
-			id, external_id, user_id, document_type, filename, content_type, 
-			file_content, categories, status, created_at, updated_at
-		FROM documents
+	var rows []documentRow
+
+	query := documentSelect + `
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
-	if err != nil {
+	if err := r.db.SelectContext(ctx, &rows, query, limit, offset); err != nil {
 		return nil, fmt.Errorf("error listing documents: %w", err)
 	}
-	defer rows.Close()
-
-	var documents []*entity.Document
-	for rows.Next() {
-		var documentDB struct {
-			ID           int64                 `db:"id"`
-			ExternalID   uuid.UUID             `db:"external_id"`
-			UserID       int64                 `db:"user_id"`
-			DocumentType string                `db:"document_type"`
-			Filename     string                `db:"filename"`
-			ContentType  string                `db:"content_type"`
-			FileContent  string                `db:"file_content"`
-			Categories   []byte                `db:"categories"`
-			Status       entity.DocumentStatus `db:"status"`
-			CreatedAt    sql.NullTime          `db:"created_at"`
-			UpdatedAt    sql.NullTime          `db:"updated_at"`
-		}
-
-		err := rows.Scan(
-			&documentDB.ID,
-			&documentDB.ExternalID,
-			&documentDB.UserID,
-			&documentDB.DocumentType,
-			&documentDB.Filename,
-			&documentDB.ContentType,
-			&documentDB.FileContent,
-			&documentDB.Categories,
-			&documentDB.Status,
-			&documentDB.CreatedAt,
-			&documentDB.UpdatedAt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("error scanning document row: %w", err)
-		}
 
-		// Converter categories de JSON para []string
-		var categories []string
-		if err := json.Unmarshal(documentDB.Categories, &categories); err != nil {
-			return nil, fmt.Errorf("error unmarshaling categories: %w", err)
-		}
-
-		document := &entity.Document{
-			ID:           documentDB.ID,
-			ExternalID:   documentDB.ExternalID,
-			UserID:       documentDB.UserID,
-			DocumentType: documentDB.DocumentType,
-			Filename:     documentDB.Filename,
-			ContentType:  documentDB.ContentType,
-			FileContent:  documentDB.FileContent,
-			Categories:   categories,
-			Status:       documentDB.Status,
-			CreatedAt:    documentDB.CreatedAt.Time,
-			UpdatedAt:    documentDB.UpdatedAt.Time,
-		}
+	return toDocuments(rows)
+}
 
-		documents = append(documents, document)
-	}
+func (r *PostgresDocumentRepository) CountByUserID(ctx context.Context, userID int64) (int, error) {
+	query := `SELECT COUNT(*) FROM documents WHERE user_id = $1`
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating document rows: %w", err)
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("error counting documents by user ID: %w", err)
 	}
 
-	return documents, nil
+	return count, nil
 }
 
-func (r *PostgresDocumentRepository) CountByUserID(ctx context.Context, userID int64) (int, error) {
-	query := `SELECT COUNT(*) FROM documents WHERE user_id = $1`
+func (r *PostgresDocumentRepository) CountAccessibleByUserID(ctx context.Context, userID int64) (int, error) {
+	query := `SELECT COUNT(*) FROM documents WHERE ` + accessibleByUser
 
 	var count int
 	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
-		return 0, fmt.Errorf("error counting documents by user ID: %w", err)
+		return 0, fmt.Errorf("error counting accessible documents by user ID: %w", err)
 	}
 
 	return count, nil
 }
+
+func toDocuments(rows []documentRow) ([]*entity.Document, error) {
+	documents := make([]*entity.Document, 0, len(rows))
+	for i := range rows {
+		document, err := rows[i].toEntity()
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, document)
+	}
+	return documents, nil
+}
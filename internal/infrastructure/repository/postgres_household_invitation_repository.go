@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"finance-assistant/internal/domain/entity"
+	domainrepo "finance-assistant/internal/domain/repository"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// householdInvitationSelect seleciona o convite junto com o ID externo e o nome da família
+const householdInvitationSelect = `
+		SELECT
+			i.id, i.external_id, i.household_id, h.external_id AS household_external_id,
+			h.name AS household_name, i.email, i.role, COALESCE(i.invited_by, 0) AS invited_by,
+			i.status, i.expires_at, i.responded_at, i.created_at
+		FROM household_invitations i
+		JOIN households h ON h.id = i.household_id
+`
+
+type PostgresHouseholdInvitationRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresHouseholdInvitationRepository(db *sqlx.DB) *PostgresHouseholdInvitationRepository {
+	return &PostgresHouseholdInvitationRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresHouseholdInvitationRepository) Create(ctx context.Context, invitation *entity.HouseholdInvitation) error {
+	query := `
+		INSERT INTO household_invitations (
+			external_id, household_id, email, role, invited_by, status, expires_at, created_at
+		)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), $6, $7, $8)
+		RETURNING id
+	`
+
+	err := database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		invitation.ExternalID,
+		invitation.HouseholdID,
+		invitation.Email,
+		invitation.Role,
+		invitation.InvitedByID,
+		invitation.Status,
+		invitation.ExpiresAt,
+		invitation.CreatedAt,
+	).Scan(&invitation.ID)
+
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == "idx_household_invitations_pending" {
+			return domainrepo.ErrDuplicateHouseholdInvitation
+		}
+		return fmt.Errorf("error creating household invitation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresHouseholdInvitationRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.HouseholdInvitation, error) {
+	var invitation entity.HouseholdInvitation
+
+	query := householdInvitationSelect + `
+		WHERE i.external_id = $1
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &invitation, query, externalID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding household invitation by external ID: %w", err)
+	}
+
+	return &invitation, nil
+}
+
+func (r *PostgresHouseholdInvitationRepository) FindPendingByHouseholdID(ctx context.Context, householdID int64) ([]*entity.HouseholdInvitation, error) {
+	var invitations []*entity.HouseholdInvitation
+
+	query := householdInvitationSelect + `
+		WHERE i.household_id = $1 AND i.status = 'pending' AND i.expires_at > NOW()
+		ORDER BY i.created_at DESC
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &invitations, query, householdID); err != nil {
+		return nil, fmt.Errorf("error finding household invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+func (r *PostgresHouseholdInvitationRepository) FindPendingByEmail(ctx context.Context, email string) ([]*entity.HouseholdInvitation, error) {
+	var invitations []*entity.HouseholdInvitation
+
+	query := householdInvitationSelect + `
+		WHERE LOWER(i.email) = LOWER($1) AND i.status = 'pending' AND i.expires_at > NOW()
+		ORDER BY i.created_at DESC
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &invitations, query, email); err != nil {
+		return nil, fmt.Errorf("error finding household invitations by email: %w", err)
+	}
+
+	return invitations, nil
+}
+
+// UpdateStatus grava a resposta ao convite; apenas convites ainda pendentes são
+// alterados, de forma que respostas simultâneas não sejam aplicadas duas vezes
+func (r *PostgresHouseholdInvitationRepository) UpdateStatus(ctx context.Context, invitation *entity.HouseholdInvitation) error {
+	query := `
+		UPDATE household_invitations
+		SET status = $1, responded_at = $2
+		WHERE id = $3 AND status = 'pending'
+	`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(ctx, query, invitation.Status, invitation.RespondedAt, invitation.ID)
+	if err != nil {
+		return fmt.Errorf("error updating household invitation status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return entity.ErrHouseholdInvitationClosed
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const householdMemberSelect = `
+		SELECT
+			m.household_id, m.user_id, u.external_id AS user_external_id,
+			u.name AS user_name, u.email AS user_email, m.role, m.created_at
+		FROM household_members m
+		JOIN users u ON u.id = m.user_id
+`
+
+type PostgresHouseholdRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresHouseholdRepository(db *sqlx.DB) *PostgresHouseholdRepository {
+	return &PostgresHouseholdRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresHouseholdRepository) Create(ctx context.Context, household *entity.Household) error {
+	query := `
+		INSERT INTO households (external_id, name, created_at, updated_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	err := database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		household.ExternalID,
+		household.Name,
+		household.CreatedAt,
+		household.UpdatedAt,
+	).Scan(&household.ID)
+
+	if err != nil {
+		return fmt.Errorf("error creating household: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresHouseholdRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Household, error) {
+	var household entity.Household
+
+	query := `
+		SELECT id, external_id, name, created_at, updated_at
+		FROM households
+		WHERE external_id = $1
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &household, query, externalID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding household by external ID: %w", err)
+	}
+
+	return &household, nil
+}
+
+func (r *PostgresHouseholdRepository) FindByUserID(ctx context.Context, userID int64) ([]*entity.Household, error) {
+	var households []*entity.Household
+
+	query := `
+		SELECT h.id, h.external_id, h.name, h.created_at, h.updated_at
+		FROM households h
+		JOIN household_members m ON m.household_id = h.id
+		WHERE m.user_id = $1
+		ORDER BY h.name, h.id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &households, query, userID); err != nil {
+		return nil, fmt.Errorf("error finding households by user ID: %w", err)
+	}
+
+	return households, nil
+}
+
+func (r *PostgresHouseholdRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM households WHERE id = $1`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("error deleting household: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no household found with ID: %d", id)
+	}
+
+	return nil
+}
+
+func (r *PostgresHouseholdRepository) AddMember(ctx context.Context, member *entity.HouseholdMember) error {
+	query := `
+		INSERT INTO household_members (household_id, user_id, role, created_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, member.HouseholdID, member.UserID, member.Role, member.CreatedAt); err != nil {
+		return fmt.Errorf("error adding household member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresHouseholdRepository) FindMember(ctx context.Context, householdID, userID int64) (*entity.HouseholdMember, error) {
+	var member entity.HouseholdMember
+
+	query := householdMemberSelect + `
+		WHERE m.household_id = $1 AND m.user_id = $2
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &member, query, householdID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding household member: %w", err)
+	}
+
+	return &member, nil
+}
+
+func (r *PostgresHouseholdRepository) FindMembers(ctx context.Context, householdID int64) ([]*entity.HouseholdMember, error) {
+	var members []*entity.HouseholdMember
+
+	query := householdMemberSelect + `
+		WHERE m.household_id = $1
+		ORDER BY m.created_at, m.user_id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &members, query, householdID); err != nil {
+		return nil, fmt.Errorf("error finding household members: %w", err)
+	}
+
+	return members, nil
+}
+
+func (r *PostgresHouseholdRepository) UpdateMemberRole(ctx context.Context, householdID, userID int64, role entity.HouseholdRole) error {
+	query := `
+		UPDATE household_members
+		SET role = $1
+		WHERE household_id = $2 AND user_id = $3
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, role, householdID, userID); err != nil {
+		return fmt.Errorf("error updating household member role: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresHouseholdRepository) RemoveMember(ctx context.Context, householdID, userID int64) error {
+	query := `DELETE FROM household_members WHERE household_id = $1 AND user_id = $2`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, householdID, userID); err != nil {
+		return fmt.Errorf("error removing household member: %w", err)
+	}
+
+	return nil
+}
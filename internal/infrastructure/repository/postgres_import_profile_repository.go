@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const importProfileSelect = `
+		SELECT
+			id, external_id, user_id, name, delimiter, encoding, date_format,
+			decimal_separator, has_header, skip_rows, columns, currency, created_at, updated_at
+		FROM import_profiles
+`
+
+// importProfileRow representa a linha do banco; columns é JSONB
+type importProfileRow struct {
+	ID               int64     `db:"id"`
+	ExternalID       uuid.UUID `db:"external_id"`
+	UserID           int64     `db:"user_id"`
+	Name             string    `db:"name"`
+	Delimiter        string    `db:"delimiter"`
+	Encoding         string    `db:"encoding"`
+	DateFormat       string    `db:"date_format"`
+	DecimalSeparator string    `db:"decimal_separator"`
+	HasHeader        bool      `db:"has_header"`
+	SkipRows         int       `db:"skip_rows"`
+	Columns          []byte    `db:"columns"`
+	Currency         string    `db:"currency"`
+	CreatedAt        time.Time `db:"created_at"`
+	UpdatedAt        time.Time `db:"updated_at"`
+}
+
+func (row *importProfileRow) toEntity() (*entity.ImportProfile, error) {
+	profile := &entity.ImportProfile{
+		ID:               row.ID,
+		ExternalID:       row.ExternalID,
+		UserID:           row.UserID,
+		Name:             row.Name,
+		Delimiter:        row.Delimiter,
+		Encoding:         row.Encoding,
+		DateFormat:       row.DateFormat,
+		DecimalSeparator: row.DecimalSeparator,
+		HasHeader:        row.HasHeader,
+		SkipRows:         row.SkipRows,
+		Currency:         row.Currency,
+		CreatedAt:        row.CreatedAt,
+		UpdatedAt:        row.UpdatedAt,
+	}
+
+	if err := json.Unmarshal(row.Columns, &profile.Columns); err != nil {
+		return nil, fmt.Errorf("error unmarshaling columns: %w", err)
+	}
+
+	return profile, nil
+}
+
+type PostgresImportProfileRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresImportProfileRepository(db *sqlx.DB) *PostgresImportProfileRepository {
+	return &PostgresImportProfileRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresImportProfileRepository) Create(ctx context.Context, profile *entity.ImportProfile) error {
+	query := `
+		INSERT INTO import_profiles (
+			external_id, user_id, name, delimiter, encoding, date_format,
+			decimal_separator, has_header, skip_rows, columns, currency, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id
+	`
+
+	columnsJSON, err := json.Marshal(profile.Columns)
+	if err != nil {
+		return fmt.Errorf("error marshaling columns: %w", err)
+	}
+
+	err = r.db.QueryRowContext(
+		ctx,
+		query,
+		profile.ExternalID,
+		profile.UserID,
+		profile.Name,
+		profile.Delimiter,
+		profile.Encoding,
+		profile.DateFormat,
+		profile.DecimalSeparator,
+		profile.HasHeader,
+		profile.SkipRows,
+		columnsJSON,
+		profile.Currency,
+		profile.CreatedAt,
+		profile.UpdatedAt,
+	).Scan(&profile.ID)
+
+	if err != nil {
+		return fmt.Errorf("error creating import profile: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresImportProfileRepository) FindByID(ctx context.Context, id int64) (*entity.ImportProfile, error) {
+	var row importProfileRow
+
+	query := importProfileSelect + `
+		WHERE id = $1
+	`
+
+	if err := r.db.GetContext(ctx, &row, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding import profile by ID: %w", err)
+	}
+
+	return row.toEntity()
+}
+
+func (r *PostgresImportProfileRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.ImportProfile, error) {
+	var row importProfileRow
+
+	query := importProfileSelect + `
+		WHERE external_id = $1
+	`
+
+	if err := r.db.GetContext(ctx, &row, query, externalID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding import profile by external ID: %w", err)
+	}
+
+	return row.toEntity()
+}
+
+func (r *PostgresImportProfileRepository) FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entity.ImportProfile, error) {
+	var rows []importProfileRow
+
+	query := importProfileSelect + `
+		WHERE user_id = $1
+		ORDER BY name ASC
+		LIMIT $2 OFFSET $3
+	`
+
+	if err := r.db.SelectContext(ctx, &rows, query, userID, limit, offset); err != nil {
+		return nil, fmt.Errorf("error finding import profiles by user ID: %w", err)
+	}
+
+	profiles := make([]*entity.ImportProfile, 0, len(rows))
+	for i := range rows {
+		profile, err := rows[i].toEntity()
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
+
+func (r *PostgresImportProfileRepository) Update(ctx context.Context, profile *entity.ImportProfile) error {
+	query := `
+		UPDATE import_profiles
+		SET name = $1, delimiter = $2, encoding = $3, date_format = $4, decimal_separator = $5,
+			has_header = $6, skip_rows = $7, columns = $8, currency = $9, updated_at = $10
+		WHERE id = $11
+	`
+
+	columnsJSON, err := json.Marshal(profile.Columns)
+	if err != nil {
+		return fmt.Errorf("error marshaling columns: %w", err)
+	}
+
+	result, err := r.db.ExecContext(
+		ctx,
+		query,
+		profile.Name,
+		profile.Delimiter,
+		profile.Encoding,
+		profile.DateFormat,
+		profile.DecimalSeparator,
+		profile.HasHeader,
+		profile.SkipRows,
+		columnsJSON,
+		profile.Currency,
+		profile.UpdatedAt,
+		profile.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating import profile: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no import profile found with ID: %d", profile.ID)
+	}
+
+	return nil
+}
+
+func (r *PostgresImportProfileRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM import_profiles WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("error deleting import profile: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no import profile found with ID: %d", id)
+	}
+
+	return nil
+}
+
+func (r *PostgresImportProfileRepository) CountByUserID(ctx context.Context, userID int64) (int, error) {
+	query := `SELECT COUNT(*) FROM import_profiles WHERE user_id = $1`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("error counting import profiles by user ID: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresInstallmentPlanRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresInstallmentPlanRepository(db *sqlx.DB) *PostgresInstallmentPlanRepository {
+	return &PostgresInstallmentPlanRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresInstallmentPlanRepository) Create(ctx context.Context, plan *entity.InstallmentPlan) error {
+	query := `
+		INSERT INTO installment_plans (
+			external_id, user_id, description, description_key, source_account, currency,
+			installment_amount, installments, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id
+	`
+
+	err := database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		plan.ExternalID,
+		plan.UserID,
+		plan.Description,
+		plan.DescriptionKey,
+		plan.SourceAccount,
+		plan.Currency,
+		plan.InstallmentAmount,
+		plan.Installments,
+		plan.CreatedAt,
+	).Scan(&plan.ID)
+
+	if err != nil {
+		return fmt.Errorf("error creating installment plan: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresInstallmentPlanRepository) FindMatching(ctx context.Context, plan *entity.InstallmentPlan, number int, excludeDocumentID int64) ([]*entity.InstallmentPlan, error) {
+	var plans []*entity.InstallmentPlan
+
+	query := `
+		SELECT
+			p.id, p.external_id, p.user_id, p.description, p.description_key, p.source_account,
+			p.currency, p.installment_amount, p.installments, p.created_at
+		FROM installment_plans p
+		WHERE p.user_id = $1 AND p.source_account = $2 AND p.description_key = $3
+			AND p.currency = $4 AND p.installment_amount = $5 AND p.installments = $6
+			AND NOT EXISTS (
+				SELECT 1 FROM transactions t
+				WHERE t.installment_plan_id = p.id AND t.installment_number = $7 AND t.document_id <> $8
+			)
+		ORDER BY p.id
+	`
+
+	err := database.Conn(ctx, r.db).SelectContext(
+		ctx,
+		&plans,
+		query,
+		plan.UserID,
+		plan.SourceAccount,
+		plan.DescriptionKey,
+		plan.Currency,
+		plan.InstallmentAmount,
+		plan.Installments,
+		number,
+		excludeDocumentID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error finding matching installment plans: %w", err)
+	}
+
+	return plans, nil
+}
+
+func (r *PostgresInstallmentPlanRepository) FindAccessibleByUserID(ctx context.Context, userID int64) ([]*entity.InstallmentPlan, error) {
+	var plans []*entity.InstallmentPlan
+
+	query := `
+		SELECT
+			p.id, p.external_id, p.user_id, p.description, p.description_key, p.source_account,
+			p.currency, p.installment_amount, p.installments, p.created_at,
+			last.installment_number AS last_installment, last.transaction_date AS last_installment_date
+		FROM installment_plans p
+		JOIN LATERAL (
+			SELECT t.installment_number, t.transaction_date
+			FROM transactions t
+			WHERE t.installment_plan_id = p.id
+			ORDER BY t.installment_number DESC, t.transaction_date DESC
+			LIMIT 1
+		) last ON TRUE
+		WHERE p.user_id = $1
+			OR EXISTS (
+				SELECT 1 FROM transactions t
+				WHERE t.installment_plan_id = p.id
+					AND t.household_id IN (SELECT household_id FROM household_members WHERE user_id = $1)
+			)
+		ORDER BY p.id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &plans, query, userID); err != nil {
+		return nil, fmt.Errorf("error finding accessible installment plans by user ID: %w", err)
+	}
+
+	return plans, nil
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	domainrepo "finance-assistant/internal/domain/repository"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// merchantSelect seleciona as colunas do estabelecimento junto com os seus apelidos
+const merchantSelect = `
+		SELECT
+			m.id, m.external_id, m.user_id, m.name, m.created_at, m.updated_at,
+			ARRAY(SELECT a.alias FROM merchant_aliases a WHERE a.merchant_id = m.id ORDER BY a.alias) AS aliases
+		FROM merchants m
+`
+
+// merchantRow representa a linha do banco com os apelidos agregados
+type merchantRow struct {
+	entity.Merchant
+	Aliases pq.StringArray `db:"aliases"`
+}
+
+func (row *merchantRow) toEntity() *entity.Merchant {
+	merchant := row.Merchant
+	merchant.Aliases = []string(row.Aliases)
+	return &merchant
+}
+
+type PostgresMerchantRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresMerchantRepository(db *sqlx.DB) *PostgresMerchantRepository {
+	return &PostgresMerchantRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresMerchantRepository) Create(ctx context.Context, merchant *entity.Merchant) error {
+	query := `
+		WITH created AS (
+			INSERT INTO merchants (external_id, user_id, name, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id
+		), aliases AS (
+			INSERT INTO merchant_aliases (user_id, alias, merchant_id)
+			SELECT $2, alias, created.id FROM created, UNNEST($6::text[]) AS alias
+		)
+		SELECT id FROM created
+	`
+
+	err := database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		merchant.ExternalID,
+		merchant.UserID,
+		merchant.Name,
+		merchant.CreatedAt,
+		merchant.UpdatedAt,
+		pq.Array(merchant.Aliases),
+	).Scan(&merchant.ID)
+
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == "merchant_aliases_pkey" {
+			return domainrepo.ErrDuplicateMerchant
+		}
+		return fmt.Errorf("error creating merchant: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresMerchantRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Merchant, error) {
+	var row merchantRow
+
+	query := merchantSelect + `
+		WHERE m.external_id = $1
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &row, query, externalID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding merchant by external ID: %w", err)
+	}
+
+	return row.toEntity(), nil
+}
+
+func (r *PostgresMerchantRepository) FindByAlias(ctx context.Context, userID int64, alias string) (*entity.Merchant, error) {
+	var row merchantRow
+
+	query := merchantSelect + `
+		JOIN merchant_aliases ma ON ma.merchant_id = m.id
+		WHERE ma.user_id = $1 AND ma.alias = $2
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &row, query, userID, alias); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding merchant by alias: %w", err)
+	}
+
+	return row.toEntity(), nil
+}
+
+func (r *PostgresMerchantRepository) Merge(ctx context.Context, targetID int64, sourceIDs []int64) error {
+	conn := database.Conn(ctx, r.db)
+	sources := pq.Array(sourceIDs)
+
+	query := `UPDATE merchant_aliases SET merchant_id = $1 WHERE merchant_id = ANY($2)`
+	if _, err := conn.ExecContext(ctx, query, targetID, sources); err != nil {
+		return fmt.Errorf("error moving merchant aliases: %w", err)
+	}
+
+	query = `UPDATE transactions SET merchant_id = $1, updated_at = NOW() WHERE merchant_id = ANY($2)`
+	if _, err := conn.ExecContext(ctx, query, targetID, sources); err != nil {
+		return fmt.Errorf("error moving merchant transactions: %w", err)
+	}
+
+	query = `DELETE FROM merchants WHERE id = ANY($1)`
+	if _, err := conn.ExecContext(ctx, query, sources); err != nil {
+		return fmt.Errorf("error deleting merged merchants: %w", err)
+	}
+
+	query = `UPDATE merchants SET updated_at = NOW() WHERE id = $1`
+	if _, err := conn.ExecContext(ctx, query, targetID); err != nil {
+		return fmt.Errorf("error updating merchant: %w", err)
+	}
+
+	return nil
+}
+
+// spendingFilter restringe as transações somadas nos gastos por estabelecimento.
+// Apenas as transferências confirmadas ficam de fora: uma sugestão ainda não
+// revisada pode ser um gasto de verdade.
+const spendingFilter = `
+		FROM transactions t
+		JOIN merchants m ON m.id = t.merchant_id
+		WHERE (t.user_id = $1 OR t.household_id IN (SELECT household_id FROM household_members WHERE user_id = $1))
+			AND ($2::date IS NULL OR t.transaction_date >= $2)
+			AND ($3::date IS NULL OR t.transaction_date <= $3)
+			AND NOT EXISTS (
+				SELECT 1 FROM transfers tr
+				WHERE tr.status = 'confirmed' AND t.id IN (tr.outgoing_transaction_id, tr.incoming_transaction_id)
+			)
+`
+
+func (r *PostgresMerchantRepository) SumAccessibleSpendingByUserID(ctx context.Context, userID int64, from, to time.Time) ([]*entity.MerchantSpending, error) {
+	var spending []*entity.MerchantSpending
+
+	query := `
+		SELECT
+			m.id AS merchant_id, m.external_id AS merchant_external_id, m.name, t.currency,
+			COUNT(*) AS transactions,
+			COALESCE(SUM(-t.amount) FILTER (WHERE t.amount < 0), 0) AS spent,
+			COALESCE(SUM(t.amount) FILTER (WHERE t.amount > 0), 0) AS received
+	` + spendingFilter + `
+		GROUP BY m.id, m.external_id, m.name, t.currency
+		ORDER BY spent DESC, m.name, t.currency
+	`
+
+	err := database.Conn(ctx, r.db).SelectContext(ctx, &spending, query, userID, nullTime(from), nullTime(to))
+	if err != nil {
+		return nil, fmt.Errorf("error summing spending by merchant: %w", err)
+	}
+
+	return spending, nil
+}
+
+func (r *PostgresMerchantRepository) SumAccessibleDailySpendingByUserID(ctx context.Context, userID int64, from, to time.Time) ([]*entity.MerchantDailySpending, error) {
+	var spending []*entity.MerchantDailySpending
+
+	query := `
+		SELECT
+			m.id AS merchant_id, m.external_id AS merchant_external_id, m.name, t.currency,
+			t.transaction_date AS date,
+			COUNT(*) AS transactions,
+			COALESCE(SUM(-t.amount) FILTER (WHERE t.amount < 0), 0) AS spent,
+			COALESCE(SUM(t.amount) FILTER (WHERE t.amount > 0), 0) AS received
+	` + spendingFilter + `
+		GROUP BY m.id, m.external_id, m.name, t.currency, t.transaction_date
+		ORDER BY m.id, t.transaction_date
+	`
+
+	err := database.Conn(ctx, r.db).SelectContext(ctx, &spending, query, userID, nullTime(from), nullTime(to))
+	if err != nil {
+		return nil, fmt.Errorf("error summing daily spending by merchant: %w", err)
+	}
+
+	return spending, nil
+}
+
+// nullTime converte datas vazias para NULL
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
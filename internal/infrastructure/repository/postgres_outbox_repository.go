@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type PostgresOutboxRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresOutboxRepository(db *sqlx.DB) *PostgresOutboxRepository {
+	return &PostgresOutboxRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresOutboxRepository) Create(ctx context.Context, event *entity.OutboxEvent) error {
+	query := `
+		INSERT INTO outbox_events (
+			aggregate_type, aggregate_id, event_type, payload, next_attempt_at, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	err := database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		event.AggregateType,
+		event.AggregateID,
+		event.EventType,
+		event.Payload,
+		event.NextAttemptAt,
+		event.CreatedAt,
+	).Scan(&event.ID)
+
+	if err != nil {
+		return fmt.Errorf("error creating outbox event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepository) ClaimPending(ctx context.Context, limit int) ([]*entity.OutboxEvent, error) {
+	var events []*entity.OutboxEvent
+
+	query := `
+		SELECT
+			id, aggregate_type, aggregate_id, event_type, payload, attempts,
+			COALESCE(last_error, '') AS last_error, next_attempt_at, published_at, created_at
+		FROM outbox_events
+		WHERE published_at IS NULL AND next_attempt_at <= NOW()
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &events, query, limit); err != nil {
+		return nil, fmt.Errorf("error claiming outbox events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *PostgresOutboxRepository) MarkPublished(ctx context.Context, id int64) error {
+	query := `
+		UPDATE outbox_events
+		SET published_at = NOW(), attempts = attempts + 1, last_error = NULL
+		WHERE id = $1
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("error marking outbox event as published: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresOutboxRepository) MarkFailed(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error {
+	query := `
+		UPDATE outbox_events
+		SET attempts = attempts + 1, last_error = $1, next_attempt_at = $2
+		WHERE id = $3
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, lastError, nextAttemptAt, id); err != nil {
+		return fmt.Errorf("error marking outbox event as failed: %w", err)
+	}
+
+	return nil
+}
+
+// DeletePublishedBefore remove eventos já publicados antes da data informada
+func (r *PostgresOutboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	query := `
+		DELETE FROM outbox_events
+		WHERE published_at IS NOT NULL AND published_at < $1
+	`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting published outbox events: %w", err)
+	}
+
+	return result.RowsAffected()
+}
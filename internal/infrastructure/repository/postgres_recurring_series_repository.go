@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type PostgresRecurringSeriesRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRecurringSeriesRepository(db *sqlx.DB) *PostgresRecurringSeriesRepository {
+	return &PostgresRecurringSeriesRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRecurringSeriesRepository) ReplaceForUser(ctx context.Context, userID int64, series []*entity.RecurringSeries) error {
+	conn := database.Conn(ctx, r.db)
+
+	keys := make([]string, len(series))
+	for i, s := range series {
+		keys[i] = s.SeriesKey
+	}
+
+	query := `DELETE FROM recurring_series WHERE user_id = $1 AND NOT (series_key = ANY($2))`
+	if _, err := conn.ExecContext(ctx, query, userID, pq.Array(keys)); err != nil {
+		return fmt.Errorf("error deleting recurring series: %w", err)
+	}
+
+	query = `
+		INSERT INTO recurring_series (
+			external_id, user_id, series_key, merchant_id, description, currency, frequency,
+			amount, previous_amount, occurrences, first_date, last_date, next_date,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, NULLIF($4, 0), $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (user_id, series_key) DO UPDATE
+		SET merchant_id = EXCLUDED.merchant_id, description = EXCLUDED.description,
+			frequency = EXCLUDED.frequency, amount = EXCLUDED.amount,
+			previous_amount = EXCLUDED.previous_amount, occurrences = EXCLUDED.occurrences,
+			first_date = EXCLUDED.first_date, last_date = EXCLUDED.last_date,
+			next_date = EXCLUDED.next_date, updated_at = EXCLUDED.updated_at
+		RETURNING id, external_id, created_at
+	`
+
+	for _, s := range series {
+		err := conn.QueryRowxContext(
+			ctx,
+			query,
+			s.ExternalID,
+			userID,
+			s.SeriesKey,
+			s.MerchantID,
+			s.Description,
+			s.Currency,
+			s.Frequency,
+			s.Amount,
+			s.PreviousAmount,
+			s.Occurrences,
+			s.FirstDate,
+			s.LastDate,
+			s.NextDate,
+			s.CreatedAt,
+			s.UpdatedAt,
+		).Scan(&s.ID, &s.ExternalID, &s.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("error saving recurring series: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresRecurringSeriesRepository) FindByUserID(ctx context.Context, userID int64) ([]*entity.RecurringSeries, error) {
+	var series []*entity.RecurringSeries
+
+	query := `
+		SELECT
+			s.id, s.external_id, s.user_id, s.series_key,
+			COALESCE(s.merchant_id, 0) AS merchant_id,
+			COALESCE(m.external_id, '00000000-0000-0000-0000-000000000000') AS merchant_external_id,
+			s.description, s.currency, s.frequency, s.amount, s.previous_amount, s.occurrences,
+			s.first_date, s.last_date, s.next_date, s.created_at, s.updated_at
+		FROM recurring_series s
+		LEFT JOIN merchants m ON m.id = s.merchant_id
+		WHERE s.user_id = $1
+		ORDER BY s.amount DESC, s.id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &series, query, userID); err != nil {
+		return nil, fmt.Errorf("error finding recurring series by user ID: %w", err)
+	}
+
+	return series, nil
+}
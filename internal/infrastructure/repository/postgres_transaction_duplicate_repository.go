@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const transactionDuplicateSelect = `
+		SELECT id, external_id, user_id, transaction_id, duplicate_of_id, score, status, created_at, updated_at
+		FROM transaction_duplicates
+`
+
+// transactionDuplicateRow representa a linha do banco, com as transações pelo ID
+type transactionDuplicateRow struct {
+	ID            int64                  `db:"id"`
+	ExternalID    uuid.UUID              `db:"external_id"`
+	UserID        int64                  `db:"user_id"`
+	TransactionID int64                  `db:"transaction_id"`
+	DuplicateOfID int64                  `db:"duplicate_of_id"`
+	Score         float64                `db:"score"`
+	Status        entity.DuplicateStatus `db:"status"`
+	CreatedAt     time.Time              `db:"created_at"`
+	UpdatedAt     time.Time              `db:"updated_at"`
+}
+
+type PostgresTransactionDuplicateRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresTransactionDuplicateRepository(db *sqlx.DB) *PostgresTransactionDuplicateRepository {
+	return &PostgresTransactionDuplicateRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresTransactionDuplicateRepository) Create(ctx context.Context, duplicate *entity.TransactionDuplicate) error {
+	query := `
+		INSERT INTO transaction_duplicates (
+			external_id, user_id, transaction_id, duplicate_of_id, score, status, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	err := database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		duplicate.ExternalID,
+		duplicate.UserID,
+		duplicate.Transaction.ID,
+		duplicate.DuplicateOf.ID,
+		duplicate.Score,
+		duplicate.Status,
+		duplicate.CreatedAt,
+		duplicate.UpdatedAt,
+	).Scan(&duplicate.ID)
+
+	if err != nil {
+		return fmt.Errorf("error creating transaction duplicate: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTransactionDuplicateRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.TransactionDuplicate, error) {
+	var row transactionDuplicateRow
+
+	query := transactionDuplicateSelect + `
+		WHERE external_id = $1
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &row, query, externalID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding transaction duplicate by external ID: %w", err)
+	}
+
+	duplicates, err := r.withTransactions(ctx, []transactionDuplicateRow{row})
+	if err != nil {
+		return nil, err
+	}
+	return duplicates[0], nil
+}
+
+func (r *PostgresTransactionDuplicateRepository) FindPendingByUserID(ctx context.Context, userID int64) ([]*entity.TransactionDuplicate, error) {
+	var rows []transactionDuplicateRow
+
+	query := transactionDuplicateSelect + `
+		WHERE user_id = $1 AND status = $2
+		ORDER BY created_at DESC, id DESC
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &rows, query, userID, entity.DuplicateStatusPending); err != nil {
+		return nil, fmt.Errorf("error finding transaction duplicates by user ID: %w", err)
+	}
+
+	return r.withTransactions(ctx, rows)
+}
+
+func (r *PostgresTransactionDuplicateRepository) UpdateStatus(ctx context.Context, duplicate *entity.TransactionDuplicate) error {
+	query := `UPDATE transaction_duplicates SET status = $1, updated_at = $2 WHERE id = $3`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(ctx, query, duplicate.Status, duplicate.UpdatedAt, duplicate.ID)
+	if err != nil {
+		return fmt.Errorf("error updating transaction duplicate status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no transaction duplicate found with ID: %d", duplicate.ID)
+	}
+
+	return nil
+}
+
+// Merge registra as origens da transação duplicada na mantida e a exclui; a
+// exclusão remove também a própria possível duplicata
+func (r *PostgresTransactionDuplicateRepository) Merge(ctx context.Context, duplicate *entity.TransactionDuplicate) error {
+	conn := database.Conn(ctx, r.db)
+
+	query := `
+		INSERT INTO transaction_sources (transaction_id, document_id, fitid, created_at)
+		SELECT $1, document_id, fitid, created_at
+		FROM transaction_sources
+		WHERE transaction_id = $2
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := conn.ExecContext(ctx, query, duplicate.DuplicateOf.ID, duplicate.Transaction.ID); err != nil {
+		return fmt.Errorf("error moving transaction sources: %w", err)
+	}
+
+	query = `DELETE FROM transactions WHERE id = $1`
+	if _, err := conn.ExecContext(ctx, query, duplicate.Transaction.ID); err != nil {
+		return fmt.Errorf("error deleting duplicate transaction: %w", err)
+	}
+
+	return nil
+}
+
+// withTransactions converte as linhas carregando as duas transações de cada uma
+func (r *PostgresTransactionDuplicateRepository) withTransactions(ctx context.Context, rows []transactionDuplicateRow) ([]*entity.TransactionDuplicate, error) {
+	duplicates := make([]*entity.TransactionDuplicate, 0, len(rows))
+	if len(rows) == 0 {
+		return duplicates, nil
+	}
+
+	ids := make([]int64, 0, 2*len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.TransactionID, row.DuplicateOfID)
+	}
+
+	var transactions []*entity.Transaction
+	query := transactionSelect + `
+		WHERE t.id = ANY($1)
+	`
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &transactions, query, pq.Array(ids)); err != nil {
+		return nil, fmt.Errorf("error finding duplicate transactions: %w", err)
+	}
+
+	byID := make(map[int64]*entity.Transaction, len(transactions))
+	for _, transaction := range transactions {
+		byID[transaction.ID] = transaction
+	}
+
+	for _, row := range rows {
+		duplicates = append(duplicates, &entity.TransactionDuplicate{
+			ID:          row.ID,
+			ExternalID:  row.ExternalID,
+			UserID:      row.UserID,
+			Transaction: byID[row.TransactionID],
+			DuplicateOf: byID[row.DuplicateOfID],
+			Score:       row.Score,
+			Status:      row.Status,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+		})
+	}
+	return duplicates, nil
+}
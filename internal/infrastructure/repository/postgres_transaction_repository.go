@@ -0,0 +1,441 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	domainrepo "finance-assistant/internal/domain/repository"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// transactionSelect seleciona as colunas da transação junto com o ID externo do documento de origem
+const transactionSelect = `
+		SELECT
+			t.id, t.external_id, t.user_id, t.document_id, d.external_id AS document_external_id,
+			COALESCE(t.household_id, 0) AS household_id,
+			COALESCE(t.account_id, 0) AS account_id,
+			COALESCE(a.external_id, '00000000-0000-0000-0000-000000000000') AS account_external_id,
+			t.transaction_date, t.amount, t.currency, t.description, t.counterparty,
+			COALESCE(t.category_id, 0) AS category_id,
+			COALESCE(c.external_id, '00000000-0000-0000-0000-000000000000') AS category_external_id,
+			COALESCE(c.name, '') AS category, t.category_source,
+			COALESCE(t.merchant_id, 0) AS merchant_id,
+			COALESCE(m.external_id, '00000000-0000-0000-0000-000000000000') AS merchant_external_id,
+			COALESCE(m.name, '') AS merchant,
+			COALESCE(t.installment_plan_id, 0) AS installment_plan_id,
+			COALESCE(ip.external_id, '00000000-0000-0000-0000-000000000000') AS installment_plan_external_id,
+			COALESCE(t.installment_number, 0) AS installment_number, COALESCE(ip.installments, 0) AS installments,
+			COALESCE(t.fitid, '') AS fitid, t.source_account,
+			COALESCE(tr.id, 0) AS transfer_id,
+			COALESCE(tr.external_id, '00000000-0000-0000-0000-000000000000') AS transfer_external_id,
+			COALESCE(t.fingerprint, '') AS fingerprint,
+			t.created_at, t.updated_at
+		FROM transactions t
+		JOIN documents d ON d.id = t.document_id
+		LEFT JOIN accounts a ON a.id = t.account_id
+		LEFT JOIN categories c ON c.id = t.category_id
+		LEFT JOIN merchants m ON m.id = t.merchant_id
+		LEFT JOIN installment_plans ip ON ip.id = t.installment_plan_id
+		LEFT JOIN transfers tr ON tr.status <> 'rejected'
+			AND t.id IN (tr.outgoing_transaction_id, tr.incoming_transaction_id)
+`
+
+type PostgresTransactionRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresTransactionRepository(db *sqlx.DB) *PostgresTransactionRepository {
+	return &PostgresTransactionRepository{
+		db: db,
+	}
+}
+
+// moveSharedTransactions é a expressão WITH que passa as transações do
+// documento $1 também informadas por outros documentos para o mais antigo
+// deles, de forma que excluir ou reprocessar o documento não as remova
+const moveSharedTransactions = `
+		WITH moved AS (
+			UPDATE transactions t
+			SET document_id = s.document_id, updated_at = NOW()
+			FROM (
+				SELECT DISTINCT ON (transaction_id) transaction_id, document_id
+				FROM transaction_sources
+				WHERE document_id <> $1
+				ORDER BY transaction_id, created_at, document_id
+			) s
+			WHERE t.document_id = $1 AND s.transaction_id = t.id
+			RETURNING t.id
+		)
+`
+
+// Create insere a transação e registra o documento como sua origem. Transações
+// já importadas, com a mesma impressão digital ou o mesmo FITID na conta de
+// origem, não são inseridas: o documento é registrado como mais uma origem da
+// existente e é retornado ErrDuplicateTransaction. O ON CONFLICT sem alvo
+// cobre os dois índices únicos, inclusive entre documentos processados ao mesmo
+// tempo, sem abortar a transação em andamento.
+func (r *PostgresTransactionRepository) Create(ctx context.Context, transaction *entity.Transaction) error {
+	query := `
+		WITH inserted AS (
+			INSERT INTO transactions (
+				external_id, user_id, document_id, household_id, account_id, transaction_date, amount,
+				currency, description, counterparty, category_id, category_source, merchant_id,
+				installment_plan_id, installment_number, fitid, source_account, fingerprint, created_at, updated_at
+			)
+			VALUES (
+				$1, $2, $3, NULLIF($4, 0), NULLIF($5, 0), $6, $7, $8, $9, $10, NULLIF($11, 0), $12, NULLIF($13, 0),
+				NULLIF($14, 0), NULLIF($15, 0), NULLIF($16, ''), $17, NULLIF($18, ''), $19, $20
+			)
+			ON CONFLICT DO NOTHING
+			RETURNING id
+		), source AS (
+			INSERT INTO transaction_sources (transaction_id, document_id, fitid, created_at)
+			SELECT id, $3, NULLIF($16, ''), $19 FROM inserted
+		)
+		SELECT id FROM inserted
+	`
+
+	err := database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		transaction.ExternalID,
+		transaction.UserID,
+		transaction.DocumentID,
+		transaction.HouseholdID,
+		transaction.AccountID,
+		transaction.Date,
+		transaction.Amount,
+		transaction.Currency,
+		transaction.Description,
+		transaction.Counterparty,
+		transaction.CategoryID,
+		transaction.CategorySource,
+		transaction.MerchantID,
+		transaction.InstallmentPlanID,
+		transaction.InstallmentNumber,
+		transaction.FITID,
+		transaction.SourceAccount,
+		transaction.Fingerprint,
+		transaction.CreatedAt,
+		transaction.UpdatedAt,
+	).Scan(&transaction.ID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return r.addSource(ctx, transaction)
+		}
+		return fmt.Errorf("error creating transaction: %w", err)
+	}
+
+	return nil
+}
+
+// addSource registra o documento da transação duplicada como mais uma origem
+// da transação já importada e retorna ErrDuplicateTransaction
+func (r *PostgresTransactionRepository) addSource(ctx context.Context, transaction *entity.Transaction) error {
+	query := `
+		INSERT INTO transaction_sources (transaction_id, document_id, fitid, created_at)
+		SELECT id, $5, NULLIF($3, ''), $6
+		FROM transactions
+		WHERE user_id = $1
+			AND ((source_account = $2 AND fitid = NULLIF($3, '')) OR fingerprint = NULLIF($4, ''))
+		ORDER BY id
+		LIMIT 1
+		ON CONFLICT DO NOTHING
+	`
+
+	_, err := database.Conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		transaction.UserID,
+		transaction.SourceAccount,
+		transaction.FITID,
+		transaction.Fingerprint,
+		transaction.DocumentID,
+		transaction.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error adding transaction source: %w", err)
+	}
+
+	return domainrepo.ErrDuplicateTransaction
+}
+
+func (r *PostgresTransactionRepository) FindSources(ctx context.Context, transactionID int64) ([]*entity.TransactionSource, error) {
+	var sources []*entity.TransactionSource
+
+	query := `
+		SELECT
+			s.document_id, d.external_id AS document_external_id, d.filename,
+			COALESCE(s.fitid, '') AS fitid, s.created_at
+		FROM transaction_sources s
+		JOIN documents d ON d.id = s.document_id
+		WHERE s.transaction_id = $1
+		ORDER BY s.created_at, s.document_id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &sources, query, transactionID); err != nil {
+		return nil, fmt.Errorf("error finding transaction sources: %w", err)
+	}
+
+	return sources, nil
+}
+
+func (r *PostgresTransactionRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Transaction, error) {
+	var transaction entity.Transaction
+
+	query := transactionSelect + `
+		WHERE t.external_id = $1
+	`
+
+	err := database.Conn(ctx, r.db).GetContext(ctx, &transaction, query, externalID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding transaction by external ID: %w", err)
+	}
+
+	return &transaction, nil
+}
+
+func (r *PostgresTransactionRepository) FindByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+
+	query := transactionSelect + `
+		WHERE t.user_id = $1
+		ORDER BY t.transaction_date DESC, t.id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &transactions, query, userID, limit, offset); err != nil {
+		return nil, fmt.Errorf("error finding transactions by user ID: %w", err)
+	}
+
+	return transactions, nil
+}
+
+func (r *PostgresTransactionRepository) FindByUserIDSince(ctx context.Context, userID int64, since time.Time) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+
+	query := transactionSelect + `
+		WHERE t.user_id = $1 AND t.transaction_date >= $2
+		ORDER BY t.transaction_date, t.id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &transactions, query, userID, since); err != nil {
+		return nil, fmt.Errorf("error finding transactions by user ID since date: %w", err)
+	}
+
+	return transactions, nil
+}
+
+func (r *PostgresTransactionRepository) FindAccessibleByUserIDSince(ctx context.Context, userID int64, since time.Time) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+
+	query := transactionSelect + `
+		WHERE (t.user_id = $1 OR t.household_id IN (SELECT household_id FROM household_members WHERE user_id = $1))
+			AND t.transaction_date >= $2
+		ORDER BY t.transaction_date, t.id
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &transactions, query, userID, since); err != nil {
+		return nil, fmt.Errorf("error finding accessible transactions by user ID since date: %w", err)
+	}
+
+	return transactions, nil
+}
+
+func (r *PostgresTransactionRepository) FindAccessibleByUserID(ctx context.Context, userID int64, limit, offset int) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+
+	query := transactionSelect + `
+		WHERE t.user_id = $1
+			OR t.household_id IN (SELECT household_id FROM household_members WHERE user_id = $1)
+		ORDER BY t.transaction_date DESC, t.id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &transactions, query, userID, limit, offset); err != nil {
+		return nil, fmt.Errorf("error finding accessible transactions by user ID: %w", err)
+	}
+
+	return transactions, nil
+}
+
+func (r *PostgresTransactionRepository) FindConfirmedByUserID(ctx context.Context, userID int64, limit int) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+
+	query := transactionSelect + `
+		WHERE t.user_id = $1 AND t.category_id IS NOT NULL AND t.category_source IN ($2, $3)
+		ORDER BY t.transaction_date DESC, t.id DESC
+		LIMIT $4
+	`
+
+	err := database.Conn(ctx, r.db).SelectContext(ctx, &transactions, query, userID, entity.CategorySourceFile, entity.CategorySourceUser, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error finding confirmed transactions by user ID: %w", err)
+	}
+
+	return transactions, nil
+}
+
+func (r *PostgresTransactionRepository) SumDailyByAccountID(ctx context.Context, accountID int64) ([]entity.BalanceChange, error) {
+	var changes []entity.BalanceChange
+
+	query := `
+		SELECT transaction_date, SUM(amount) AS amount
+		FROM transactions
+		WHERE account_id = $1
+		GROUP BY transaction_date
+		ORDER BY transaction_date
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &changes, query, accountID); err != nil {
+		return nil, fmt.Errorf("error summing transactions by account ID: %w", err)
+	}
+
+	return changes, nil
+}
+
+func (r *PostgresTransactionRepository) FindByDocumentID(ctx context.Context, documentID int64, limit, offset int) ([]*entity.Transaction, error) {
+	var transactions []*entity.Transaction
+
+	query := transactionSelect + `
+		WHERE t.document_id = $1
+		ORDER BY t.transaction_date DESC, t.id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &transactions, query, documentID, limit, offset); err != nil {
+		return nil, fmt.Errorf("error finding transactions by document ID: %w", err)
+	}
+
+	return transactions, nil
+}
+
+func (r *PostgresTransactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
+	query := `
+		UPDATE transactions
+		SET transaction_date = $1, amount = $2, currency = $3, description = $4,
+			counterparty = $5, category_id = NULLIF($6, 0), category_source = $7,
+			merchant_id = NULLIF($8, 0), updated_at = $9
+		WHERE id = $10
+	`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		transaction.Date,
+		transaction.Amount,
+		transaction.Currency,
+		transaction.Description,
+		transaction.Counterparty,
+		transaction.CategoryID,
+		transaction.CategorySource,
+		transaction.MerchantID,
+		transaction.UpdatedAt,
+		transaction.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating transaction: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no transaction found with ID: %d", transaction.ID)
+	}
+
+	return nil
+}
+
+func (r *PostgresTransactionRepository) UpdateCategory(ctx context.Context, id, categoryID int64, source string) error {
+	query := `
+		UPDATE transactions
+		SET category_id = NULLIF($1, 0), category_source = $2, updated_at = NOW()
+		WHERE id = $3
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, categoryID, source, id); err != nil {
+		return fmt.Errorf("error updating transaction category: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTransactionRepository) UpdateMerchant(ctx context.Context, id, merchantID int64) error {
+	query := `
+		UPDATE transactions
+		SET merchant_id = NULLIF($1, 0), updated_at = NOW()
+		WHERE id = $2
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, merchantID, id); err != nil {
+		return fmt.Errorf("error updating transaction merchant: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTransactionRepository) DeleteByDocumentID(ctx context.Context, documentID int64) error {
+	query := moveSharedTransactions + `,
+		sources AS (
+			DELETE FROM transaction_sources WHERE document_id = $1
+		)
+		DELETE FROM transactions
+		WHERE document_id = $1 AND id NOT IN (SELECT id FROM moved)
+	`
+
+	if _, err := database.Conn(ctx, r.db).ExecContext(ctx, query, documentID); err != nil {
+		return fmt.Errorf("error deleting transactions by document ID: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTransactionRepository) CountByUserID(ctx context.Context, userID int64) (int, error) {
+	query := `SELECT COUNT(*) FROM transactions WHERE user_id = $1`
+
+	var count int
+	if err := database.Conn(ctx, r.db).QueryRowxContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("error counting transactions by user ID: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *PostgresTransactionRepository) CountAccessibleByUserID(ctx context.Context, userID int64) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM transactions
+		WHERE user_id = $1
+			OR household_id IN (SELECT household_id FROM household_members WHERE user_id = $1)
+	`
+
+	var count int
+	if err := database.Conn(ctx, r.db).QueryRowxContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("error counting accessible transactions by user ID: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *PostgresTransactionRepository) CountByDocumentID(ctx context.Context, documentID int64) (int, error) {
+	query := `SELECT COUNT(*) FROM transactions WHERE document_id = $1`
+
+	var count int
+	if err := database.Conn(ctx, r.db).QueryRowxContext(ctx, query, documentID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("error counting transactions by document ID: %w", err)
+	}
+
+	return count, nil
+}
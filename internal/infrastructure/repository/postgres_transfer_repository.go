@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// transferSelect seleciona as colunas da transferência junto com os dados das
+// transações de saída (o) e de entrada (i)
+const transferSelect = `
+		SELECT
+			tr.id, tr.external_id, tr.user_id, tr.status, tr.created_at, tr.updated_at,
+			o.id AS outgoing_id, o.external_id AS outgoing_external_id,
+			COALESCE(oa.external_id, '00000000-0000-0000-0000-000000000000') AS outgoing_account_external_id,
+			o.transaction_date AS outgoing_date, o.description AS outgoing_description,
+			i.id AS incoming_id, i.external_id AS incoming_external_id,
+			COALESCE(ia.external_id, '00000000-0000-0000-0000-000000000000') AS incoming_account_external_id,
+			i.transaction_date AS incoming_date, i.description AS incoming_description,
+			i.amount, i.currency
+		FROM transfers tr
+		JOIN transactions o ON o.id = tr.outgoing_transaction_id
+		JOIN transactions i ON i.id = tr.incoming_transaction_id
+		LEFT JOIN accounts oa ON oa.id = o.account_id
+		LEFT JOIN accounts ia ON ia.id = i.account_id
+`
+
+// transferRow representa a linha do banco com as duas transações achatadas
+type transferRow struct {
+	ID                        int64                 `db:"id"`
+	ExternalID                uuid.UUID             `db:"external_id"`
+	UserID                    int64                 `db:"user_id"`
+	Status                    entity.TransferStatus `db:"status"`
+	CreatedAt                 time.Time             `db:"created_at"`
+	UpdatedAt                 time.Time             `db:"updated_at"`
+	OutgoingID                int64                 `db:"outgoing_id"`
+	OutgoingExternalID        uuid.UUID             `db:"outgoing_external_id"`
+	OutgoingAccountExternalID uuid.UUID             `db:"outgoing_account_external_id"`
+	OutgoingDate              time.Time             `db:"outgoing_date"`
+	OutgoingDescription       string                `db:"outgoing_description"`
+	IncomingID                int64                 `db:"incoming_id"`
+	IncomingExternalID        uuid.UUID             `db:"incoming_external_id"`
+	IncomingAccountExternalID uuid.UUID             `db:"incoming_account_external_id"`
+	IncomingDate              time.Time             `db:"incoming_date"`
+	IncomingDescription       string                `db:"incoming_description"`
+	Amount                    int64                 `db:"amount"`
+	Currency                  string                `db:"currency"`
+}
+
+func (row *transferRow) toEntity() *entity.Transfer {
+	return &entity.Transfer{
+		ID:         row.ID,
+		ExternalID: row.ExternalID,
+		UserID:     row.UserID,
+		Outgoing: entity.TransferLeg{
+			TransactionID:         row.OutgoingID,
+			TransactionExternalID: row.OutgoingExternalID,
+			AccountExternalID:     row.OutgoingAccountExternalID,
+			Date:                  row.OutgoingDate,
+			Description:           row.OutgoingDescription,
+		},
+		Incoming: entity.TransferLeg{
+			TransactionID:         row.IncomingID,
+			TransactionExternalID: row.IncomingExternalID,
+			AccountExternalID:     row.IncomingAccountExternalID,
+			Date:                  row.IncomingDate,
+			Description:           row.IncomingDescription,
+		},
+		Amount:    row.Amount,
+		Currency:  row.Currency,
+		Status:    row.Status,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+type PostgresTransferRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresTransferRepository(db *sqlx.DB) *PostgresTransferRepository {
+	return &PostgresTransferRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresTransferRepository) Create(ctx context.Context, transfer *entity.Transfer) error {
+	query := `
+		INSERT INTO transfers (
+			external_id, user_id, outgoing_transaction_id, incoming_transaction_id, status, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	err := database.Conn(ctx, r.db).QueryRowxContext(
+		ctx,
+		query,
+		transfer.ExternalID,
+		transfer.UserID,
+		transfer.Outgoing.TransactionID,
+		transfer.Incoming.TransactionID,
+		transfer.Status,
+		transfer.CreatedAt,
+		transfer.UpdatedAt,
+	).Scan(&transfer.ID)
+
+	if err != nil {
+		return fmt.Errorf("error creating transfer: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresTransferRepository) FindByExternalID(ctx context.Context, externalID uuid.UUID) (*entity.Transfer, error) {
+	var row transferRow
+
+	query := transferSelect + `
+		WHERE tr.external_id = $1
+	`
+
+	if err := database.Conn(ctx, r.db).GetContext(ctx, &row, query, externalID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding transfer by external ID: %w", err)
+	}
+
+	return row.toEntity(), nil
+}
+
+func (r *PostgresTransferRepository) FindByUserID(ctx context.Context, userID int64, status entity.TransferStatus) ([]*entity.Transfer, error) {
+	query := transferSelect + `
+		WHERE tr.user_id = $1 AND ($2 = '' OR tr.status = $2)
+		ORDER BY o.transaction_date DESC, tr.id DESC
+	`
+
+	return r.selectTransfers(ctx, query, userID, status)
+}
+
+func (r *PostgresTransferRepository) FindByTransactionID(ctx context.Context, transactionID int64) ([]*entity.Transfer, error) {
+	query := transferSelect + `
+		WHERE $1 IN (tr.outgoing_transaction_id, tr.incoming_transaction_id)
+		ORDER BY tr.id
+	`
+
+	return r.selectTransfers(ctx, query, transactionID)
+}
+
+func (r *PostgresTransferRepository) UpdateStatus(ctx context.Context, transfer *entity.Transfer) error {
+	query := `UPDATE transfers SET status = $1, updated_at = $2 WHERE id = $3`
+
+	result, err := database.Conn(ctx, r.db).ExecContext(ctx, query, transfer.Status, transfer.UpdatedAt, transfer.ID)
+	if err != nil {
+		return fmt.Errorf("error updating transfer status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no transfer found with ID: %d", transfer.ID)
+	}
+
+	return nil
+}
+
+func (r *PostgresTransferRepository) selectTransfers(ctx context.Context, query string, args ...any) ([]*entity.Transfer, error) {
+	var rows []transferRow
+	if err := database.Conn(ctx, r.db).SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("error finding transfers: %w", err)
+	}
+
+	transfers := make([]*entity.Transfer, 0, len(rows))
+	for i := range rows {
+		transfers = append(transfers, rows[i].toEntity())
+	}
+	return transfers, nil
+}
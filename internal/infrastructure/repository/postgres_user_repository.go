@@ -23,8 +23,8 @@ func NewPostgresUserRepository(db *sqlx.DB) *PostgresUserRepository {
 
 func (r *PostgresUserRepository) Create(ctx context.Context, user *entity.User) error {
 	query := `
-		INSERT INTO users (external_id, name, email, phone, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO users (external_id, name, email, phone, password_hash, role, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8)
 		RETURNING id
 	`
 
@@ -35,6 +35,8 @@ func (r *PostgresUserRepository) Create(ctx context.Context, user *entity.User)
 		user.Name,
 		user.Email,
 		user.Phone,
+		user.PasswordHash,
+		user.Role,
 		user.CreatedAt,
 		user.UpdatedAt,
 	).Scan(&user.ID)
@@ -50,7 +52,7 @@ func (r *PostgresUserRepository) FindByID(ctx context.Context, id int64) (*entit
 	var user entity.User
 
 	query := `
-		SELECT id, external_id, name, email, phone, created_at, updated_at
+		SELECT id, external_id, name, email, phone, COALESCE(password_hash, '') AS password_hash, role, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
@@ -70,7 +72,7 @@ func (r *PostgresUserRepository) FindByExternalID(ctx context.Context, externalI
 	var user entity.User
 
 	query := `
-		SELECT id, external_id, name, email, phone, created_at, updated_at
+		SELECT id, external_id, name, email, phone, COALESCE(password_hash, '') AS password_hash, role, created_at, updated_at
 		FROM users
 		WHERE external_id = $1
 	`
@@ -90,7 +92,7 @@ func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email string)
 	var user entity.User
 
 	query := `
-		SELECT id, external_id, name, email, phone, created_at, updated_at
+		SELECT id, external_id, name, email, phone, COALESCE(password_hash, '') AS password_hash, role, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
@@ -109,8 +111,8 @@ func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email string)
 func (r *PostgresUserRepository) Update(ctx context.Context, user *entity.User) error {
 	query := `
 		UPDATE users
-		SET name = $1, email = $2, phone = $3, updated_at = $4
-		WHERE id = $5
+		SET name = $1, email = $2, phone = $3, role = $4, updated_at = $5
+		WHERE id = $6
 	`
 
 	result, err := r.db.ExecContext(
@@ -119,6 +121,7 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user *entity.User)
 		user.Name,
 		user.Email,
 		user.Phone,
+		user.Role,
 		user.UpdatedAt,
 		user.ID,
 	)
@@ -138,6 +141,30 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user *entity.User)
 	return nil
 }
 
+func (r *PostgresUserRepository) UpdatePassword(ctx context.Context, id int64, passwordHash string) error {
+	query := `
+		UPDATE users
+		SET password_hash = $1, updated_at = NOW()
+		WHERE id = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, passwordHash, id)
+	if err != nil {
+		return fmt.Errorf("error updating user password: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no user found with ID: %d", id)
+	}
+
+	return nil
+}
+
 func (r *PostgresUserRepository) Delete(ctx context.Context, id int64) error {
 	query := `DELETE FROM users WHERE id = $1`
 
@@ -162,7 +189,7 @@ func (r *PostgresUserRepository) List(ctx context.Context, limit, offset int) ([
 	var users []*entity.User
 
 	query := `
-		SELECT id, external_id, name, email, phone, created_at, updated_at
+		SELECT id, external_id, name, email, phone, COALESCE(password_hash, '') AS password_hash, role, created_at, updated_at
 		FROM users
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"fmt"
+
+	"finance-assistant/config"
+	domainstorage "finance-assistant/internal/domain/storage"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// NewBlobStore cria o armazenamento de arquivos conforme STORAGE_DRIVER
+func NewBlobStore(cfg *config.Config) (domainstorage.BlobStore, error) {
+	switch cfg.StorageDriver {
+	case DriverLocal, "":
+		return NewLocalBlobStore(cfg.StorageLocalPath)
+	case DriverS3:
+		return NewS3BlobStore(S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			PathStyle: cfg.S3PathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("driver de armazenamento desconhecido: %s", cfg.StorageDriver)
+	}
+}
@@ -0,0 +1,463 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	domainstorage "finance-assistant/internal/domain/storage"
+)
+
+const (
+	s3Service       = "s3"
+	s3Algorithm     = "AWS4-HMAC-SHA256"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	emptyPayload    = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	// s3PartSize é o tamanho mínimo de parte aceito pelo S3 em uploads multipart;
+	// também limita a memória usada por upload de tamanho desconhecido
+	s3PartSize = 5 << 20
+)
+
+// S3Config reúne os parâmetros de acesso a um serviço compatível com S3 (AWS, MinIO etc.)
+type S3Config struct {
+	Endpoint  string // ex: https://s3.us-east-1.amazonaws.com ou http://localhost:9000
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool // endpoint/bucket/key em vez de bucket.endpoint/key (necessário no MinIO)
+}
+
+// S3BlobStore armazena os arquivos em um bucket compatível com S3, assinando
+// as requisições com AWS Signature Version 4
+type S3BlobStore struct {
+	client   *http.Client
+	endpoint *url.URL
+	cfg      S3Config
+}
+
+func NewS3BlobStore(cfg S3Config) (*S3BlobStore, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("bucket S3 não configurado")
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region)
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("endpoint S3 inválido: %s", cfg.Endpoint)
+	}
+
+	return &S3BlobStore{
+		client:   &http.Client{Timeout: 5 * time.Minute},
+		endpoint: endpoint,
+		cfg:      cfg,
+	}, nil
+}
+
+// Put envia o conteúdo em uma única requisição quando o tamanho é conhecido ou
+// cabe em uma parte; caso contrário usa upload multipart, mantendo em memória
+// no máximo uma parte por vez
+func (s *S3BlobStore) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	if size >= 0 {
+		return s.putObject(ctx, key, content, size, contentType)
+	}
+
+	part := make([]byte, s3PartSize)
+	n, err := io.ReadFull(content, part)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return s.putObject(ctx, key, bytes.NewReader(part[:n]), int64(n), contentType)
+	}
+	if err != nil {
+		return fmt.Errorf("erro ao ler conteúdo: %w", err)
+	}
+
+	return s.multipartUpload(ctx, key, content, part, contentType)
+}
+
+func (s *S3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.getObject(ctx, key, "")
+}
+
+func (s *S3BlobStore) Stat(ctx context.Context, key string) (int64, error) {
+	resp, err := s.do(ctx, http.MethodHead, key, nil, nil, emptyPayload, nil)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao consultar arquivo no S3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.ContentLength, nil
+	case http.StatusNotFound:
+		return 0, domainstorage.ErrBlobNotFound
+	default:
+		return 0, s.responseError("consultar arquivo", resp)
+	}
+}
+
+func (s *S3BlobStore) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	if length <= 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+	return s.getObject(ctx, key, fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+}
+
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, nil, emptyPayload, nil)
+	if err != nil {
+		return fmt.Errorf("erro ao remover arquivo do S3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return s.responseError("remover arquivo", resp)
+	}
+	return nil
+}
+
+func (s *S3BlobStore) putObject(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	// O S3 exige Content-Length; corpo vazio evita que o cliente HTTP use chunked encoding
+	if size == 0 {
+		content = http.NoBody
+	}
+
+	resp, err := s.do(ctx, http.MethodPut, key, nil, content, unsignedPayload, func(req *http.Request) {
+		req.ContentLength = size
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao enviar arquivo para o S3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.responseError("enviar arquivo", resp)
+	}
+	return nil
+}
+
+func (s *S3BlobStore) getObject(ctx context.Context, key, byteRange string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil, nil, emptyPayload, func(req *http.Request) {
+		if byteRange != "" {
+			req.Header.Set("Range", byteRange)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao obter arquivo do S3: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, domainstorage.ErrBlobNotFound
+	default:
+		defer resp.Body.Close()
+		return nil, s.responseError("obter arquivo", resp)
+	}
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+// multipartUpload envia o conteúdo em partes de s3PartSize; first é a primeira
+// parte, já lida pelo chamador. Em caso de erro o upload é abortado.
+func (s *S3BlobStore) multipartUpload(ctx context.Context, key string, content io.Reader, first []byte, contentType string) error {
+	uploadID, err := s.createMultipartUpload(ctx, key, contentType)
+	if err != nil {
+		return err
+	}
+
+	var parts []completedPart
+	buffer, part := first, first
+	for number := 1; ; number++ {
+		etag, err := s.uploadPart(ctx, key, uploadID, number, part)
+		if err != nil {
+			s.abortMultipartUpload(key, uploadID)
+			return err
+		}
+		parts = append(parts, completedPart{PartNumber: number, ETag: etag})
+
+		// Uma parte incompleta é sempre a última
+		if len(part) < s3PartSize {
+			break
+		}
+
+		n, err := io.ReadFull(content, buffer)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			s.abortMultipartUpload(key, uploadID)
+			return fmt.Errorf("erro ao ler conteúdo: %w", err)
+		}
+		part = buffer[:n]
+	}
+
+	if err := s.completeMultipartUpload(ctx, key, uploadID, parts); err != nil {
+		s.abortMultipartUpload(key, uploadID)
+		return err
+	}
+	return nil
+}
+
+func (s *S3BlobStore) createMultipartUpload(ctx context.Context, key, contentType string) (string, error) {
+	resp, err := s.do(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil, emptyPayload, func(req *http.Request) {
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+	})
+	if err != nil {
+		return "", fmt.Errorf("erro ao iniciar upload multipart: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", s.responseError("iniciar upload multipart", resp)
+	}
+
+	var result struct {
+		UploadID string `xml:"UploadId"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil || result.UploadID == "" {
+		return "", fmt.Errorf("resposta inválida ao iniciar upload multipart: %v", err)
+	}
+	return result.UploadID, nil
+}
+
+func (s *S3BlobStore) uploadPart(ctx context.Context, key, uploadID string, number int, part []byte) (string, error) {
+	query := url.Values{"partNumber": {strconv.Itoa(number)}, "uploadId": {uploadID}}
+	resp, err := s.do(ctx, http.MethodPut, key, query, bytes.NewReader(part), hashHex(part), func(req *http.Request) {
+		req.ContentLength = int64(len(part))
+	})
+	if err != nil {
+		return "", fmt.Errorf("erro ao enviar parte %d: %w", number, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", s.responseError(fmt.Sprintf("enviar parte %d", number), resp)
+	}
+	return resp.Header.Get("ETag"), nil
+}
+
+func (s *S3BlobStore) completeMultipartUpload(ctx context.Context, key, uploadID string, parts []completedPart) error {
+	body, err := xml.Marshal(struct {
+		XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+		Parts   []completedPart `xml:"Part"`
+	}{Parts: parts})
+	if err != nil {
+		return fmt.Errorf("erro ao serializar partes: %w", err)
+	}
+
+	resp, err := s.do(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadID}}, bytes.NewReader(body), hashHex(body), func(req *http.Request) {
+		req.ContentLength = int64(len(body))
+		req.Header.Set("Content-Type", "application/xml")
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao concluir upload multipart: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.responseError("concluir upload multipart", resp)
+	}
+
+	// O S3 pode responder 200 com um documento <Error> quando a conclusão falha
+	result, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return fmt.Errorf("erro ao ler resposta do upload multipart: %w", err)
+	}
+	if bytes.Contains(result, []byte("<Error>")) {
+		return fmt.Errorf("erro ao concluir upload multipart no S3: %s", strings.TrimSpace(string(result)))
+	}
+	return nil
+}
+
+// abortMultipartUpload descarta as partes enviadas; usa contexto próprio para
+// funcionar mesmo quando o contexto da requisição já foi cancelado
+func (s *S3BlobStore) abortMultipartUpload(key, uploadID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := s.do(ctx, http.MethodDelete, key, url.Values{"uploadId": {uploadID}}, nil, emptyPayload, nil)
+	if err != nil {
+		return
+	}
+	resp.Body.Close()
+}
+
+// do monta, assina e envia uma requisição para o objeto informado
+func (s *S3BlobStore) do(ctx context.Context, method, key string, query url.Values, body io.Reader, payloadHash string, prepare func(*http.Request)) (*http.Response, error) {
+	if key == "" {
+		return nil, ErrInvalidBlobKey
+	}
+
+	u := *s.endpoint
+	if s.cfg.PathStyle {
+		u.Path = "/" + s.cfg.Bucket + "/" + key
+		u.RawPath = "/" + escapePath(s.cfg.Bucket) + "/" + escapePath(key)
+	} else {
+		u.Host = s.cfg.Bucket + "." + u.Host
+		u.Path = "/" + key
+		u.RawPath = "/" + escapePath(key)
+	}
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar requisição S3: %w", err)
+	}
+	if prepare != nil {
+		prepare(req)
+	}
+	s.sign(req, payloadHash)
+
+	return s.client.Do(req)
+}
+
+// sign adiciona os cabeçalhos de autenticação AWS Signature Version 4
+func (s *S3BlobStore) sign(req *http.Request, payloadHash string) {
+	s.signAt(req, payloadHash, time.Now())
+}
+
+// signAt assina a requisição com o horário informado, separado de sign para
+// que a assinatura possa ser comparada com os exemplos da documentação da AWS
+func (s *S3BlobStore) signAt(req *http.Request, payloadHash string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	canonicalHeaders, signedHeaders := canonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.cfg.Region + "/" + s3Service + "/aws4_request"
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.cfg.SecretKey), date)
+	signingKey = hmacSHA256(signingKey, s.cfg.Region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.cfg.AccessKey, scope, signedHeaders, signature))
+}
+
+// canonicalHeaders monta os cabeçalhos assinados: host, range e os x-amz-*,
+// em minúsculas e em ordem alfabética
+func canonicalHeaders(req *http.Request) (string, string) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if name == "range" || strings.HasPrefix(name, "x-amz-") {
+			headers[name] = strings.Join(strings.Fields(strings.Join(values, ",")), " ")
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name + ":" + headers[name] + "\n")
+	}
+	return canonical.String(), strings.Join(names, ";")
+}
+
+func (s *S3BlobStore) responseError(action string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("erro ao %s no S3: status %d: %s", action, resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// canonicalQuery ordena e codifica os parâmetros como exigido pelo SigV4
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		for _, value := range query[key] {
+			pairs = append(pairs, escape(key, true)+"="+escape(value, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escapePath codifica a chave conforme as regras de URI do SigV4, preservando "/"
+func escapePath(path string) string {
+	return escape(path, false)
+}
+
+func escape(value string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
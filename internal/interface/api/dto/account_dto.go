@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// AccountRequest representa os dados para criar ou atualizar uma conta
+type AccountRequest struct {
+	Name           string `json:"name" binding:"required,max=60" example:"Conta corrente Itaú"`                                      // Nome da conta
+	Institution    string `json:"institution,omitempty" binding:"max=100" example:"Itaú"`                                            // Banco ou instituição (opcional)
+	Type           string `json:"type" binding:"required" example:"checking" enums:"checking,savings,credit_card,investment,wallet"` // Tipo da conta
+	Currency       string `json:"currency,omitempty" example:"BRL"`                                                                  // Código ISO 4217 da moeda (padrão: BRL)
+	Number         string `json:"number,omitempty" binding:"max=40" example:"12345-6"`                                               // Número da conta ou do cartão; apenas os 4 últimos dígitos são guardados (opcional)
+	OpeningBalance int64  `json:"opening_balance" example:"150000"`                                                                  // Saldo inicial em centavos
+}
+
+// AccountResponse representa uma conta retornada pela API
+type AccountResponse struct {
+	ID             uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo da conta
+	Name           string    `json:"name" example:"Conta corrente Itaú"`                // Nome da conta
+	Institution    string    `json:"institution,omitempty" example:"Itaú"`              // Banco ou instituição
+	Type           string    `json:"type" example:"checking"`                           // Tipo da conta
+	Currency       string    `json:"currency" example:"BRL"`                            // Código ISO 4217 da moeda
+	MaskedNumber   string    `json:"masked_number,omitempty" example:"****3456"`        // Últimos dígitos do número
+	OpeningBalance int64     `json:"opening_balance" example:"150000"`                  // Saldo inicial em centavos
+	Linked         bool      `json:"linked" example:"true"`                             // Se a conta já foi associada a um extrato importado
+	CreatedAt      time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`         // Data de criação
+	UpdatedAt      time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`         // Data de última atualização
+}
+
+// AccountFromEntity converte uma entidade Account para DTO
+func AccountFromEntity(account *entity.Account) AccountResponse {
+	return AccountResponse{
+		ID:             account.ExternalID,
+		Name:           account.Name,
+		Institution:    account.Institution,
+		Type:           string(account.Type),
+		Currency:       account.Currency,
+		MaskedNumber:   account.MaskedNumber,
+		OpeningBalance: account.OpeningBalance,
+		Linked:         account.SourceAccount != "",
+		CreatedAt:      account.CreatedAt,
+		UpdatedAt:      account.UpdatedAt,
+	}
+}
+
+// ReconciliationResponse representa a conciliação dos extratos de uma conta
+type ReconciliationResponse struct {
+	AccountID      uuid.UUID                  `json:"account_id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo da conta
+	Currency       string                     `json:"currency" example:"BRL"`                                    // Código ISO 4217 da moeda
+	OpeningBalance int64                      `json:"opening_balance" example:"150000"`                          // Saldo inicial em centavos
+	CurrentBalance int64                      `json:"current_balance" example:"182550"`                          // Saldo atual em centavos: saldo inicial mais as transações importadas
+	Reconciled     bool                       `json:"reconciled" example:"true"`                                 // Se nenhum extrato tem diferença de valor
+	Statements     []AccountStatementResponse `json:"statements"`                                                // Extratos em ordem de período
+}
+
+// AccountStatementResponse representa o resultado da conciliação de um extrato
+type AccountStatementResponse struct {
+	DocumentID      uuid.UUID `json:"document_id" example:"550e8400-e29b-41d4-a716-446655440000"`                      // ID externo do documento do extrato
+	StartDate       time.Time `json:"start_date" example:"2024-01-01T00:00:00Z"`                                       // Início do período do extrato
+	EndDate         time.Time `json:"end_date" example:"2024-01-31T00:00:00Z"`                                         // Fim do período do extrato
+	OpeningBalance  *int64    `json:"opening_balance" example:"150000"`                                                // Saldo anterior ao período informado pelo extrato em centavos; null quando não informado
+	ClosingBalance  *int64    `json:"closing_balance" example:"182550"`                                                // Saldo final informado pelo extrato em centavos; null quando não informado
+	ExpectedBalance int64     `json:"expected_balance" example:"182550"`                                               // Saldo calculado na data do saldo final, em centavos
+	Difference      int64     `json:"difference" example:"0"`                                                          // Saldo informado menos o calculado, em centavos
+	Status          string    `json:"status" example:"matched" enums:"matched,amount_mismatch,overlap,gap,unverified"` // Resultado da conciliação
+}
+
+// ReconciliationFromEntity converte a conciliação dos extratos de uma conta para DTO
+func ReconciliationFromEntity(account *entity.Account, statements []*entity.AccountStatement, balance int64) ReconciliationResponse {
+	response := ReconciliationResponse{
+		AccountID:      account.ExternalID,
+		Currency:       account.Currency,
+		OpeningBalance: account.OpeningBalance,
+		CurrentBalance: balance,
+		Reconciled:     true,
+		Statements:     make([]AccountStatementResponse, 0, len(statements)),
+	}
+	for _, statement := range statements {
+		response.Reconciled = response.Reconciled && statement.IsReconciled()
+		response.Statements = append(response.Statements, AccountStatementResponse{
+			DocumentID:      statement.DocumentExternalID,
+			StartDate:       statement.StartDate,
+			EndDate:         statement.EndDate,
+			OpeningBalance:  statement.OpeningBalance,
+			ClosingBalance:  statement.ClosingBalance,
+			ExpectedBalance: statement.ExpectedBalance,
+			Difference:      statement.Difference,
+			Status:          string(statement.Status),
+		})
+	}
+	return response
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// CreateAPIKeyRequest representa os dados para criar uma chave de API
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100" example:"Script de upload"`                  // Nome para identificar a chave
+	Scopes    []string   `json:"scopes" binding:"required,min=1" example:"documents:write,transactions:read"` // Escopos concedidos à chave
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2027-01-01T00:00:00Z"`                         // Data de expiração (opcional; sem expiração quando ausente)
+}
+
+// APIKeyResponse representa uma chave de API retornada pela API, sem o seu valor
+type APIKeyResponse struct {
+	ID         uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`     // ID externo da chave
+	Name       string     `json:"name" example:"Script de upload"`                       // Nome da chave
+	Prefix     string     `json:"prefix" example:"fa_q1w2e3r4"`                          // Início da chave, para identificá-la
+	Scopes     []string   `json:"scopes" example:"documents:write,transactions:read"`    // Escopos concedidos
+	ExpiresAt  *time.Time `json:"expires_at,omitempty" example:"2027-01-01T00:00:00Z"`   // Data de expiração
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" example:"2026-01-01T00:00:00Z"` // Data do último uso
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" example:"2026-01-01T00:00:00Z"`   // Data de revogação
+	CreatedAt  time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`             // Data de criação
+}
+
+// CreatedAPIKeyResponse representa a chave recém-criada; o valor da chave só é exibido nesta resposta
+type CreatedAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key" example:"fa_q1w2e3r4t5y6u7i8o9p0a1s2d3f4g5h6j7k8l9z0x1c"` // Valor da chave, a ser enviado no cabeçalho "Authorization: ApiKey <chave>"
+}
+
+// APIKeyFromEntity converte uma entidade APIKey para DTO
+func APIKeyFromEntity(key *entity.APIKey) APIKeyResponse {
+	return APIKeyResponse{
+		ID:         key.ExternalID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+// LoginRequest representa as credenciais enviadas no login
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email" example:"joao.silva@example.com"` // Email do usuário
+	Password string `json:"password" binding:"required" example:"s3nh@Segura"`               // Senha do usuário
+}
+
+// RefreshTokenRequest representa o refresh token enviado na renovação e no logout
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required" example:"550e8400-e29b-41d4-a716-446655440000.q1w2e3r4t5y6"` // Refresh token recebido no login ou na última renovação
+}
+
+// ChangePasswordRequest representa os dados para alterar a senha do usuário autenticado
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required" example:"s3nh@Segura"`           // Senha atual
+	NewPassword     string `json:"new_password" binding:"required,min=8,max=72" example:"n0v@Senha123"` // Nova senha (8 a 72 caracteres)
+}
+
+// TokenResponse representa os tokens emitidos no login e na renovação
+type TokenResponse struct {
+	AccessToken  string `json:"access_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`            // Access token (JWT) a ser enviado no cabeçalho Authorization
+	TokenType    string `json:"token_type" example:"Bearer"`                                               // Tipo do token
+	ExpiresIn    int    `json:"expires_in" example:"900"`                                                  // Validade do access token em segundos
+	RefreshToken string `json:"refresh_token" example:"550e8400-e29b-41d4-a716-446655440000.q1w2e3r4t5y6"` // Refresh token de uso único para obter novos tokens
+}
+
+// NewTokenResponse monta a resposta com os tokens emitidos
+func NewTokenResponse(accessToken string, accessTokenExpiresAt time.Time, refreshToken string) TokenResponse {
+	return TokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(time.Until(accessTokenExpiresAt).Round(time.Second).Seconds()),
+		RefreshToken: refreshToken,
+	}
+}
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// CategoryRequest representa os dados para criar ou atualizar uma categoria
+type CategoryRequest struct {
+	Name     string     `json:"name" binding:"required,max=60" example:"Restaurantes"`              // Nome da categoria
+	ParentID *uuid.UUID `json:"parent_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"` // Categoria pai, padrão ou do usuário (opcional)
+	Icon     string     `json:"icon,omitempty" binding:"max=50" example:"utensils"`                 // Nome do ícone ou emoji (opcional)
+	Color    string     `json:"color,omitempty" example:"#F97316"`                                  // Cor no formato #RRGGBB (opcional)
+}
+
+// CategoryResponse representa uma categoria retornada pela API
+type CategoryResponse struct {
+	ID        uuid.UUID          `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`                  // ID externo da categoria
+	ParentID  *uuid.UUID         `json:"parent_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"` // Categoria pai
+	Name      string             `json:"name" example:"Restaurantes"`                                        // Nome da categoria
+	Icon      string             `json:"icon,omitempty" example:"utensils"`                                  // Ícone da categoria
+	Color     string             `json:"color,omitempty" example:"#F97316"`                                  // Cor da categoria
+	System    bool               `json:"system" example:"false"`                                             // Se é uma categoria padrão, que não pode ser alterada
+	Children  []CategoryResponse `json:"children,omitempty"`                                                 // Subcategorias (apenas na listagem)
+	CreatedAt time.Time          `json:"created_at" example:"2023-01-01T00:00:00Z"`                          // Data de criação
+	UpdatedAt time.Time          `json:"updated_at" example:"2023-01-01T00:00:00Z"`                          // Data de última atualização
+}
+
+// CategorySummaryResponse identifica a categoria de um documento ou transação
+type CategorySummaryResponse struct {
+	ID   uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo da categoria
+	Name string    `json:"name" example:"Alimentação"`                        // Nome da categoria
+}
+
+// CategoryFromEntity converte uma entidade Category para DTO
+func CategoryFromEntity(category *entity.Category) CategoryResponse {
+	response := CategoryResponse{
+		ID:        category.ExternalID,
+		Name:      category.Name,
+		Icon:      category.Icon,
+		Color:     category.Color,
+		System:    category.IsSystem(),
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+	if category.ParentID != 0 {
+		parentID := category.ParentExternalID
+		response.ParentID = &parentID
+	}
+	return response
+}
+
+// CategoryTreeFromEntities monta a árvore de categorias, com as subcategorias
+// dentro das categorias de primeiro nível
+func CategoryTreeFromEntities(categories []*entity.Category) []CategoryResponse {
+	tree := []CategoryResponse{}
+	positions := make(map[int64]int)
+	for _, category := range categories {
+		if category.ParentID == 0 {
+			positions[category.ID] = len(tree)
+			tree = append(tree, CategoryFromEntity(category))
+		}
+	}
+	for _, category := range categories {
+		if position, ok := positions[category.ParentID]; ok {
+			tree[position].Children = append(tree[position].Children, CategoryFromEntity(category))
+		}
+	}
+	return tree
+}
+
+// CategorySummariesFromEntities converte as categorias de um documento para DTO
+func CategorySummariesFromEntities(categories []*entity.Category) []CategorySummaryResponse {
+	summaries := make([]CategorySummaryResponse, len(categories))
+	for i, category := range categories {
+		summaries[i] = CategorySummaryResponse{ID: category.ExternalID, Name: category.Name}
+	}
+	return summaries
+}
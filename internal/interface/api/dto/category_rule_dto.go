@@ -0,0 +1,127 @@
+package dto
+
+import (
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// CategoryRuleConditionsDTO representa as condições de uma regra; todas as preenchidas precisam ser atendidas
+type CategoryRuleConditionsDTO struct {
+	DescriptionContains string `json:"description_contains,omitempty" example:"ifood"`            // Trecho da descrição, sem diferenciar maiúsculas
+	DescriptionRegex    string `json:"description_regex,omitempty" example:"uber|99 ?pop"`        // Expressão regular aplicada à descrição, sem diferenciar maiúsculas
+	Counterparty        string `json:"counterparty,omitempty" example:"iFood"`                    // Trecho da contraparte
+	SourceAccount       string `json:"source_account,omitempty" example:"0001/12345-6"`           // Conta de origem exata
+	MinAmount           *int64 `json:"min_amount,omitempty" example:"-10000"`                     // Valor mínimo em centavos, inclusivo
+	MaxAmount           *int64 `json:"max_amount,omitempty" example:"-1"`                         // Valor máximo em centavos, inclusivo
+	DayOfMonth          int    `json:"day_of_month,omitempty" binding:"min=0,max=31" example:"5"` // Dia do mês da transação
+}
+
+// CategoryRuleRequest representa os dados para criar ou atualizar uma regra de categorização
+type CategoryRuleRequest struct {
+	Name       string                    `json:"name" binding:"required,max=100" example:"Delivery"`                            // Nome da regra
+	CategoryID uuid.UUID                 `json:"category_id" binding:"required" example:"550e8400-e29b-41d4-a716-446655440000"` // Categoria atribuída, padrão ou do usuário
+	Priority   int                       `json:"priority" binding:"min=0,max=1000000" example:"10"`                             // Regras de menor prioridade são avaliadas primeiro
+	Enabled    *bool                     `json:"enabled,omitempty" example:"true"`                                              // Se a regra está habilitada (padrão: true)
+	Conditions CategoryRuleConditionsDTO `json:"conditions" binding:"required"`                                                 // Condições da regra
+}
+
+// CategoryRuleTestRequest representa uma simulação das regras sobre o histórico de transações
+type CategoryRuleTestRequest struct {
+	Rule  *CategoryRuleRequest `json:"rule,omitempty"`                                       // Regra candidata, ainda não salva, incluída na simulação (opcional)
+	Limit int                  `json:"limit,omitempty" binding:"min=0,max=500" example:"50"` // Número máximo de alterações listadas (padrão: 50)
+}
+
+// CategoryRuleResponse representa uma regra de categorização retornada pela API
+type CategoryRuleResponse struct {
+	ID         uuid.UUID                 `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`          // ID externo da regra
+	Name       string                    `json:"name" example:"Delivery"`                                    // Nome da regra
+	CategoryID uuid.UUID                 `json:"category_id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo da categoria atribuída
+	Category   string                    `json:"category" example:"Alimentação"`                             // Nome da categoria atribuída
+	Priority   int                       `json:"priority" example:"10"`                                      // Prioridade de avaliação
+	Enabled    bool                      `json:"enabled" example:"true"`                                     // Se a regra está habilitada
+	Conditions CategoryRuleConditionsDTO `json:"conditions"`                                                 // Condições da regra
+	CreatedAt  time.Time                 `json:"created_at" example:"2023-01-01T00:00:00Z"`                  // Data de criação
+	UpdatedAt  time.Time                 `json:"updated_at" example:"2023-01-01T00:00:00Z"`                  // Data de última atualização
+}
+
+// CategoryChangeResponse representa a categoria que uma regra atribui a uma transação
+type CategoryChangeResponse struct {
+	TransactionID    uuid.UUID  `json:"transaction_id" example:"550e8400-e29b-41d4-a716-446655440000"`    // ID externo da transação
+	Date             string     `json:"date" example:"2023-01-15"`                                        // Data da transação (AAAA-MM-DD)
+	Amount           int64      `json:"amount" example:"-4590"`                                           // Valor em centavos
+	Description      string     `json:"description" example:"IFOOD *RESTAURANTE"`                         // Descrição da transação
+	PreviousCategory string     `json:"previous_category,omitempty" example:"Outros"`                     // Categoria atual
+	Category         string     `json:"category" example:"Alimentação"`                                   // Categoria atribuída pela regra
+	RuleID           *uuid.UUID `json:"rule_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"` // Regra salva do usuário (ausente para regras padrão e candidatas)
+	RuleName         string     `json:"rule_name" example:"Delivery"`                                     // Nome da regra aplicada
+}
+
+// CategorizationReportResponse resume a aplicação das regras ao histórico de transações
+type CategorizationReportResponse struct {
+	Evaluated int                      `json:"evaluated" example:"120"` // Transações avaliadas
+	Matched   int                      `json:"matched" example:"85"`    // Transações atendidas por alguma regra
+	Changed   int                      `json:"changed" example:"12"`    // Transações cuja categoria muda
+	Changes   []CategoryChangeResponse `json:"changes,omitempty"`       // Amostra das alterações (apenas na simulação)
+}
+
+// ToEntity converte as condições da requisição para a entidade
+func (c CategoryRuleConditionsDTO) ToEntity() entity.CategoryRuleConditions {
+	return entity.CategoryRuleConditions{
+		DescriptionContains: c.DescriptionContains,
+		DescriptionRegex:    c.DescriptionRegex,
+		Counterparty:        c.Counterparty,
+		SourceAccount:       c.SourceAccount,
+		MinAmount:           c.MinAmount,
+		MaxAmount:           c.MaxAmount,
+		DayOfMonth:          c.DayOfMonth,
+	}
+}
+
+// IsEnabled indica se a regra deve ficar habilitada; ausente, vale true
+func (r *CategoryRuleRequest) IsEnabled() bool {
+	return r.Enabled == nil || *r.Enabled
+}
+
+// CategoryRuleFromEntity converte uma entidade CategoryRule para DTO
+func CategoryRuleFromEntity(rule *entity.CategoryRule) CategoryRuleResponse {
+	return CategoryRuleResponse{
+		ID:         rule.ExternalID,
+		Name:       rule.Name,
+		CategoryID: rule.CategoryExternalID,
+		Category:   rule.Category,
+		Priority:   rule.Priority,
+		Enabled:    rule.Enabled,
+		Conditions: CategoryRuleConditionsDTO{
+			DescriptionContains: rule.DescriptionContains,
+			DescriptionRegex:    rule.DescriptionRegex,
+			Counterparty:        rule.Counterparty,
+			SourceAccount:       rule.SourceAccount,
+			MinAmount:           rule.MinAmount,
+			MaxAmount:           rule.MaxAmount,
+			DayOfMonth:          rule.DayOfMonth,
+		},
+		CreatedAt: rule.CreatedAt,
+		UpdatedAt: rule.UpdatedAt,
+	}
+}
+
+// CategoryChangeFromEntity converte a alteração de categoria de uma transação para DTO
+func CategoryChangeFromEntity(transaction *entity.Transaction, rule *entity.CategoryRule, previousCategory string) CategoryChangeResponse {
+	response := CategoryChangeResponse{
+		TransactionID:    transaction.ExternalID,
+		Date:             transaction.Date.Format("2006-01-02"),
+		Amount:           transaction.Amount,
+		Description:      transaction.Description,
+		PreviousCategory: previousCategory,
+		Category:         rule.Category,
+		RuleName:         rule.Name,
+	}
+	// Regras padrão e candidatas não estão salvas
+	if rule.ID != 0 {
+		ruleID := rule.ExternalID
+		response.RuleID = &ruleID
+	}
+	return response
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"encoding/base64"
 	"finance-assistant/internal/domain/entity"
 	"time"
 
@@ -11,37 +12,53 @@ import (
 // @Description Dados do formulário para upload de um novo documento
 type DocumentUploadRequest struct {
 	DocumentType string   `form:"document_type" binding:"required" example:"bank_statement"` // Tipo de documento
-	Categories   []string `form:"categories" example:"banco,mensal"`                         // Categorias para classificação (opcional)
+	Categories   []string `form:"categories" example:"550e8400-e29b-41d4-a716-446655440000"` // IDs das categorias do documento (opcional)
+	// Perfil de importação para arquivos CSV (opcional; se ausente o layout é detectado automaticamente)
+	ImportProfile string `form:"import_profile" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Família com que o documento é compartilhado (opcional)
+	Household string `form:"household" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Conta do usuário a que o documento pertence (opcional; se ausente é identificada pelos dados do extrato)
+	AccountID string `form:"account_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Aceita o documento mesmo que o mesmo conteúdo já tenha sido enviado pelo usuário (opcional)
+	Force bool `form:"force" example:"false"`
 	// O arquivo é enviado via multipart/form-data com o campo "file"
 }
 
 // DocumentResponse representa os dados retornados pela API
 // @Description Informações de um documento armazenado
 type DocumentResponse struct {
-	ID           uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo do documento
-	DocumentType string    `json:"document_type" example:"bank_statement"`            // Tipo de documento
-	Filename     string    `json:"filename" example:"extrato_janeiro.pdf"`            // Nome do arquivo
-	ContentType  string    `json:"content_type" example:"application/pdf"`            // Tipo MIME do arquivo
-	FileSize     int       `json:"file_size" example:"125000"`                        // Tamanho aproximado do arquivo em bytes
-	Categories   []string  `json:"categories" example:"[\"banco\",\"mensal\"]"`       // Categorias do documento
-	Status       string    `json:"status" example:"processing"`                       // Status de processamento (pending, processing, processed, failed)
-	CreatedAt    time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`         // Data de criação
-	UpdatedAt    time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`         // Data de última atualização
+	ID               uuid.UUID                 `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`                                 // ID externo do documento
+	DocumentType     string                    `json:"document_type" example:"bank_statement"`                                            // Tipo de documento
+	Filename         string                    `json:"filename" example:"extrato_janeiro.pdf"`                                            // Nome do arquivo
+	ContentType      string                    `json:"content_type" example:"application/pdf"`                                            // Tipo MIME do arquivo
+	FileSize         int64                     `json:"file_size" example:"125000"`                                                        // Tamanho do arquivo em bytes
+	SHA256           string                    `json:"sha256" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"` // Hash SHA-256 do conteúdo
+	DuplicateAllowed bool                      `json:"duplicate_allowed" example:"false"`                                                 // Indica que o documento repete um conteúdo já enviado e foi aceito com force=true
+	Categories       []CategorySummaryResponse `json:"categories"`                                                                        // Categorias do documento
+	Status           string                    `json:"status" example:"processing"`                                                       // Status de processamento (pending, processing, processed, failed, unreconciled)
+	HouseholdID      *uuid.UUID                `json:"household_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`             // Família com que o documento é compartilhado
+	AccountID        *uuid.UUID                `json:"account_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`               // Conta do usuário a que o documento pertence
+	CreatedAt        time.Time                 `json:"created_at" example:"2023-01-01T00:00:00Z"`                                         // Data de criação
+	UpdatedAt        time.Time                 `json:"updated_at" example:"2023-01-01T00:00:00Z"`                                         // Data de última atualização
 }
 
 // DocumentDetailResponse representa os dados detalhados do documento, incluindo o conteúdo
 // @Description Informações detalhadas de um documento, incluindo seu conteúdo
 type DocumentDetailResponse struct {
-	ID           uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`          // ID externo do documento
-	DocumentType string    `json:"document_type" example:"bank_statement"`                     // Tipo de documento
-	Filename     string    `json:"filename" example:"extrato_janeiro.pdf"`                     // Nome do arquivo
-	ContentType  string    `json:"content_type" example:"application/pdf"`                     // Tipo MIME do arquivo
-	FileSize     int       `json:"file_size" example:"125000"`                                 // Tamanho aproximado do arquivo em bytes
-	FileContent  string    `json:"file_content" example:"JVBERi0xLjUKJYCBgoMKMSAwIG9iago8..."` // Conteúdo do arquivo em Base64
-	Categories   []string  `json:"categories" example:"[\"banco\",\"mensal\"]"`                // Categorias do documento
-	Status       string    `json:"status" example:"processing"`                                // Status de processamento (pending, processing, processed, failed)
-	CreatedAt    time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`                  // Data de criação
-	UpdatedAt    time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`                  // Data de última atualização
+	ID               uuid.UUID                 `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`                                 // ID externo do documento
+	DocumentType     string                    `json:"document_type" example:"bank_statement"`                                            // Tipo de documento
+	Filename         string                    `json:"filename" example:"extrato_janeiro.pdf"`                                            // Nome do arquivo
+	ContentType      string                    `json:"content_type" example:"application/pdf"`                                            // Tipo MIME do arquivo
+	FileSize         int64                     `json:"file_size" example:"125000"`                                                        // Tamanho do arquivo em bytes
+	SHA256           string                    `json:"sha256" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"` // Hash SHA-256 do conteúdo
+	FileContent      string                    `json:"file_content" example:"JVBERi0xLjUKJYCBgoMKMSAwIG9iago8..."`                        // Conteúdo do arquivo em Base64
+	DuplicateAllowed bool                      `json:"duplicate_allowed" example:"false"`                                                 // Indica que o documento repete um conteúdo já enviado e foi aceito com force=true
+	Categories       []CategorySummaryResponse `json:"categories"`                                                                        // Categorias do documento
+	Status           string                    `json:"status" example:"processing"`                                                       // Status de processamento (pending, processing, processed, failed, unreconciled)
+	HouseholdID      *uuid.UUID                `json:"household_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`             // Família com que o documento é compartilhado
+	AccountID        *uuid.UUID                `json:"account_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`               // Conta do usuário a que o documento pertence
+	CreatedAt        time.Time                 `json:"created_at" example:"2023-01-01T00:00:00Z"`                                         // Data de criação
+	UpdatedAt        time.Time                 `json:"updated_at" example:"2023-01-01T00:00:00Z"`                                         // Data de última atualização
 }
 
 // DocumentListResponse representa a resposta de uma listagem paginada de documentos
@@ -53,53 +70,70 @@ type DocumentListResponse struct {
 	Limit     int                `json:"limit" example:"10"` // Limite de itens por página
 }
 
+// DocumentConflictResponse representa a resposta para o envio de um arquivo já enviado pelo usuário
+// @Description Erro de documento duplicado, com o ID do documento existente
+type DocumentConflictResponse struct {
+	Error              string    `json:"error" example:"Documento com o mesmo conteúdo já enviado"`           // Mensagem de erro
+	ExistingDocumentID uuid.UUID `json:"existing_document_id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo do documento existente
+}
+
 // DocumentStatusUpdateRequest representa a requisição para atualizar o status de um documento
 // @Description Requisição para mudar o status de um documento
 type DocumentStatusUpdateRequest struct {
-	Status string `json:"status" binding:"required" example:"processed" enums:"pending,processing,processed,failed"` // Novo status do documento
+	Status string `json:"status" binding:"required" example:"processed" enums:"pending,processing,processed,failed,unreconciled"` // Novo status do documento
 }
 
 // DocumentFromEntity converte uma entidade Document para DocumentResponse
 func DocumentFromEntity(document *entity.Document) DocumentResponse {
-	// Calcular tamanho do arquivo a partir do conteúdo base64
-	fileSize := 0
-	if document.FileContent != "" {
-		// Estimar tamanho do arquivo a partir do base64
-		// A string base64 é aproximadamente 33% maior que o binário original
-		fileSize = len(document.FileContent) * 3 / 4
+	return DocumentResponse{
+		ID:               document.ExternalID,
+		DocumentType:     document.DocumentType,
+		Filename:         document.Filename,
+		ContentType:      document.ContentType,
+		FileSize:         document.Size,
+		SHA256:           document.SHA256,
+		DuplicateAllowed: document.DuplicateAllowed,
+		Categories:       CategorySummariesFromEntities(document.Categories),
+		Status:           string(document.Status),
+		HouseholdID:      householdID(document),
+		AccountID:        accountID(document),
+		CreatedAt:        document.CreatedAt,
+		UpdatedAt:        document.UpdatedAt,
 	}
+}
 
-	return DocumentResponse{
-		ID:           document.ExternalID,
-		DocumentType: document.DocumentType,
-		Filename:     document.Filename,
-		ContentType:  document.ContentType,
-		FileSize:     fileSize,
-		Categories:   document.Categories,
-		Status:       string(document.Status),
-		CreatedAt:    document.CreatedAt,
-		UpdatedAt:    document.UpdatedAt,
+// DocumentDetailFromEntity converte uma entidade Document e seu conteúdo para DocumentDetailResponse
+func DocumentDetailFromEntity(document *entity.Document, content []byte) DocumentDetailResponse {
+	return DocumentDetailResponse{
+		ID:               document.ExternalID,
+		DocumentType:     document.DocumentType,
+		Filename:         document.Filename,
+		ContentType:      document.ContentType,
+		FileSize:         document.Size,
+		SHA256:           document.SHA256,
+		DuplicateAllowed: document.DuplicateAllowed,
+		FileContent:      base64.StdEncoding.EncodeToString(content),
+		Categories:       CategorySummariesFromEntities(document.Categories),
+		Status:           string(document.Status),
+		HouseholdID:      householdID(document),
+		AccountID:        accountID(document),
+		CreatedAt:        document.CreatedAt,
+		UpdatedAt:        document.UpdatedAt,
 	}
 }
 
-// DocumentDetailFromEntity converte uma entidade Document para DocumentDetailResponse
-func DocumentDetailFromEntity(document *entity.Document) DocumentDetailResponse {
-	// Calcular tamanho do arquivo
-	fileSize := 0
-	if document.FileContent != "" {
-		fileSize = len(document.FileContent) * 3 / 4
+// accountID retorna o ID externo da conta do documento, ou nil quando não identificada
+func accountID(document *entity.Document) *uuid.UUID {
+	if document.AccountID == 0 {
+		return nil
 	}
+	return &document.AccountExternalID
+}
 
-	return DocumentDetailResponse{
-		ID:           document.ExternalID,
-		DocumentType: document.DocumentType,
-		Filename:     document.Filename,
-		ContentType:  document.ContentType,
-		FileSize:     fileSize,
-		FileContent:  document.FileContent,
-		Categories:   document.Categories,
-		Status:       string(document.Status),
-		CreatedAt:    document.CreatedAt,
-		UpdatedAt:    document.UpdatedAt,
+// householdID retorna o ID externo da família do documento, ou nil para documentos pessoais
+func householdID(document *entity.Document) *uuid.UUID {
+	if document.HouseholdID == 0 {
+		return nil
 	}
+	return &document.HouseholdExternalID
 }
@@ -0,0 +1,106 @@
+package dto
+
+import (
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// HouseholdRequest representa os dados para criar uma família
+type HouseholdRequest struct {
+	Name string `json:"name" binding:"required,max=100" example:"Família Silva"` // Nome da família
+}
+
+// HouseholdMemberRoleRequest representa a alteração do papel de um membro
+type HouseholdMemberRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=owner member viewer" example:"member" enums:"owner,member,viewer"` // Novo papel na família
+}
+
+// HouseholdInvitationRequest representa um convite para a família
+type HouseholdInvitationRequest struct {
+	Email string `json:"email" binding:"required,email" example:"maria.silva@example.com"`                               // Email do convidado
+	Role  string `json:"role" binding:"required,oneof=owner member viewer" example:"member" enums:"owner,member,viewer"` // Papel oferecido na família
+}
+
+// HouseholdResponse representa uma família retornada pela API
+type HouseholdResponse struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo da família
+	Name      string    `json:"name" example:"Família Silva"`                      // Nome da família
+	CreatedAt time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`         // Data de criação
+	UpdatedAt time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`         // Data de última atualização
+}
+
+// HouseholdMemberResponse representa um membro da família
+type HouseholdMemberResponse struct {
+	UserID   uuid.UUID `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo do usuário
+	Name     string    `json:"name" example:"João Silva"`                              // Nome do usuário
+	Email    string    `json:"email" example:"joao.silva@example.com"`                 // Email do usuário
+	Role     string    `json:"role" example:"owner" enums:"owner,member,viewer"`       // Papel na família
+	JoinedAt time.Time `json:"joined_at" example:"2023-01-01T00:00:00Z"`               // Data de entrada na família
+}
+
+// HouseholdDetailResponse representa uma família com os seus membros
+type HouseholdDetailResponse struct {
+	HouseholdResponse
+	Members []HouseholdMemberResponse `json:"members"` // Membros da família
+}
+
+// HouseholdInvitationResponse representa um convite para a família
+type HouseholdInvitationResponse struct {
+	ID            uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`                  // ID externo do convite
+	HouseholdID   uuid.UUID `json:"household_id" example:"550e8400-e29b-41d4-a716-446655440000"`        // ID externo da família
+	HouseholdName string    `json:"household_name" example:"Família Silva"`                             // Nome da família
+	Email         string    `json:"email" example:"maria.silva@example.com"`                            // Email do convidado
+	Role          string    `json:"role" example:"member" enums:"owner,member,viewer"`                  // Papel oferecido na família
+	Status        string    `json:"status" example:"pending" enums:"pending,accepted,declined,revoked"` // Situação do convite
+	ExpiresAt     time.Time `json:"expires_at" example:"2023-01-08T00:00:00Z"`                          // Data de expiração do convite
+	CreatedAt     time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`                          // Data de criação
+}
+
+// HouseholdFromEntity converte uma entidade Household para DTO
+func HouseholdFromEntity(household *entity.Household) HouseholdResponse {
+	return HouseholdResponse{
+		ID:        household.ExternalID,
+		Name:      household.Name,
+		CreatedAt: household.CreatedAt,
+		UpdatedAt: household.UpdatedAt,
+	}
+}
+
+// HouseholdMemberFromEntity converte uma entidade HouseholdMember para DTO
+func HouseholdMemberFromEntity(member *entity.HouseholdMember) HouseholdMemberResponse {
+	return HouseholdMemberResponse{
+		UserID:   member.UserExternalID,
+		Name:     member.UserName,
+		Email:    member.UserEmail,
+		Role:     string(member.Role),
+		JoinedAt: member.CreatedAt,
+	}
+}
+
+// HouseholdDetailFromEntity converte a família e os seus membros para DTO
+func HouseholdDetailFromEntity(household *entity.Household, members []*entity.HouseholdMember) HouseholdDetailResponse {
+	response := HouseholdDetailResponse{
+		HouseholdResponse: HouseholdFromEntity(household),
+		Members:           make([]HouseholdMemberResponse, len(members)),
+	}
+	for i, member := range members {
+		response.Members[i] = HouseholdMemberFromEntity(member)
+	}
+	return response
+}
+
+// HouseholdInvitationFromEntity converte uma entidade HouseholdInvitation para DTO
+func HouseholdInvitationFromEntity(invitation *entity.HouseholdInvitation) HouseholdInvitationResponse {
+	return HouseholdInvitationResponse{
+		ID:            invitation.ExternalID,
+		HouseholdID:   invitation.HouseholdExternalID,
+		HouseholdName: invitation.HouseholdName,
+		Email:         invitation.Email,
+		Role:          string(invitation.Role),
+		Status:        string(invitation.Status),
+		ExpiresAt:     invitation.ExpiresAt,
+		CreatedAt:     invitation.CreatedAt,
+	}
+}
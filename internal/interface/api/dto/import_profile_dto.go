@@ -0,0 +1,135 @@
+package dto
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/pkg/csvimport"
+	"github.com/google/uuid"
+)
+
+// ImportColumnsDTO mapeia os campos da transação para colunas do arquivo
+// @Description Mapeamento de colunas; cada valor é o nome da coluna no cabeçalho ou sua posição (a partir de 1)
+type ImportColumnsDTO struct {
+	Date         string `json:"date" binding:"required" example:"Data"`             // Coluna da data
+	Description  string `json:"description" binding:"required" example:"Histórico"` // Coluna da descrição
+	Amount       string `json:"amount,omitempty" example:"Valor"`                   // Coluna do valor com sinal
+	Debit        string `json:"debit,omitempty" example:"Débito"`                   // Coluna de débitos (quando separada)
+	Credit       string `json:"credit,omitempty" example:"Crédito"`                 // Coluna de créditos (quando separada)
+	Counterparty string `json:"counterparty,omitempty" example:"Favorecido"`        // Coluna da contraparte (opcional)
+	Category     string `json:"category,omitempty" example:"Categoria"`             // Coluna da categoria (opcional)
+	Balance      string `json:"balance,omitempty" example:"Saldo"`                  // Coluna do saldo (opcional)
+}
+
+// ImportProfileRequest representa os dados enviados para criar/atualizar um perfil de importação
+// @Description Layout de um arquivo CSV de extrato
+type ImportProfileRequest struct {
+	Name             string           `json:"name" binding:"required" example:"Itaú conta corrente"`                 // Nome do perfil
+	Delimiter        string           `json:"delimiter" binding:"required" example:";"`                              // Delimitador de colunas (, ; | ou tab)
+	Encoding         string           `json:"encoding" example:"windows-1252" enums:"utf-8,windows-1252,iso-8859-1"` // Codificação do arquivo (padrão: utf-8)
+	DateFormat       string           `json:"date_format" binding:"required" example:"dd/mm/yyyy"`                   // Formato das datas
+	DecimalSeparator string           `json:"decimal_separator" binding:"required" example:"," enums:",,."`          // Separador decimal
+	HasHeader        bool             `json:"has_header" example:"true"`                                             // Se o arquivo possui linha de cabeçalho
+	SkipRows         int              `json:"skip_rows" binding:"min=0" example:"0"`                                 // Linhas ignoradas antes do cabeçalho/dados
+	Columns          ImportColumnsDTO `json:"columns" binding:"required"`                                            // Mapeamento de colunas
+	Currency         string           `json:"currency,omitempty" example:"BRL"`                                      // Moeda das transações (padrão: BRL)
+}
+
+// ImportProfileResponse representa um perfil de importação retornado pela API
+// @Description Perfil de importação de arquivos CSV
+type ImportProfileResponse struct {
+	ID               uuid.UUID        `json:"id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo do perfil (ausente em sugestões)
+	Name             string           `json:"name" example:"Itaú conta corrente"`                          // Nome do perfil
+	Delimiter        string           `json:"delimiter" example:";"`                                       // Delimitador de colunas
+	Encoding         string           `json:"encoding" example:"windows-1252"`                             // Codificação do arquivo
+	DateFormat       string           `json:"date_format" example:"dd/mm/yyyy"`                            // Formato das datas
+	DecimalSeparator string           `json:"decimal_separator" example:","`                               // Separador decimal
+	HasHeader        bool             `json:"has_header" example:"true"`                                   // Se o arquivo possui cabeçalho
+	SkipRows         int              `json:"skip_rows" example:"0"`                                       // Linhas ignoradas
+	Columns          ImportColumnsDTO `json:"columns"`                                                     // Mapeamento de colunas
+	Currency         string           `json:"currency" example:"BRL"`                                      // Moeda das transações
+	CreatedAt        *time.Time       `json:"created_at,omitempty" example:"2023-01-01T00:00:00Z"`         // Data de criação
+	UpdatedAt        *time.Time       `json:"updated_at,omitempty" example:"2023-01-01T00:00:00Z"`         // Data de última atualização
+}
+
+// ImportProfileListResponse representa a resposta de uma listagem paginada de perfis
+// @Description Lista paginada de perfis de importação
+type ImportProfileListResponse struct {
+	ImportProfiles []ImportProfileResponse `json:"import_profiles"`    // Lista de perfis
+	Total          int                     `json:"total" example:"3"`  // Número total de perfis
+	Page           int                     `json:"page" example:"1"`   // Página atual
+	Limit          int                     `json:"limit" example:"10"` // Limite de itens por página
+}
+
+// ToLayout converte a requisição para o layout usado pelo leitor de CSV
+func (r *ImportProfileRequest) ToLayout() *csvimport.Layout {
+	encoding := r.Encoding
+	if encoding == "" {
+		encoding = csvimport.EncodingUTF8
+	}
+
+	layout := &csvimport.Layout{
+		Encoding:   encoding,
+		DateFormat: r.DateFormat,
+		HasHeader:  r.HasHeader,
+		SkipRows:   r.SkipRows,
+		Columns: csvimport.Columns{
+			Date:         r.Columns.Date,
+			Description:  r.Columns.Description,
+			Amount:       r.Columns.Amount,
+			Debit:        r.Columns.Debit,
+			Credit:       r.Columns.Credit,
+			Counterparty: r.Columns.Counterparty,
+			Category:     r.Columns.Category,
+			Balance:      r.Columns.Balance,
+		},
+	}
+
+	// Aceitar "\t" literal como tabulação
+	delimiter := r.Delimiter
+	if delimiter == `\t` || delimiter == "tab" {
+		delimiter = "\t"
+	}
+	if d, size := utf8.DecodeRuneInString(delimiter); size == len(delimiter) {
+		layout.Delimiter = d
+	}
+	if len(r.DecimalSeparator) == 1 {
+		layout.DecimalSeparator = r.DecimalSeparator[0]
+	}
+	return layout
+}
+
+// ImportProfileFromEntity converte uma entidade ImportProfile para ImportProfileResponse
+func ImportProfileFromEntity(profile *entity.ImportProfile) ImportProfileResponse {
+	response := ImportProfileResponse{
+		ID:               profile.ExternalID,
+		Name:             profile.Name,
+		Delimiter:        profile.Delimiter,
+		Encoding:         profile.Encoding,
+		DateFormat:       profile.DateFormat,
+		DecimalSeparator: profile.DecimalSeparator,
+		HasHeader:        profile.HasHeader,
+		SkipRows:         profile.SkipRows,
+		Columns: ImportColumnsDTO{
+			Date:         profile.Columns.Date,
+			Description:  profile.Columns.Description,
+			Amount:       profile.Columns.Amount,
+			Debit:        profile.Columns.Debit,
+			Credit:       profile.Columns.Credit,
+			Counterparty: profile.Columns.Counterparty,
+			Category:     profile.Columns.Category,
+			Balance:      profile.Columns.Balance,
+		},
+		Currency: profile.Currency,
+	}
+
+	// Sugestões detectadas não são persistidas e não têm ID nem datas
+	if profile.ID == 0 {
+		response.ID = uuid.Nil
+		return response
+	}
+	response.CreatedAt = &profile.CreatedAt
+	response.UpdatedAt = &profile.UpdatedAt
+	return response
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// ProjectedInstallmentResponse representa uma parcela futura prevista
+type ProjectedInstallmentResponse struct {
+	Number  int    `json:"number" example:"4"`            // Número da parcela
+	DueDate string `json:"due_date" example:"2024-04-15"` // Data prevista (AAAA-MM-DD)
+	Amount  int64  `json:"amount" example:"15990"`        // Valor da parcela em centavos
+}
+
+// InstallmentPlanResponse representa uma compra parcelada retornada pela API
+// @Description Compra parcelada com o saldo restante e as parcelas previstas
+type InstallmentPlanResponse struct {
+	ID                    uuid.UUID                      `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo da compra parcelada
+	Description           string                         `json:"description" example:"Loja X"`                      // Nome da compra, sem a parcela
+	SourceAccount         string                         `json:"source_account,omitempty" example:"1234"`           // Conta de origem conforme informada no extrato
+	Currency              string                         `json:"currency" example:"BRL"`                            // Código ISO 4217 da moeda
+	InstallmentAmount     int64                          `json:"installment_amount" example:"15990"`                // Valor de cada parcela em centavos
+	Installments          int                            `json:"installments" example:"10"`                         // Número total de parcelas
+	PaidInstallments      int                            `json:"paid_installments" example:"3"`                     // Última parcela importada
+	RemainingInstallments int                            `json:"remaining_installments" example:"7"`                // Parcelas restantes
+	OutstandingBalance    int64                          `json:"outstanding_balance" example:"111930"`              // Valor das parcelas restantes em centavos
+	EndDate               string                         `json:"end_date" example:"2024-10-15"`                     // Data prevista da última parcela (AAAA-MM-DD)
+	Projected             []ProjectedInstallmentResponse `json:"projected"`                                         // Parcelas restantes previstas, uma por mês
+	ConvertedBalance      *MoneyResponse                 `json:"converted_outstanding_balance,omitempty"`           // Saldo restante convertido para a moeda do relatório, quando informada, pela cotação da data da última parcela importada
+}
+
+// InstallmentPlanFromEntity converte uma entidade InstallmentPlan para InstallmentPlanResponse
+func InstallmentPlanFromEntity(plan *entity.InstallmentPlan) InstallmentPlanResponse {
+	projected := plan.ProjectedInstallments()
+	response := InstallmentPlanResponse{
+		ID:                    plan.ExternalID,
+		Description:           plan.Description,
+		SourceAccount:         plan.SourceAccount,
+		Currency:              plan.Currency,
+		InstallmentAmount:     plan.InstallmentAmount,
+		Installments:          plan.Installments,
+		PaidInstallments:      plan.LastInstallment,
+		RemainingInstallments: plan.RemainingInstallments(),
+		OutstandingBalance:    plan.OutstandingBalance(),
+		EndDate:               plan.EndDate().Format("2006-01-02"),
+		Projected:             make([]ProjectedInstallmentResponse, len(projected)),
+	}
+	for i, installment := range projected {
+		response.Projected[i] = ProjectedInstallmentResponse{
+			Number:  installment.Number,
+			DueDate: installment.DueDate.Format("2006-01-02"),
+			Amount:  installment.Amount,
+		}
+	}
+	return response
+}
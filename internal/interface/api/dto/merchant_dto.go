@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// MerchantSpendingQuery representa o período e a moeda da listagem de gastos por estabelecimento
+type MerchantSpendingQuery struct {
+	From     time.Time `form:"from" time_format:"2006-01-02"` // Data inicial, inclusiva (AAAA-MM-DD)
+	To       time.Time `form:"to" time_format:"2006-01-02"`   // Data final, inclusiva (AAAA-MM-DD)
+	Currency string    `form:"currency"`                      // Moeda do relatório; vazia mantém os totais na moeda de cada transação
+}
+
+// MerchantMergeRequest representa os estabelecimentos mesclados em outro
+type MerchantMergeRequest struct {
+	MerchantIDs []uuid.UUID `json:"merchant_ids" binding:"required,min=1,max=100"` // Estabelecimentos que passam a fazer parte do estabelecimento de destino
+}
+
+// MerchantResponse representa um estabelecimento retornado pela API
+type MerchantResponse struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo do estabelecimento
+	Name      string    `json:"name" example:"Ifood"`                              // Nome do estabelecimento
+	Aliases   []string  `json:"aliases" example:"ifood,ifood agencia"`             // Nomes normalizados reconhecidos nas descrições
+	CreatedAt time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`         // Data de criação
+	UpdatedAt time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`         // Data de última atualização
+}
+
+// MerchantSpendingResponse representa o total das transações de um estabelecimento em uma moeda
+type MerchantSpendingResponse struct {
+	MerchantID   uuid.UUID `json:"merchant_id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo do estabelecimento
+	Name         string    `json:"name" example:"Ifood"`                                       // Nome do estabelecimento
+	Currency     string    `json:"currency" example:"BRL"`                                     // Código ISO 4217 da moeda
+	Transactions int       `json:"transactions" example:"12"`                                  // Número de transações
+	Spent        int64     `json:"spent" example:"45990"`                                      // Soma dos débitos em centavos
+	Received     int64     `json:"received" example:"0"`                                       // Soma dos créditos em centavos, como estornos
+}
+
+// MerchantAssignmentResponse representa o resultado do reconhecimento de estabelecimentos
+type MerchantAssignmentResponse struct {
+	Assigned int `json:"assigned" example:"42"` // Transações associadas a um estabelecimento
+}
+
+// MerchantFromEntity converte uma entidade Merchant para MerchantResponse
+func MerchantFromEntity(merchant *entity.Merchant) MerchantResponse {
+	aliases := merchant.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return MerchantResponse{
+		ID:        merchant.ExternalID,
+		Name:      merchant.Name,
+		Aliases:   aliases,
+		CreatedAt: merchant.CreatedAt,
+		UpdatedAt: merchant.UpdatedAt,
+	}
+}
+
+// MerchantSpendingFromEntities converte os totais por estabelecimento para MerchantSpendingResponse
+func MerchantSpendingFromEntities(spending []*entity.MerchantSpending) []MerchantSpendingResponse {
+	response := make([]MerchantSpendingResponse, len(spending))
+	for i, s := range spending {
+		response[i] = MerchantSpendingResponse{
+			MerchantID:   s.MerchantExternalID,
+			Name:         s.Name,
+			Currency:     s.Currency,
+			Transactions: s.Transactions,
+			Spent:        s.Spent,
+			Received:     s.Received,
+		}
+	}
+	return response
+}
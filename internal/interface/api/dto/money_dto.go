@@ -0,0 +1,17 @@
+package dto
+
+import "finance-assistant/internal/domain/entity"
+
+// MoneyResponse representa um valor monetário em uma moeda
+type MoneyResponse struct {
+	Amount   int64  `json:"amount" example:"20870"` // Valor em unidades mínimas da moeda (centavos, ou ienes para JPY)
+	Currency string `json:"currency" example:"USD"` // Código ISO 4217 da moeda
+}
+
+// MoneyFromEntity converte um entity.Money para MoneyResponse
+func MoneyFromEntity(money entity.Money) MoneyResponse {
+	return MoneyResponse{
+		Amount:   money.Amount,
+		Currency: money.Currency,
+	}
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// SubscriptionResponse representa uma série de débitos recorrentes retornada pela API
+// @Description Assinatura ou conta recorrente com o valor e a data esperados da próxima cobrança
+type SubscriptionResponse struct {
+	ID             uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`                    // ID externo da série
+	MerchantID     *uuid.UUID `json:"merchant_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo do estabelecimento, quando reconhecido
+	Description    string     `json:"description" example:"Netflix"`                                        // Nome do estabelecimento
+	Currency       string     `json:"currency" example:"BRL"`                                               // Código ISO 4217 da moeda
+	Frequency      string     `json:"frequency" example:"monthly"`                                          // Periodicidade: weekly, monthly ou yearly
+	Amount         int64      `json:"amount" example:"4490"`                                                // Valor esperado em centavos (o da última cobrança)
+	PreviousAmount int64      `json:"previous_amount" example:"3990"`                                       // Valor da cobrança anterior em centavos
+	PriceChanged   bool       `json:"price_changed" example:"true"`                                         // O valor mudou na última cobrança
+	Missed         bool       `json:"missed" example:"false"`                                               // A próxima cobrança já deveria ter ocorrido
+	Occurrences    int        `json:"occurrences" example:"6"`                                              // Cobranças encontradas
+	LastDate       string     `json:"last_date" example:"2024-06-15"`                                       // Data da última cobrança (AAAA-MM-DD)
+	NextDate       string     `json:"next_date" example:"2024-07-15"`                                       // Data esperada da próxima cobrança (AAAA-MM-DD)
+	MonthlyCost    int64      `json:"monthly_cost" example:"4490"`                                          // Custo mensal equivalente em centavos
+}
+
+// SubscriptionListResponse representa as séries recorrentes do usuário e o custo mensal total
+type SubscriptionListResponse struct {
+	Subscriptions []SubscriptionResponse `json:"subscriptions"`
+	MonthlyTotal  map[string]int64       `json:"monthly_total" example:"BRL:24480"` // Custo mensal total em unidades mínimas da moeda, por moeda ou na moeda do relatório (cada assinatura pela cotação da sua última cobrança)
+}
+
+// SubscriptionFromEntity converte uma entidade RecurringSeries para SubscriptionResponse
+func SubscriptionFromEntity(series *entity.RecurringSeries, now time.Time) SubscriptionResponse {
+	response := SubscriptionResponse{
+		ID:             series.ExternalID,
+		Description:    series.Description,
+		Currency:       series.Currency,
+		Frequency:      string(series.Frequency),
+		Amount:         series.Amount,
+		PreviousAmount: series.PreviousAmount,
+		PriceChanged:   series.PriceChanged(),
+		Missed:         series.IsMissed(now),
+		Occurrences:    series.Occurrences,
+		LastDate:       series.LastDate.Format("2006-01-02"),
+		NextDate:       series.NextDate.Format("2006-01-02"),
+		MonthlyCost:    series.MonthlyCost(),
+	}
+	if series.MerchantID != 0 {
+		merchantID := series.MerchantExternalID
+		response.MerchantID = &merchantID
+	}
+	return response
+}
@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"math"
+	"time"
+
+	"finance-assistant/internal/domain/categorization"
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// TransactionResponse representa os dados de uma transação retornados pela API
+// @Description Transação financeira extraída de um documento
+type TransactionResponse struct {
+	ID                uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`                            // ID externo da transação
+	DocumentID        uuid.UUID  `json:"document_id" example:"550e8400-e29b-41d4-a716-446655440000"`                   // ID externo do documento de origem
+	AccountID         *uuid.UUID `json:"account_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`          // ID externo da conta do usuário
+	Date              string     `json:"date" example:"2023-01-15"`                                                    // Data da transação (AAAA-MM-DD)
+	Amount            int64      `json:"amount" example:"-4590"`                                                       // Valor em centavos; negativo para débitos
+	Currency          string     `json:"currency" example:"BRL"`                                                       // Código ISO 4217 da moeda
+	Description       string     `json:"description" example:"IFOOD *RESTAURANTE"`                                     // Descrição conforme o extrato
+	Counterparty      string     `json:"counterparty,omitempty" example:"iFood"`                                       // Contraparte da transação
+	CategoryID        *uuid.UUID `json:"category_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`         // ID externo da categoria da transação
+	Category          string     `json:"category,omitempty" example:"Alimentação"`                                     // Nome da categoria da transação
+	CategorySource    string     `json:"category_source,omitempty" example:"rule" enums:"file,rule,suggestion,user"`   // Origem da categoria: arquivo, regra, sugestão ou correção do usuário
+	MerchantID        *uuid.UUID `json:"merchant_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`         // ID externo do estabelecimento reconhecido
+	Merchant          string     `json:"merchant,omitempty" example:"Ifood"`                                           // Nome do estabelecimento reconhecido
+	InstallmentPlanID *uuid.UUID `json:"installment_plan_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo da compra parcelada
+	InstallmentNumber int        `json:"installment_number,omitempty" example:"3"`                                     // Número da parcela
+	Installments      int        `json:"installments,omitempty" example:"10"`                                          // Número total de parcelas da compra
+	TransferID        *uuid.UUID `json:"transfer_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`         // ID externo da transferência entre contas do usuário
+	CreatedAt         time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`                                    // Data de criação
+	UpdatedAt         time.Time  `json:"updated_at" example:"2023-01-01T00:00:00Z"`                                    // Data de última atualização
+}
+
+// TransactionListResponse representa a resposta de uma listagem paginada de transações
+// @Description Lista paginada de transações
+type TransactionListResponse struct {
+	Transactions []TransactionResponse `json:"transactions"`       // Lista de transações
+	Total        int                   `json:"total" example:"42"` // Número total de transações
+	Page         int                   `json:"page" example:"1"`   // Página atual
+	Limit        int                   `json:"limit" example:"10"` // Limite de itens por página
+}
+
+// TransactionCategoryRequest representa a correção da categoria de uma transação
+// @Description Nova categoria da transação
+type TransactionCategoryRequest struct {
+	CategoryID uuid.UUID `json:"category_id" binding:"required" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo da categoria padrão ou do dono da transação
+}
+
+// CategorySuggestionResponse representa uma categoria sugerida para uma transação
+// @Description Categoria sugerida com base nas categorias já informadas pelo usuário
+type CategorySuggestionResponse struct {
+	CategoryID uuid.UUID `json:"category_id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo da categoria
+	Category   string    `json:"category" example:"Alimentação"`                             // Nome da categoria
+	Confidence float64   `json:"confidence" example:"0.92"`                                  // Confiança estimada, entre 0 e 1
+}
+
+// CategorySuggestionFromDomain converte uma sugestão do classificador para CategorySuggestionResponse
+func CategorySuggestionFromDomain(suggestion categorization.Suggestion) CategorySuggestionResponse {
+	return CategorySuggestionResponse{
+		CategoryID: suggestion.CategoryExternalID,
+		Category:   suggestion.Category,
+		Confidence: math.Round(suggestion.Confidence*1000) / 1000,
+	}
+}
+
+// TransactionFromEntity converte uma entidade Transaction para TransactionResponse
+func TransactionFromEntity(transaction *entity.Transaction) TransactionResponse {
+	response := TransactionResponse{
+		ID:             transaction.ExternalID,
+		DocumentID:     transaction.DocumentExternalID,
+		Date:           transaction.Date.Format("2006-01-02"),
+		Amount:         transaction.Amount,
+		Currency:       transaction.Currency,
+		Description:    transaction.Description,
+		Counterparty:   transaction.Counterparty,
+		Category:       transaction.Category,
+		CategorySource: transaction.CategorySource,
+		CreatedAt:      transaction.CreatedAt,
+		UpdatedAt:      transaction.UpdatedAt,
+	}
+	if transaction.AccountID != 0 {
+		account := transaction.AccountExternalID
+		response.AccountID = &account
+	}
+	if transaction.CategoryID != 0 {
+		categoryID := transaction.CategoryExternalID
+		response.CategoryID = &categoryID
+	}
+	if transaction.InstallmentPlanID != 0 {
+		planID := transaction.InstallmentPlanExternalID
+		response.InstallmentPlanID = &planID
+		response.InstallmentNumber = transaction.InstallmentNumber
+		response.Installments = transaction.Installments
+	}
+	if transaction.TransferID != 0 {
+		transferID := transaction.TransferExternalID
+		response.TransferID = &transferID
+	}
+	if transaction.MerchantID != 0 {
+		merchantID := transaction.MerchantExternalID
+		response.MerchantID = &merchantID
+		response.Merchant = transaction.Merchant
+	}
+	return response
+}
+
+// TransactionSourceResponse representa um documento que informou a transação
+// @Description Documento de origem da transação; extratos de períodos sobrepostos informam a mesma transação mais de uma vez
+type TransactionSourceResponse struct {
+	DocumentID uuid.UUID `json:"document_id" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo do documento
+	Filename   string    `json:"filename" example:"extrato-marco.ofx"`                       // Nome do arquivo enviado
+	FITID      string    `json:"fitid,omitempty" example:"20240310001"`                      // Identificador da transação no documento, quando informado
+	CreatedAt  time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`                  // Data em que o documento informou a transação
+}
+
+// TransactionSourceFromEntity converte uma entidade TransactionSource para TransactionSourceResponse
+func TransactionSourceFromEntity(source *entity.TransactionSource) TransactionSourceResponse {
+	return TransactionSourceResponse{
+		DocumentID: source.DocumentExternalID,
+		Filename:   source.Filename,
+		FITID:      source.FITID,
+		CreatedAt:  source.CreatedAt,
+	}
+}
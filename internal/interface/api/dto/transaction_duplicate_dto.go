@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// TransactionDuplicateResponse representa uma possível transação duplicada aguardando revisão
+// @Description Transação importada que pode ser a mesma que outra já importada de um extrato de período sobreposto
+type TransactionDuplicateResponse struct {
+	ID          uuid.UUID           `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`  // ID externo da possível duplicata
+	Transaction TransactionResponse `json:"transaction"`                                        // Transação importada por último; é a excluída quando as duas são unidas
+	DuplicateOf TransactionResponse `json:"duplicate_of"`                                       // Transação importada antes; é a mantida quando as duas são unidas
+	Score       float64             `json:"score" example:"0.85"`                               // Semelhança entre as transações, entre 0 e 1
+	Status      string              `json:"status" example:"pending" enums:"pending,dismissed"` // Aguardando revisão ou descartada pelo usuário
+	CreatedAt   time.Time           `json:"created_at" example:"2023-01-01T00:00:00Z"`          // Data de criação
+}
+
+// TransactionDuplicateFromEntity converte uma entidade TransactionDuplicate para TransactionDuplicateResponse
+func TransactionDuplicateFromEntity(duplicate *entity.TransactionDuplicate) TransactionDuplicateResponse {
+	return TransactionDuplicateResponse{
+		ID:          duplicate.ExternalID,
+		Transaction: TransactionFromEntity(duplicate.Transaction),
+		DuplicateOf: TransactionFromEntity(duplicate.DuplicateOf),
+		Score:       duplicate.Score,
+		Status:      string(duplicate.Status),
+		CreatedAt:   duplicate.CreatedAt,
+	}
+}
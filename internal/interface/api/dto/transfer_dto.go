@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+
+	"finance-assistant/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// TransferLinkRequest representa as duas transações ligadas manualmente como transferência
+type TransferLinkRequest struct {
+	TransactionIDs []uuid.UUID `json:"transaction_ids" binding:"required,len=2"` // IDs externos do débito e do crédito, em qualquer ordem
+}
+
+// TransferLegResponse representa um dos lados de uma transferência
+type TransferLegResponse struct {
+	TransactionID uuid.UUID  `json:"transaction_id" example:"550e8400-e29b-41d4-a716-446655440000"`       // ID externo da transação
+	AccountID     *uuid.UUID `json:"account_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"` // ID externo da conta, quando identificada
+	Date          string     `json:"date" example:"2024-03-10"`                                           // Data da transação (AAAA-MM-DD)
+	Description   string     `json:"description" example:"PAGAMENTO FATURA CARTAO"`                       // Descrição conforme o extrato
+}
+
+// TransferResponse representa uma transferência entre contas do usuário
+// @Description Débito em uma conta e o crédito correspondente em outra, que não contam como gastos
+type TransferResponse struct {
+	ID        uuid.UUID           `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`               // ID externo da transferência
+	Outgoing  TransferLegResponse `json:"outgoing"`                                                        // Débito na conta de origem
+	Incoming  TransferLegResponse `json:"incoming"`                                                        // Crédito na conta de destino
+	Amount    int64               `json:"amount" example:"235000"`                                         // Valor transferido em centavos
+	Currency  string              `json:"currency" example:"BRL"`                                          // Código ISO 4217 da moeda
+	Status    string              `json:"status" example:"suggested" enums:"suggested,confirmed,rejected"` // Sugerida automaticamente, confirmada ou rejeitada
+	CreatedAt time.Time           `json:"created_at" example:"2023-01-01T00:00:00Z"`                       // Data de criação
+	UpdatedAt time.Time           `json:"updated_at" example:"2023-01-01T00:00:00Z"`                       // Data de última atualização
+}
+
+// TransferFromEntity converte uma entidade Transfer para TransferResponse
+func TransferFromEntity(transfer *entity.Transfer) TransferResponse {
+	return TransferResponse{
+		ID:        transfer.ExternalID,
+		Outgoing:  transferLeg(transfer.Outgoing),
+		Incoming:  transferLeg(transfer.Incoming),
+		Amount:    transfer.Amount,
+		Currency:  transfer.Currency,
+		Status:    string(transfer.Status),
+		CreatedAt: transfer.CreatedAt,
+		UpdatedAt: transfer.UpdatedAt,
+	}
+}
+
+func transferLeg(leg entity.TransferLeg) TransferLegResponse {
+	response := TransferLegResponse{
+		TransactionID: leg.TransactionExternalID,
+		Date:          leg.Date.Format("2006-01-02"),
+		Description:   leg.Description,
+	}
+	if leg.AccountExternalID != uuid.Nil {
+		account := leg.AccountExternalID
+		response.AccountID = &account
+	}
+	return response
+}
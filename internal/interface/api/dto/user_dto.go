@@ -7,11 +7,13 @@ import (
 	"github.com/google/uuid"
 )
 
-// UserRequest representa os dados enviados para criar/atualizar um usuário
+// UserRequest representa os dados enviados para criar um usuário
 type UserRequest struct {
 	Name  string `json:"name" binding:"required" example:"João Silva"`                    // Nome completo do usuário
 	Email string `json:"email" binding:"required,email" example:"joao.silva@example.com"` // Email do usuário
 	Phone string `json:"phone,omitempty" example:"(11) 98765-4321"`                       // Telefone do usuário (opcional)
+	// Senha usada no login (8 a 72 caracteres)
+	Password string `json:"password" binding:"required,min=8,max=72" example:"s3nh@Segura"`
 }
 
 // UserResponse representa os dados retornados pela API
@@ -20,6 +22,7 @@ type UserResponse struct {
 	Name      string    `json:"name" example:"João Silva"`                         // Nome do usuário
 	Email     string    `json:"email" example:"joao.silva@example.com"`            // Email do usuário
 	Phone     string    `json:"phone,omitempty" example:"(11) 98765-4321"`         // Telefone do usuário
+	Role      string    `json:"role" example:"user"`                               // Papel do usuário (user, support ou admin)
 	CreatedAt time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`         // Data de criação
 	UpdatedAt time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`         // Data de atualização
 }
@@ -31,6 +34,11 @@ type UpdateUserRequest struct {
 	Phone string `json:"phone" example:"(11) 99999-8888"`                                 // Telefone atualizado (opcional)
 }
 
+// ChangeRoleRequest representa o novo papel de um usuário
+type ChangeRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=user support admin" example:"support"` // Novo papel (user, support ou admin)
+}
+
 // UserListResponse representa a resposta de uma listagem paginada
 type UserListResponse struct {
 	Users []UserResponse `json:"users"`              // Lista de usuários
@@ -46,6 +54,7 @@ func FromEntity(user *entity.User) UserResponse {
 		Name:      user.Name,
 		Email:     user.Email,
 		Phone:     user.Phone,
+		Role:      string(user.Role),
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
@@ -53,5 +62,12 @@ func FromEntity(user *entity.User) UserResponse {
 
 // ToEntity converte UserRequest para uma entidade User
 func (r *UserRequest) ToEntity() (*entity.User, error) {
-	return entity.NewUser(r.Name, r.Email, r.Phone)
+	user, err := entity.NewUser(r.Name, r.Email, r.Phone)
+	if err != nil {
+		return nil, err
+	}
+	if err := user.SetPassword(r.Password); err != nil {
+		return nil, err
+	}
+	return user, nil
 }
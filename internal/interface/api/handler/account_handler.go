@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AccountHandler struct {
+	accountService *service.AccountService
+}
+
+func NewAccountHandler(accountService *service.AccountService) *AccountHandler {
+	return &AccountHandler{
+		accountService: accountService,
+	}
+}
+
+// Create godoc
+// @Summary      Criar conta
+// @Description  Cadastra uma conta corrente, poupança, cartão de crédito, investimento ou carteira do usuário. Do número informado apenas os 4 últimos dígitos são guardados; eles identificam a conta dos extratos OFX importados sem conta informada
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id       path      string              true  "ID do usuário"
+// @Param        account  body      dto.AccountRequest  true  "Dados da conta"
+// @Success      201      {object}  dto.AccountResponse
+// @Failure      400      {object}  dto.ErrorResponse
+// @Failure      401      {object}  dto.ErrorResponse
+// @Failure      403      {object}  dto.ErrorResponse
+// @Failure      404      {object}  dto.ErrorResponse
+// @Failure      500      {object}  dto.ErrorResponse
+// @Router       /users/{id}/accounts [post]
+func (h *AccountHandler) Create(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	var req dto.AccountRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	account, err := h.accountService.CreateAccount(c.Request.Context(), userID, accountInput(&req))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.AccountFromEntity(account))
+}
+
+// List godoc
+// @Summary      Listar contas
+// @Description  Retorna as contas do usuário em ordem alfabética
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID do usuário"
+// @Success      200  {array}   dto.AccountResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /users/{id}/accounts [get]
+func (h *AccountHandler) List(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	accounts, err := h.accountService.ListAccounts(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := make([]dto.AccountResponse, len(accounts))
+	for i, account := range accounts {
+		response[i] = dto.AccountFromEntity(account)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetByID godoc
+// @Summary      Buscar conta
+// @Description  Retorna uma conta do usuário
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id         path      string  true  "ID do usuário"
+// @Param        accountId  path      string  true  "ID da conta"
+// @Success      200        {object}  dto.AccountResponse
+// @Failure      400        {object}  dto.ErrorResponse
+// @Failure      401        {object}  dto.ErrorResponse
+// @Failure      403        {object}  dto.ErrorResponse
+// @Failure      404        {object}  dto.ErrorResponse
+// @Failure      500        {object}  dto.ErrorResponse
+// @Router       /users/{id}/accounts/{accountId} [get]
+func (h *AccountHandler) GetByID(c *gin.Context) {
+	userID, accountID, ok := parseAccountParams(c)
+	if !ok {
+		return
+	}
+
+	account, err := h.accountService.GetAccount(c.Request.Context(), userID, accountID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.AccountFromEntity(account))
+}
+
+// Update godoc
+// @Summary      Atualizar conta
+// @Description  Substitui os dados de uma conta do usuário; sem número informado, os dígitos já cadastrados são mantidos
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id         path      string              true  "ID do usuário"
+// @Param        accountId  path      string              true  "ID da conta"
+// @Param        account    body      dto.AccountRequest  true  "Dados da conta"
+// @Success      200        {object}  dto.AccountResponse
+// @Failure      400        {object}  dto.ErrorResponse
+// @Failure      401        {object}  dto.ErrorResponse
+// @Failure      403        {object}  dto.ErrorResponse
+// @Failure      404        {object}  dto.ErrorResponse
+// @Failure      500        {object}  dto.ErrorResponse
+// @Router       /users/{id}/accounts/{accountId} [put]
+func (h *AccountHandler) Update(c *gin.Context) {
+	userID, accountID, ok := parseAccountParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AccountRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	account, err := h.accountService.UpdateAccount(c.Request.Context(), userID, accountID, accountInput(&req))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.AccountFromEntity(account))
+}
+
+// Delete godoc
+// @Summary      Excluir conta
+// @Description  Remove uma conta do usuário; os documentos e as transações importados são mantidos, sem conta
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id         path      string  true  "ID do usuário"
+// @Param        accountId  path      string  true  "ID da conta"
+// @Success      204        {object}  nil
+// @Failure      400        {object}  dto.ErrorResponse
+// @Failure      401        {object}  dto.ErrorResponse
+// @Failure      403        {object}  dto.ErrorResponse
+// @Failure      404        {object}  dto.ErrorResponse
+// @Failure      500        {object}  dto.ErrorResponse
+// @Router       /users/{id}/accounts/{accountId} [delete]
+func (h *AccountHandler) Delete(c *gin.Context) {
+	userID, accountID, ok := parseAccountParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.accountService.DeleteAccount(c.Request.Context(), userID, accountID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Reconciliation godoc
+// @Summary      Conciliar conta
+// @Description  Confere o saldo final de cada extrato importado para a conta com o saldo calculado a partir do saldo inicial e das transações, indicando extratos que conferem, com diferença de valor, sobrepostos ou com lacuna em relação ao anterior
+// @Tags         accounts
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID da conta"
+// @Success      200  {object}  dto.ReconciliationResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /accounts/{id}/reconciliation [get]
+func (h *AccountHandler) Reconciliation(c *gin.Context) {
+	accountID, ok := parseUUIDParam(c, "id", "ID de conta inválido")
+	if !ok {
+		return
+	}
+
+	reconciliation, err := h.accountService.GetReconciliation(c.Request.Context(), accountID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ReconciliationFromEntity(reconciliation.Account, reconciliation.Statements, reconciliation.Balance))
+}
+
+// parseAccountParams valida os IDs de usuário e conta da URL
+func parseAccountParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	accountID, ok := parseUUIDParam(c, "accountId", "ID de conta inválido")
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, accountID, true
+}
+
+func accountInput(req *dto.AccountRequest) service.AccountInput {
+	return service.AccountInput{
+		Name:           req.Name,
+		Institution:    req.Institution,
+		Type:           entity.AccountType(req.Type),
+		Currency:       req.Currency,
+		Number:         req.Number,
+		OpeningBalance: req.OpeningBalance,
+	}
+}
+
+func (h *AccountHandler) handleError(c *gin.Context, err error) {
+	if respondAccessError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Usuário não encontrado"})
+	case errors.Is(err, service.ErrAccountNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, entity.ErrInvalidAccountName),
+		errors.Is(err, entity.ErrInvalidAccountInstitution),
+		errors.Is(err, entity.ErrInvalidAccountType),
+		errors.Is(err, entity.ErrInvalidAccountCurrency),
+		errors.Is(err, entity.ErrInvalidAccountNumber):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+	}
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type APIKeyHandler struct {
+	apiKeyService *service.APIKeyService
+}
+
+func NewAPIKeyHandler(apiKeyService *service.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+	}
+}
+
+// Create godoc
+// @Summary      Criar chave de API
+// @Description  Gera uma chave de API pessoal com os escopos informados. O valor da chave só é exibido nesta resposta
+// @Tags         api-keys
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                   true  "ID do usuário"
+// @Param        key  body      dto.CreateAPIKeyRequest  true  "Dados da chave"
+// @Success      201  {object}  dto.CreatedAPIKeyResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /users/{id}/api-keys [post]
+func (h *APIKeyHandler) Create(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID de usuário inválido"})
+		return
+	}
+
+	var req dto.CreateAPIKeyRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	key, plaintext, err := h.apiKeyService.CreateAPIKey(c.Request.Context(), userID, req.Name, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreatedAPIKeyResponse{
+		APIKeyResponse: dto.APIKeyFromEntity(key),
+		Key:            plaintext,
+	})
+}
+
+// List godoc
+// @Summary      Listar chaves de API
+// @Description  Retorna as chaves de API do usuário, incluindo as revogadas e expiradas
+// @Tags         api-keys
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "ID do usuário"
+// @Success      200  {array}   dto.APIKeyResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /users/{id}/api-keys [get]
+func (h *APIKeyHandler) List(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID de usuário inválido"})
+		return
+	}
+
+	keys, err := h.apiKeyService.ListAPIKeys(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := make([]dto.APIKeyResponse, len(keys))
+	for i, key := range keys {
+		response[i] = dto.APIKeyFromEntity(key)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Revoke godoc
+// @Summary      Revogar chave de API
+// @Description  Revoga uma chave de API do usuário; a chave deixa de ser aceita imediatamente
+// @Tags         api-keys
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id     path      string  true  "ID do usuário"
+// @Param        keyId  path      string  true  "ID da chave"
+// @Success      204    {object}  nil
+// @Failure      400    {object}  dto.ErrorResponse
+// @Failure      401    {object}  dto.ErrorResponse
+// @Failure      403    {object}  dto.ErrorResponse
+// @Failure      404    {object}  dto.ErrorResponse
+// @Failure      500    {object}  dto.ErrorResponse
+// @Router       /users/{id}/api-keys/{keyId} [delete]
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID de usuário inválido"})
+		return
+	}
+
+	keyID, err := uuid.Parse(c.Param("keyId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID de chave inválido"})
+		return
+	}
+
+	if err := h.apiKeyService.RevokeAPIKey(c.Request.Context(), userID, keyID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// handleError converte erros do serviço de chaves de API em respostas HTTP
+func (h *APIKeyHandler) handleError(c *gin.Context, err error) {
+	if respondAccessError(c, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, service.ErrAPIKeyViaAPIKey):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrUserNotFound), errors.Is(err, service.ErrAPIKeyNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, entity.ErrInvalidAPIKeyName),
+		errors.Is(err, entity.ErrInvalidAPIKeyScopes),
+		errors.Is(err, entity.ErrInvalidAPIKeyExpiry):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+	}
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"finance-assistant/internal/pkg/validation"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AuthHandler struct {
+	authService *service.AuthService
+}
+
+func NewAuthHandler(authService *service.AuthService) *AuthHandler {
+	return &AuthHandler{
+		authService: authService,
+	}
+}
+
+// Login godoc
+// @Summary      Entrar
+// @Description  Verifica email e senha e retorna um access token (JWT) e um refresh token
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        credentials  body      dto.LoginRequest  true  "Credenciais do usuário"
+// @Success      200          {object}  dto.TokenResponse
+// @Failure      400          {object}  dto.ErrorResponse
+// @Failure      401          {object}  dto.ErrorResponse
+// @Failure      500          {object}  dto.ErrorResponse
+// @Router       /auth/login [post]
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req dto.LoginRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	tokens, err := h.authService.Login(c.Request.Context(), req.Email, req.Password)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCredentials) {
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewTokenResponse(tokens.AccessToken, tokens.AccessTokenExpiresAt, tokens.RefreshToken))
+}
+
+// Refresh godoc
+// @Summary      Renovar tokens
+// @Description  Troca um refresh token por um novo par de tokens. Cada refresh token só pode ser usado uma vez; a reutilização revoga a sessão
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        token  body      dto.RefreshTokenRequest  true  "Refresh token"
+// @Success      200    {object}  dto.TokenResponse
+// @Failure      400    {object}  dto.ErrorResponse
+// @Failure      401    {object}  dto.ErrorResponse
+// @Failure      500    {object}  dto.ErrorResponse
+// @Router       /auth/refresh [post]
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req dto.RefreshTokenRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	tokens, err := h.authService.Refresh(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRefreshToken) {
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewTokenResponse(tokens.AccessToken, tokens.AccessTokenExpiresAt, tokens.RefreshToken))
+}
+
+// Logout godoc
+// @Summary      Sair
+// @Description  Revoga a sessão do refresh token; os access tokens emitidos para ela deixam de ser aceitos
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        token  body      dto.RefreshTokenRequest  true  "Refresh token"
+// @Success      204    {object}  nil
+// @Failure      400    {object}  dto.ErrorResponse
+// @Failure      401    {object}  dto.ErrorResponse
+// @Failure      500    {object}  dto.ErrorResponse
+// @Router       /auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req dto.RefreshTokenRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if err := h.authService.Logout(c.Request.Context(), req.RefreshToken); err != nil {
+		if errors.Is(err, service.ErrInvalidRefreshToken) {
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ChangePassword godoc
+// @Summary      Alterar senha
+// @Description  Altera a senha do usuário autenticado e revoga as suas demais sessões
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        password  body      dto.ChangePasswordRequest  true  "Senha atual e nova senha"
+// @Success      204       {object}  nil
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      401       {object}  dto.ErrorResponse
+// @Failure      500       {object}  dto.ErrorResponse
+// @Router       /auth/password [put]
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	var req dto.ChangePasswordRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if err := h.authService.ChangePassword(c.Request.Context(), req.CurrentPassword, req.NewPassword); err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, service.ErrInvalidCredentials):
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: "Senha atual incorreta"})
+		case errors.Is(err, entity.ErrInvalidUserPassword):
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// bindJSON lê o corpo JSON da requisição, respondendo 400 com os erros de
+// validação traduzidos quando os dados forem inválidos
+func bindJSON(c *gin.Context, req any) bool {
+	err := c.ShouldBindJSON(req)
+	if err == nil {
+		return true
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		if valid, errs := validation.Validate(req); !valid {
+			details := make([]dto.ErrorDetail, len(errs.Errors))
+			for i, e := range errs.Errors {
+				details[i] = dto.ErrorDetail{Field: e.Field, Message: e.Message}
+			}
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Erro de validação", Details: details})
+			return false
+		}
+	}
+
+	c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Formato de dados inválido"})
+	return false
+}
+
+// respondAccessError responde 401 ou 403 quando o serviço negou o acesso ao
+// recurso, retornando false para os demais erros
+func respondAccessError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, auth.ErrForbidden):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: err.Error()})
+	default:
+		return false
+	}
+	return true
+}
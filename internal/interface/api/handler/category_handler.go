@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CategoryHandler struct {
+	categoryService *service.CategoryService
+}
+
+func NewCategoryHandler(categoryService *service.CategoryService) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: categoryService,
+	}
+}
+
+// Create godoc
+// @Summary      Criar categoria
+// @Description  Cria uma categoria do usuário, opcionalmente como subcategoria de uma categoria padrão ou do usuário. Nomes que diferem apenas por maiúsculas, acentos ou plural são considerados iguais
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id        path      string               true  "ID do usuário"
+// @Param        category  body      dto.CategoryRequest  true  "Dados da categoria"
+// @Success      201       {object}  dto.CategoryResponse
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      401       {object}  dto.ErrorResponse
+// @Failure      403       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      409       {object}  dto.ErrorResponse
+// @Failure      500       {object}  dto.ErrorResponse
+// @Router       /users/{id}/categories [post]
+func (h *CategoryHandler) Create(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	var req dto.CategoryRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	category, err := h.categoryService.CreateCategory(c.Request.Context(), userID, categoryInput(&req))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CategoryFromEntity(category))
+}
+
+// List godoc
+// @Summary      Listar categorias
+// @Description  Retorna as categorias padrão e as do usuário em árvore, com as subcategorias dentro das categorias de primeiro nível
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID do usuário"
+// @Success      200  {array}   dto.CategoryResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /users/{id}/categories [get]
+func (h *CategoryHandler) List(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	categories, err := h.categoryService.ListCategories(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CategoryTreeFromEntities(categories))
+}
+
+// GetByID godoc
+// @Summary      Buscar categoria
+// @Description  Retorna uma categoria padrão ou do usuário
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id          path      string  true  "ID do usuário"
+// @Param        categoryId  path      string  true  "ID da categoria"
+// @Success      200         {object}  dto.CategoryResponse
+// @Failure      400         {object}  dto.ErrorResponse
+// @Failure      401         {object}  dto.ErrorResponse
+// @Failure      403         {object}  dto.ErrorResponse
+// @Failure      404         {object}  dto.ErrorResponse
+// @Failure      500         {object}  dto.ErrorResponse
+// @Router       /users/{id}/categories/{categoryId} [get]
+func (h *CategoryHandler) GetByID(c *gin.Context) {
+	userID, categoryID, ok := parseCategoryParams(c)
+	if !ok {
+		return
+	}
+
+	category, err := h.categoryService.GetCategory(c.Request.Context(), userID, categoryID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CategoryFromEntity(category))
+}
+
+// Update godoc
+// @Summary      Atualizar categoria
+// @Description  Substitui os dados de uma categoria do usuário; as categorias padrão não podem ser alteradas
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id          path      string               true  "ID do usuário"
+// @Param        categoryId  path      string               true  "ID da categoria"
+// @Param        category    body      dto.CategoryRequest  true  "Dados da categoria"
+// @Success      200         {object}  dto.CategoryResponse
+// @Failure      400         {object}  dto.ErrorResponse
+// @Failure      401         {object}  dto.ErrorResponse
+// @Failure      403         {object}  dto.ErrorResponse
+// @Failure      404         {object}  dto.ErrorResponse
+// @Failure      409         {object}  dto.ErrorResponse
+// @Failure      500         {object}  dto.ErrorResponse
+// @Router       /users/{id}/categories/{categoryId} [put]
+func (h *CategoryHandler) Update(c *gin.Context) {
+	userID, categoryID, ok := parseCategoryParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CategoryRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	category, err := h.categoryService.UpdateCategory(c.Request.Context(), userID, categoryID, categoryInput(&req))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CategoryFromEntity(category))
+}
+
+// Delete godoc
+// @Summary      Excluir categoria
+// @Description  Remove uma categoria do usuário. Os documentos deixam de referenciá-la e as transações ficam sem categoria; categorias com subcategorias ou usadas por regras de categorização não podem ser excluídas
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id          path      string  true  "ID do usuário"
+// @Param        categoryId  path      string  true  "ID da categoria"
+// @Success      204         {object}  nil
+// @Failure      400         {object}  dto.ErrorResponse
+// @Failure      401         {object}  dto.ErrorResponse
+// @Failure      403         {object}  dto.ErrorResponse
+// @Failure      404         {object}  dto.ErrorResponse
+// @Failure      409         {object}  dto.ErrorResponse
+// @Failure      500         {object}  dto.ErrorResponse
+// @Router       /users/{id}/categories/{categoryId} [delete]
+func (h *CategoryHandler) Delete(c *gin.Context) {
+	userID, categoryID, ok := parseCategoryParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.categoryService.DeleteCategory(c.Request.Context(), userID, categoryID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// parseCategoryParams valida os IDs de usuário e categoria da URL
+func parseCategoryParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	categoryID, ok := parseUUIDParam(c, "categoryId", "ID de categoria inválido")
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, categoryID, true
+}
+
+func categoryInput(req *dto.CategoryRequest) service.CategoryInput {
+	input := service.CategoryInput{
+		Name:  req.Name,
+		Icon:  req.Icon,
+		Color: req.Color,
+	}
+	if req.ParentID != nil {
+		input.ParentID = *req.ParentID
+	}
+	return input
+}
+
+func (h *CategoryHandler) handleError(c *gin.Context, err error) {
+	if respondAccessError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Usuário não encontrado"})
+	case errors.Is(err, service.ErrCategoryNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrCategoryReadOnly):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrCategoryExists),
+		errors.Is(err, service.ErrCategoryInUse),
+		errors.Is(err, service.ErrCategoryHasChildren):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, entity.ErrInvalidCategoryName),
+		errors.Is(err, entity.ErrInvalidCategoryIcon),
+		errors.Is(err, entity.ErrInvalidCategoryColor),
+		errors.Is(err, entity.ErrInvalidCategoryParent):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+	}
+}
@@ -0,0 +1,309 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// defaultTestLimit é o número de alterações listadas na simulação quando o limite não é informado
+const defaultTestLimit = 50
+
+type CategoryRuleHandler struct {
+	categoryRuleService *service.CategoryRuleService
+}
+
+func NewCategoryRuleHandler(categoryRuleService *service.CategoryRuleService) *CategoryRuleHandler {
+	return &CategoryRuleHandler{
+		categoryRuleService: categoryRuleService,
+	}
+}
+
+// Create godoc
+// @Summary      Criar regra de categorização
+// @Description  Cadastra uma regra que atribui uma categoria às transações que atendem às suas condições. As regras do usuário são avaliadas antes das regras padrão
+// @Tags         category-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id    path      string                   true  "ID do usuário"
+// @Param        rule  body      dto.CategoryRuleRequest  true  "Dados da regra"
+// @Success      201   {object}  dto.CategoryRuleResponse
+// @Failure      400   {object}  dto.ErrorResponse
+// @Failure      401   {object}  dto.ErrorResponse
+// @Failure      403   {object}  dto.ErrorResponse
+// @Failure      404   {object}  dto.ErrorResponse
+// @Failure      500   {object}  dto.ErrorResponse
+// @Router       /users/{id}/category-rules [post]
+func (h *CategoryRuleHandler) Create(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	var req dto.CategoryRuleRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	rule, err := h.categoryRuleService.CreateRule(c.Request.Context(), userID, categoryRuleInput(&req))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CategoryRuleFromEntity(rule))
+}
+
+// List godoc
+// @Summary      Listar regras de categorização
+// @Description  Retorna as regras de categorização do usuário na ordem em que são avaliadas
+// @Tags         category-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID do usuário"
+// @Success      200  {array}   dto.CategoryRuleResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /users/{id}/category-rules [get]
+func (h *CategoryRuleHandler) List(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	rules, err := h.categoryRuleService.ListRules(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := make([]dto.CategoryRuleResponse, len(rules))
+	for i, rule := range rules {
+		response[i] = dto.CategoryRuleFromEntity(rule)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Update godoc
+// @Summary      Atualizar regra de categorização
+// @Description  Substitui os dados de uma regra. As transações já categorizadas só mudam ao reaplicar as regras
+// @Tags         category-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id      path      string                   true  "ID do usuário"
+// @Param        ruleId  path      string                   true  "ID da regra"
+// @Param        rule    body      dto.CategoryRuleRequest  true  "Dados da regra"
+// @Success      200     {object}  dto.CategoryRuleResponse
+// @Failure      400     {object}  dto.ErrorResponse
+// @Failure      401     {object}  dto.ErrorResponse
+// @Failure      403     {object}  dto.ErrorResponse
+// @Failure      404     {object}  dto.ErrorResponse
+// @Failure      500     {object}  dto.ErrorResponse
+// @Router       /users/{id}/category-rules/{ruleId} [put]
+func (h *CategoryRuleHandler) Update(c *gin.Context) {
+	userID, ruleID, ok := parseRuleParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CategoryRuleRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	rule, err := h.categoryRuleService.UpdateRule(c.Request.Context(), userID, ruleID, categoryRuleInput(&req))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CategoryRuleFromEntity(rule))
+}
+
+// Delete godoc
+// @Summary      Excluir regra de categorização
+// @Description  Remove uma regra de categorização do usuário
+// @Tags         category-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id      path      string  true  "ID do usuário"
+// @Param        ruleId  path      string  true  "ID da regra"
+// @Success      204     {object}  nil
+// @Failure      400     {object}  dto.ErrorResponse
+// @Failure      401     {object}  dto.ErrorResponse
+// @Failure      403     {object}  dto.ErrorResponse
+// @Failure      404     {object}  dto.ErrorResponse
+// @Failure      500     {object}  dto.ErrorResponse
+// @Router       /users/{id}/category-rules/{ruleId} [delete]
+func (h *CategoryRuleHandler) Delete(c *gin.Context) {
+	userID, ruleID, ok := parseRuleParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.categoryRuleService.DeleteRule(c.Request.Context(), userID, ruleID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Test godoc
+// @Summary      Simular regras de categorização
+// @Description  Aplica as regras do usuário e as regras padrão ao histórico de transações sem alterá-lo, retornando as categorias que mudariam. Uma regra ainda não salva pode ser incluída na simulação
+// @Tags         category-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id    path      string                       true  "ID do usuário"
+// @Param        test  body      dto.CategoryRuleTestRequest  false "Regra candidata e limite"
+// @Success      200   {object}  dto.CategorizationReportResponse
+// @Failure      400   {object}  dto.ErrorResponse
+// @Failure      401   {object}  dto.ErrorResponse
+// @Failure      403   {object}  dto.ErrorResponse
+// @Failure      404   {object}  dto.ErrorResponse
+// @Failure      500   {object}  dto.ErrorResponse
+// @Router       /users/{id}/category-rules/test [post]
+func (h *CategoryRuleHandler) Test(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	// O corpo é opcional: sem ele, apenas as regras salvas são simuladas
+	var req dto.CategoryRuleTestRequest
+	if c.Request.ContentLength != 0 && !bindJSON(c, &req) {
+		return
+	}
+
+	var candidate *service.CategoryRuleInput
+	if req.Rule != nil {
+		input := categoryRuleInput(req.Rule)
+		candidate = &input
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultTestLimit
+	}
+
+	report, err := h.categoryRuleService.TestRules(c.Request.Context(), userID, candidate, limit)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, categorizationReportResponse(report))
+}
+
+// Apply godoc
+// @Summary      Reaplicar regras de categorização
+// @Description  Aplica as regras do usuário e as regras padrão a todas as transações já importadas, atualizando as categorias que mudaram
+// @Tags         category-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID do usuário"
+// @Success      200  {object}  dto.CategorizationReportResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /users/{id}/category-rules/apply [post]
+func (h *CategoryRuleHandler) Apply(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	report, err := h.categoryRuleService.ApplyRules(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, categorizationReportResponse(report))
+}
+
+// parseRuleParams valida os IDs de usuário e regra da URL
+func parseRuleParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	ruleID, ok := parseUUIDParam(c, "ruleId", "ID de regra inválido")
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, ruleID, true
+}
+
+func categoryRuleInput(req *dto.CategoryRuleRequest) service.CategoryRuleInput {
+	return service.CategoryRuleInput{
+		Name:       req.Name,
+		CategoryID: req.CategoryID,
+		Priority:   req.Priority,
+		Enabled:    req.IsEnabled(),
+		Conditions: req.Conditions.ToEntity(),
+	}
+}
+
+func categorizationReportResponse(report *service.CategorizationReport) dto.CategorizationReportResponse {
+	response := dto.CategorizationReportResponse{
+		Evaluated: report.Evaluated,
+		Matched:   report.Matched,
+		Changed:   report.Changed,
+		Changes:   make([]dto.CategoryChangeResponse, len(report.Changes)),
+	}
+	for i, change := range report.Changes {
+		response.Changes[i] = dto.CategoryChangeFromEntity(change.Transaction, change.Rule, change.PreviousCategory)
+	}
+	return response
+}
+
+func (h *CategoryRuleHandler) handleError(c *gin.Context, err error) {
+	if respondAccessError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Usuário não encontrado"})
+	case errors.Is(err, service.ErrCategoryRuleNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrCategoryNotFound):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, entity.ErrInvalidCategoryRuleName),
+		errors.Is(err, entity.ErrInvalidCategoryRuleCategory),
+		errors.Is(err, entity.ErrInvalidCategoryRulePriority),
+		errors.Is(err, entity.ErrCategoryRuleWithoutConditions),
+		errors.Is(err, entity.ErrInvalidCategoryRuleRegex),
+		errors.Is(err, entity.ErrInvalidCategoryRuleAmount),
+		errors.Is(err, entity.ErrInvalidCategoryRuleDay):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+	}
+}
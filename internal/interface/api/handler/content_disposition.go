@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// contentDisposition monta o cabeçalho Content-Disposition conforme a RFC 6266.
+// O parâmetro filename recebe uma versão ASCII do nome para clientes antigos e,
+// quando o nome possui outros caracteres, filename* recebe o nome original em
+// UTF-8 codificado conforme a RFC 5987 (ex: extrato_março.pdf).
+func contentDisposition(dispositionType, filename string) string {
+	fallback := asciiFilename(filename)
+	header := fmt.Sprintf(`%s; filename="%s"`, dispositionType, quoteFilename(fallback))
+	if fallback != filename {
+		header += "; filename*=UTF-8''" + encodeRFC5987(filename)
+	}
+	return header
+}
+
+// asciiFilename remove acentos e substitui os demais caracteres não ASCII ou de controle por '_'
+func asciiFilename(filename string) string {
+	var builder strings.Builder
+	for _, r := range norm.NFD.String(filename) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Marca de acentuação separada pela decomposição
+		case r < 0x20 || r == 0x7f || r > unicode.MaxASCII:
+			builder.WriteByte('_')
+		default:
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// quoteFilename escapa aspas e barras invertidas para uso em quoted-string
+func quoteFilename(filename string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(filename)
+}
+
+// encodeRFC5987 codifica o valor em percent-encoding, mantendo apenas os attr-char da RFC 5987
+func encodeRFC5987(value string) string {
+	const hex = "0123456789ABCDEF"
+
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		b := value[i]
+		if isAttrChar(b) {
+			builder.WriteByte(b)
+			continue
+		}
+		builder.WriteByte('%')
+		builder.WriteByte(hex[b>>4])
+		builder.WriteByte(hex[b&0x0f])
+	}
+	return builder.String()
+}
+
+func isAttrChar(b byte) bool {
+	if b >= utf8.RuneSelf {
+		return false
+	}
+	switch {
+	case 'a' <= b && b <= 'z', 'A' <= b && b <= 'Z', '0' <= b && b <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$&+-.^_`|~", b) >= 0
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"finance-assistant/internal/domain/currency"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// respondCurrencyError responde 400 para uma moeda de relatório inválida e 422
+// quando falta a cotação para a conversão, retornando false para os demais erros
+func respondCurrencyError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, entity.ErrInvalidCurrency):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, currency.ErrRateNotFound):
+		c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse{Error: err.Error()})
+	default:
+		return false
+	}
+	return true
+}
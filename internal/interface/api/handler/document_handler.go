@@ -1,18 +1,19 @@
 package handler
 
 import (
-	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
-	"path/filepath"
 	"strconv"
 	"strings"
 
 	"finance-assistant/internal/domain/entity"
 	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/domain/storage"
 	"finance-assistant/internal/interface/api/dto"
+	"finance-assistant/internal/pkg/filetype"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -27,132 +28,199 @@ func NewDocumentHandler(documentService *service.DocumentService) *DocumentHandl
 	}
 }
 
+// maxUploadSize é o tamanho máximo aceito para o arquivo de um documento
+const maxUploadSize = 10 * 1024 * 1024
+
+// maxFormValueSize limita o tamanho dos campos de texto do formulário
+const maxFormValueSize = 64 * 1024
+
 // Create godoc
 // @Summary      Criar documento
-// @Description  Envia um novo documento para um usuário
+// @Description  Envia um novo documento para um usuário. O arquivo é gravado no armazenamento em streaming, sem ser carregado inteiro em memória, e seu tipo é identificado pelo conteúdo, que precisa corresponder à extensão e estar entre os tipos permitidos na configuração.
 // @Tags         documents
 // @Accept       multipart/form-data
 // @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
 // @Param        id              path      string   true  "ID do usuário"
 // @Param        document_type   formData  string   true  "Tipo de documento (ex: bank_statement, invoice, receipt)"
-// @Param        categories      formData  []string false "Categorias do documento (opcional)"
-// @Param        file            formData  file     true  "Arquivo do documento (PDF, DOCX, XLS, PNG, JPEG)"
+// @Param        categories      formData  []string false "IDs das categorias do documento, padrão ou do usuário (opcional)"
+// @Param        import_profile  formData  string   false "ID do perfil de importação CSV (opcional)"
+// @Param        household       formData  string   false "ID da família com que o documento é compartilhado (opcional)"
+// @Param        account_id      formData  string   false "ID da conta do usuário a que o documento pertence (opcional; se ausente é identificada pelos dados do extrato)"
+// @Param        force           formData  bool     false "Aceita o arquivo mesmo que o mesmo conteúdo já tenha sido enviado (default: false)"
+// @Param        file            formData  file     true  "Arquivo do documento (PDF, DOC, DOCX, XLS, XLSX, PNG, JPEG, OFX, QFX, CSV)"
 // @Success      201             {object}  dto.DocumentResponse
-// @Failure      400             {object}  map[string]interface{}
-// @Failure      404             {object}  map[string]interface{}
-// @Failure      500             {object}  map[string]interface{}
+// @Failure      400             {object}  dto.ErrorResponse
+// @Failure      401             {object}  dto.ErrorResponse
+// @Failure      403             {object}  dto.ErrorResponse
+// @Failure      404             {object}  dto.ErrorResponse
+// @Failure      409             {object}  dto.DocumentConflictResponse "Conteúdo já enviado pelo usuário"
+// @Failure      415             {object}  dto.ErrorResponse "Tipo de arquivo não suportado ou diferente da extensão"
+// @Failure      500             {object}  dto.ErrorResponse
 // @Router       /users/{id}/documents [post]
 func (h *DocumentHandler) Create(c *gin.Context) {
 	// Obter ID do usuário a partir do parâmetro da URL
 	userIDStr := c.Param("id")
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de usuário inválido"})
-		return
-	}
-
-	// Carregar dados do form-data
-	var req dto.DocumentUploadRequest
-	if err := c.ShouldBind(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Erro ao processar formulário",
-			"details": err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID de usuário inválido"})
 		return
 	}
 
-	// Verificar se o tipo de documento foi fornecido
-	if req.DocumentType == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Tipo de documento é obrigatório"})
-		return
-	}
-
-	// Obter arquivo enviado
-	file, fileHeader, err := c.Request.FormFile("file")
+	reader, err := c.Request.MultipartReader()
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Arquivo não encontrado ou inválido"})
+		c.JSON(http.StatusBadRequest, formError(err))
 		return
 	}
-	defer file.Close()
 
-	// Validar tamanho do arquivo (exemplo: limite de 10MB)
-	if fileHeader.Size > 10*1024*1024 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Arquivo muito grande, tamanho máximo permitido é 10MB"})
-		return
-	}
+	// Conteúdo enviado que não chegar a ser associado a um documento é descartado
+	var upload *service.ContentUpload
+	submitted := false
+	defer func() {
+		if upload != nil && !submitted {
+			h.documentService.DiscardUpload(c.Request.Context(), upload)
+		}
+	}()
 
-	// Obter nome do arquivo e extensão
-	filename := fileHeader.Filename
-	fileExt := strings.ToLower(filepath.Ext(filename))
+	// Ler as partes do formulário na ordem em que chegam; o arquivo é gravado
+	// diretamente no armazenamento
+	var req dto.DocumentUploadRequest
+	var filename string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, formError(err))
+			return
+		}
 
-	// Validar tipo de arquivo
-	allowedExtensions := map[string]bool{
-		".pdf":  true,
-		".doc":  true,
-		".docx": true,
-		".xls":  true,
-		".xlsx": true,
-		".png":  true,
-		".jpg":  true,
-		".jpeg": true,
+		switch part.FormName() {
+		case "file":
+			if upload != nil {
+				c.JSON(http.StatusBadRequest, fileError("Apenas um arquivo pode ser enviado", ""))
+				return
+			}
+
+			filename = part.FileName()
+			limited := &uploadLimitReader{reader: part, remaining: maxUploadSize}
+			upload, err = h.documentService.UploadContent(c.Request.Context(), userID, filename, limited)
+			if limited.exceeded() {
+				c.JSON(http.StatusBadRequest, fileError("Arquivo muito grande, tamanho máximo permitido é 10MB", ""))
+				return
+			}
+			if err != nil {
+				h.handleUploadError(c, filename, err)
+				return
+			}
+
+			// Log para debug
+			log.Printf("Arquivo recebido: %s, tamanho: %d bytes, tipo: %s", filename, upload.Size, upload.ContentType)
+		case "document_type", "categories", "categories[]", "import_profile", "household", "account_id", "force":
+			value, err := readFormValue(part)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, formError(err))
+				return
+			}
+
+			switch part.FormName() {
+			case "document_type":
+				req.DocumentType = value
+			case "import_profile":
+				req.ImportProfile = value
+			case "household":
+				req.Household = value
+			case "account_id":
+				req.AccountID = value
+			case "force":
+				if req.Force, err = strconv.ParseBool(value); err != nil {
+					c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Valor inválido para force"})
+					return
+				}
+			default:
+				req.Categories = append(req.Categories, value)
+			}
+		}
+		part.Close()
 	}
 
-	if !allowedExtensions[fileExt] {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Tipo de arquivo não suportado",
-			"details": "Tipos permitidos: PDF, DOC, DOCX, XLS, XLSX, PNG, JPG, JPEG",
-		})
+	// Verificar se o tipo de documento foi fornecido
+	if req.DocumentType == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Tipo de documento é obrigatório"})
 		return
 	}
 
-	// Determinar o content type com base na extensão do arquivo
-	var contentType string
-	switch fileExt {
-	case ".pdf":
-		contentType = "application/pdf"
-	case ".doc", ".docx":
-		contentType = "application/msword"
-	case ".xls", ".xlsx":
-		contentType = "application/vnd.ms-excel"
-	case ".png":
-		contentType = "image/png"
-	case ".jpg", ".jpeg":
-		contentType = "image/jpeg"
-	default:
-		contentType = "application/octet-stream"
+	// Validar perfil de importação, se informado
+	var importProfileID uuid.UUID
+	if req.ImportProfile != "" {
+		importProfileID, err = uuid.Parse(req.ImportProfile)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID de perfil de importação inválido"})
+			return
+		}
 	}
 
-	// Ler o conteúdo do arquivo
-	fileContent, err := io.ReadAll(file)
+	// Validar categorias, enviadas em campos repetidos ou separadas por vírgula
+	categoryIDs, err := parseCategoryIDs(req.Categories)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao ler arquivo"})
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID de categoria inválido"})
 		return
 	}
 
-	// Verificar se o arquivo está vazio
-	if len(fileContent) == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Arquivo vazio"})
-		return
+	// Validar família, se informada
+	var householdID uuid.UUID
+	if req.Household != "" {
+		householdID, err = uuid.Parse(req.Household)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID de família inválido"})
+			return
+		}
 	}
 
-	// Converter o conteúdo para base64
-	base64Content := base64.StdEncoding.EncodeToString(fileContent)
+	// Validar conta, se informada
+	var accountID uuid.UUID
+	if req.AccountID != "" {
+		accountID, err = uuid.Parse(req.AccountID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID de conta inválido"})
+			return
+		}
+	}
 
-	// Log para debug
-	log.Printf("Processando upload de arquivo: %s, tamanho: %d bytes, tipo: %s",
-		filename, len(fileContent), contentType)
+	if upload == nil {
+		c.JSON(http.StatusBadRequest, fileError("Arquivo não encontrado ou inválido", ""))
+		return
+	}
 
-	// Criar documento
-	document, err := h.documentService.CreateDocument(
-		c.Request.Context(),
-		userID,
-		req.DocumentType,
-		filename,
-		contentType,
-		base64Content,
-		req.Categories,
-	)
+	// Criar documento; a partir daqui o serviço é responsável pelo conteúdo enviado
+	submitted = true
+	document, err := h.documentService.CreateDocument(c.Request.Context(), service.CreateDocumentInput{
+		UserExternalID:  userID,
+		DocumentType:    req.DocumentType,
+		Filename:        filename,
+		Upload:          upload,
+		Categories:      categoryIDs,
+		ImportProfileID: importProfileID,
+		HouseholdID:     householdID,
+		AccountID:       accountID,
+		Force:           req.Force,
+	})
 	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
+		// Conteúdo já enviado: apontar para o documento existente
+		var duplicate *service.DuplicateDocumentError
+		if errors.As(err, &duplicate) {
+			c.JSON(http.StatusConflict, dto.DocumentConflictResponse{
+				Error:              "Documento com o mesmo conteúdo já enviado",
+				ExistingDocumentID: duplicate.Existing.ExternalID,
+			})
+			return
+		}
+
 		var status int
 		var message string
 
@@ -160,6 +228,24 @@ func (h *DocumentHandler) Create(c *gin.Context) {
 		case service.ErrUserNotFoundForDocument:
 			status = http.StatusNotFound
 			message = "Usuário não encontrado"
+		case service.ErrImportProfileNotFound:
+			status = http.StatusBadRequest
+			message = "Perfil de importação não encontrado"
+		case service.ErrHouseholdNotFound:
+			status = http.StatusBadRequest
+			message = "Família não encontrada"
+		case service.ErrCategoryNotFound:
+			status = http.StatusBadRequest
+			message = "Categoria não encontrada"
+		case service.ErrAccountNotFound:
+			status = http.StatusBadRequest
+			message = "Conta não encontrada"
+		case service.ErrHouseholdWriteDenied:
+			status = http.StatusForbidden
+			message = service.ErrHouseholdWriteDenied.Error()
+		case service.ErrDuplicateDocument:
+			status = http.StatusConflict
+			message = "Documento com o mesmo conteúdo já enviado"
 		case entity.ErrInvalidDocumentType:
 			status = http.StatusBadRequest
 			message = "Tipo de documento inválido"
@@ -174,24 +260,49 @@ func (h *DocumentHandler) Create(c *gin.Context) {
 			message = fmt.Sprintf("Erro ao criar documento: %v", err)
 		}
 
-		c.JSON(status, gin.H{"error": message})
+		c.JSON(status, dto.ErrorResponse{Error: message})
 		return
 	}
 
 	c.JSON(http.StatusCreated, dto.DocumentFromEntity(document))
 }
 
+// handleUploadError converte os erros de gravação do arquivo em respostas HTTP
+func (h *DocumentHandler) handleUploadError(c *gin.Context, filename string, err error) {
+	if respondAccessError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrUserNotFoundForDocument):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Usuário não encontrado"})
+	case errors.Is(err, entity.ErrInvalidDocumentContent):
+		c.JSON(http.StatusBadRequest, fileError("Arquivo vazio", ""))
+	case errors.Is(err, filetype.ErrTypeMismatch):
+		c.JSON(http.StatusUnsupportedMediaType, fileError("Conteúdo do arquivo não corresponde à extensão", err.Error()))
+	case errors.Is(err, filetype.ErrUnsupportedType), errors.Is(err, service.ErrContentTypeNotAllowed):
+		c.JSON(http.StatusUnsupportedMediaType, fileError("Tipo de arquivo não suportado", err.Error()))
+	default:
+		log.Printf("Erro ao armazenar arquivo %s: %v", filename, err)
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Erro ao armazenar arquivo"})
+	}
+}
+
 // GetByID godoc
 // @Summary      Buscar documento por ID
 // @Description  Retorna um documento pelo seu ID
 // @Tags         documents
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
 // @Param        id        path    string  true   "ID do documento"
 // @Param        detailed  query   bool    false  "Se verdadeiro, inclui o conteúdo do arquivo na resposta (default: false)"
 // @Success      200       {object}  dto.DocumentResponse
 // @Success      200       {object}  dto.DocumentDetailResponse "Quando detailed=true"
 // @Failure      400       {object}  map[string]interface{}
+// @Failure      401       {object}  map[string]interface{}
+// @Failure      403       {object}  map[string]interface{}
 // @Failure      404       {object}  map[string]interface{}
 // @Failure      500       {object}  map[string]interface{}
 // @Router       /documents/{id} [get]
@@ -210,6 +321,9 @@ func (h *DocumentHandler) GetByID(c *gin.Context) {
 	// Buscar documento
 	document, err := h.documentService.GetDocumentByExternalID(c.Request.Context(), documentID)
 	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
 		if err == service.ErrDocumentNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Documento não encontrado"})
 			return
@@ -219,24 +333,36 @@ func (h *DocumentHandler) GetByID(c *gin.Context) {
 	}
 
 	// Retornar a resposta adequada com base no parâmetro 'detailed'
-	if detailed {
-		c.JSON(http.StatusOK, dto.DocumentDetailFromEntity(document))
-	} else {
+	if !detailed {
 		c.JSON(http.StatusOK, dto.DocumentFromEntity(document))
+		return
 	}
+
+	content, err := h.readContent(c, document)
+	if err != nil {
+		h.handleContentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DocumentDetailFromEntity(document, content))
 }
 
 // GetByUserID godoc
 // @Summary      Listar documentos de um usuário
-// @Description  Retorna uma lista paginada de documentos de um usuário específico
+// @Description  Retorna uma lista paginada de documentos de um usuário específico. Com o parâmetro hash, retorna apenas os documentos com esse conteúdo, permitindo verificar duplicatas antes do upload.
 // @Tags         documents
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
 // @Param        id     path      string  true   "ID do usuário"
 // @Param        page   query     int     false  "Página atual (padrão: 1)"
 // @Param        limit  query     int     false  "Limite de itens por página (padrão: 10)"
+// @Param        hash   query     string  false  "Hash SHA-256 do conteúdo do arquivo (hexadecimal)"
 // @Success      200    {object}  dto.DocumentListResponse
 // @Failure      400    {object}  map[string]interface{}
+// @Failure      401    {object}  map[string]interface{}
+// @Failure      403    {object}  map[string]interface{}
 // @Failure      404    {object}  map[string]interface{}
 // @Failure      500    {object}  map[string]interface{}
 // @Router       /users/{id}/documents [get]
@@ -263,9 +389,23 @@ func (h *DocumentHandler) GetByUserID(c *gin.Context) {
 		limit = 10
 	}
 
-	// Buscar documentos do usuário
-	documents, total, err := h.documentService.GetDocumentsByUserExternalID(c.Request.Context(), userID, page, limit)
+	// Buscar documentos do usuário, filtrando pelo hash do conteúdo se informado
+	var documents []*entity.Document
+	var total int
+	if hash := c.Query("hash"); hash != "" {
+		documents, err = h.documentService.GetDocumentsByHash(c.Request.Context(), userID, hash)
+		total = len(documents)
+	} else {
+		documents, total, err = h.documentService.GetDocumentsByUserExternalID(c.Request.Context(), userID, page, limit)
+	}
 	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
+		if err == service.ErrInvalidDocumentHash {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Hash SHA-256 inválido"})
+			return
+		}
 		if err == service.ErrUserNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Usuário não encontrado"})
 			return
@@ -290,14 +430,17 @@ func (h *DocumentHandler) GetByUserID(c *gin.Context) {
 
 // UpdateStatus godoc
 // @Summary      Atualizar status do documento
-// @Description  Atualiza o status de processamento de um documento
+// @Description  Atualiza o status de processamento de um documento. Restrito a administradores.
 // @Tags         documents
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        id      path      string                        true  "ID do documento"
 // @Param        status  body      dto.DocumentStatusUpdateRequest  true  "Novo status"
 // @Success      200     {object}  dto.DocumentResponse
 // @Failure      400     {object}  map[string]interface{}
+// @Failure      401     {object}  map[string]interface{}
+// @Failure      403     {object}  map[string]interface{}
 // @Failure      404     {object}  map[string]interface{}
 // @Failure      500     {object}  map[string]interface{}
 // @Router       /documents/{id}/status [put]
@@ -327,6 +470,8 @@ func (h *DocumentHandler) UpdateStatus(c *gin.Context) {
 		status = entity.DocumentStatusProcessed
 	case "failed":
 		status = entity.DocumentStatusFailed
+	case "unreconciled":
+		status = entity.DocumentStatusUnreconciled
 	default:
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Status inválido"})
 		return
@@ -335,6 +480,9 @@ func (h *DocumentHandler) UpdateStatus(c *gin.Context) {
 	// Atualizar status
 	document, err := h.documentService.UpdateDocumentStatus(c.Request.Context(), documentID, status)
 	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
 		if err == service.ErrDocumentNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Documento não encontrado"})
 			return
@@ -352,9 +500,13 @@ func (h *DocumentHandler) UpdateStatus(c *gin.Context) {
 // @Tags         documents
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
 // @Param        id   path      string  true  "ID do documento"
 // @Success      204  {object}  nil
 // @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
 // @Failure      404  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Router       /documents/{id} [delete]
@@ -369,6 +521,9 @@ func (h *DocumentHandler) Delete(c *gin.Context) {
 
 	// Excluir documento
 	if err := h.documentService.DeleteDocument(c.Request.Context(), documentID); err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
 		if err == service.ErrDocumentNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Documento não encontrado"})
 			return
@@ -382,13 +537,16 @@ func (h *DocumentHandler) Delete(c *gin.Context) {
 
 // List godoc
 // @Summary      Listar documentos
-// @Description  Retorna uma lista paginada de todos os documentos
+// @Description  Retorna uma lista paginada dos documentos de todos os usuários. Restrito a suporte e administradores.
 // @Tags         documents
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        page   query     int  false  "Página atual (padrão: 1)"
 // @Param        limit  query     int  false  "Limite de itens por página (padrão: 10)"
 // @Success      200    {object}  dto.DocumentListResponse
+// @Failure      401    {object}  map[string]interface{}
+// @Failure      403    {object}  map[string]interface{}
 // @Failure      500    {object}  map[string]interface{}
 // @Router       /documents [get]
 func (h *DocumentHandler) List(c *gin.Context) {
@@ -409,6 +567,9 @@ func (h *DocumentHandler) List(c *gin.Context) {
 	// Listar documentos
 	documents, total, err := h.documentService.ListDocuments(c.Request.Context(), page, limit)
 	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -429,15 +590,24 @@ func (h *DocumentHandler) List(c *gin.Context) {
 
 // DownloadDocument godoc
 // @Summary      Download do documento
-// @Description  Retorna o arquivo do documento para download
+// @Description  Retorna o arquivo do documento para download em streaming. Suporta requisições parciais (Range) e requisições condicionais pelo ETag (If-None-Match), que corresponde ao hash SHA-256 do conteúdo. Indisponível para o suporte.
 // @Tags         documents
 // @Accept       json
 // @Produce      octet-stream
-// @Param        id   path      string  true  "ID do documento"
-// @Success      200  {file}    binary
-// @Failure      400  {object}  map[string]interface{}
-// @Failure      404  {object}  map[string]interface{}
-// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id             path      string  true   "ID do documento"
+// @Param        Range          header    string  false  "Trecho do arquivo (ex: bytes=0-1023)"
+// @Param        If-None-Match  header    string  false  "ETag de uma cópia já obtida"
+// @Success      200            {file}    binary
+// @Success      206            {file}    binary  "Conteúdo parcial"
+// @Success      304            {object}  nil     "Conteúdo não modificado"
+// @Failure      400            {object}  map[string]interface{}
+// @Failure      401            {object}  map[string]interface{}
+// @Failure      403            {object}  map[string]interface{}
+// @Failure      404            {object}  map[string]interface{}
+// @Failure      416            {object}  nil     "Trecho solicitado inválido"
+// @Failure      500            {object}  map[string]interface{}
 // @Router       /documents/{id}/download [get]
 func (h *DocumentHandler) DownloadDocument(c *gin.Context) {
 	// Obter ID do documento
@@ -451,6 +621,9 @@ func (h *DocumentHandler) DownloadDocument(c *gin.Context) {
 	// Buscar documento
 	document, err := h.documentService.GetDocumentByExternalID(c.Request.Context(), documentID)
 	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
 		if err == service.ErrDocumentNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Documento não encontrado"})
 			return
@@ -459,18 +632,118 @@ func (h *DocumentHandler) DownloadDocument(c *gin.Context) {
 		return
 	}
 
-	// Converter o conteúdo base64 para binário
-	fileContent, err := base64.StdEncoding.DecodeString(document.FileContent)
+	// Abrir o conteúdo no armazenamento
+	content, err := h.documentService.OpenDocumentContent(c.Request.Context(), document)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao processar conteúdo do arquivo"})
+		h.handleContentError(c, err)
 		return
 	}
+	defer content.Close()
 
-	// Configurar cabeçalhos para download
-	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", document.Filename))
+	// Enviar o arquivo com os cabeçalhos de download; ServeContent trata
+	// Range, If-None-Match e HEAD
 	c.Header("Content-Type", document.ContentType)
-	c.Header("Content-Length", strconv.Itoa(len(fileContent)))
+	c.Header("Content-Disposition", contentDisposition("attachment", document.Filename))
+	if document.SHA256 != "" {
+		c.Header("ETag", `"`+document.SHA256+`"`)
+	}
+	http.ServeContent(c.Writer, c.Request, "", document.CreatedAt, content)
+}
+
+// readContent lê todo o conteúdo do documento do armazenamento
+func (h *DocumentHandler) readContent(c *gin.Context, document *entity.Document) ([]byte, error) {
+	reader, err := h.documentService.OpenDocumentContent(c.Request.Context(), document)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+func (h *DocumentHandler) handleContentError(c *gin.Context, err error) {
+	if respondAccessError(c, err) {
+		return
+	}
+	if errors.Is(err, storage.ErrBlobNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Conteúdo do documento não encontrado"})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao ler conteúdo do arquivo"})
+}
+
+// formError monta a resposta para formulários multipart malformados
+func formError(err error) dto.ErrorResponse {
+	return dto.ErrorResponse{
+		Error:   "Erro ao processar formulário",
+		Details: []dto.ErrorDetail{{Field: "form", Message: err.Error()}},
+	}
+}
+
+// fileError monta a resposta para problemas com o arquivo enviado
+func fileError(message, detail string) dto.ErrorResponse {
+	response := dto.ErrorResponse{Error: message}
+	if detail != "" {
+		response.Details = []dto.ErrorDetail{{Field: "file", Message: detail}}
+	}
+	return response
+}
+
+// parseCategoryIDs converte os IDs de categoria enviados no formulário
+func parseCategoryIDs(values []string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for _, value := range values {
+		for _, field := range strings.Split(value, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+			id, err := uuid.Parse(field)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// readFormValue lê o valor de um campo de texto do formulário
+func readFormValue(part io.Reader) (string, error) {
+	value, err := io.ReadAll(io.LimitReader(part, maxFormValueSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(value) > maxFormValueSize {
+		return "", errors.New("campo do formulário muito grande")
+	}
+	return string(value), nil
+}
+
+// uploadLimitReader interrompe a leitura do arquivo quando o limite de tamanho é ultrapassado
+type uploadLimitReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+var errUploadTooLarge = errors.New("arquivo excede o tamanho máximo permitido")
+
+func (r *uploadLimitReader) Read(p []byte) (int, error) {
+	if r.exceeded() {
+		return 0, errUploadTooLarge
+	}
+	// Ler um byte além do limite para detectar arquivos maiores
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	if r.exceeded() {
+		return n, errUploadTooLarge
+	}
+	return n, err
+}
 
-	// Enviar o arquivo
-	c.Data(http.StatusOK, document.ContentType, fileContent)
+func (r *uploadLimitReader) exceeded() bool {
+	return r.remaining < 0
 }
@@ -0,0 +1,442 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type HouseholdHandler struct {
+	householdService *service.HouseholdService
+}
+
+func NewHouseholdHandler(householdService *service.HouseholdService) *HouseholdHandler {
+	return &HouseholdHandler{
+		householdService: householdService,
+	}
+}
+
+// Create godoc
+// @Summary      Criar família
+// @Description  Cria uma família para compartilhar documentos e transações; o usuário autenticado se torna o proprietário
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        household  body      dto.HouseholdRequest  true  "Dados da família"
+// @Success      201        {object}  dto.HouseholdResponse
+// @Failure      400        {object}  dto.ErrorResponse
+// @Failure      401        {object}  dto.ErrorResponse
+// @Failure      403        {object}  dto.ErrorResponse
+// @Failure      500        {object}  dto.ErrorResponse
+// @Router       /households [post]
+func (h *HouseholdHandler) Create(c *gin.Context) {
+	var req dto.HouseholdRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	household, err := h.householdService.CreateHousehold(c.Request.Context(), req.Name)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.HouseholdFromEntity(household))
+}
+
+// List godoc
+// @Summary      Listar famílias
+// @Description  Retorna as famílias de que o usuário autenticado participa
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {array}   dto.HouseholdResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /households [get]
+func (h *HouseholdHandler) List(c *gin.Context) {
+	households, err := h.householdService.ListHouseholds(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := make([]dto.HouseholdResponse, len(households))
+	for i, household := range households {
+		response[i] = dto.HouseholdFromEntity(household)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetByID godoc
+// @Summary      Buscar família
+// @Description  Retorna a família e os seus membros
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "ID da família"
+// @Success      200  {object}  dto.HouseholdDetailResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /households/{id} [get]
+func (h *HouseholdHandler) GetByID(c *gin.Context) {
+	householdID, ok := parseUUIDParam(c, "id", "ID de família inválido")
+	if !ok {
+		return
+	}
+
+	household, members, err := h.householdService.GetHousehold(c.Request.Context(), householdID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.HouseholdDetailFromEntity(household, members))
+}
+
+// Delete godoc
+// @Summary      Excluir família
+// @Description  Exclui a família; os documentos compartilhados voltam a pertencer apenas a quem os enviou. Restrito aos proprietários
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "ID da família"
+// @Success      204  {object}  nil
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /households/{id} [delete]
+func (h *HouseholdHandler) Delete(c *gin.Context) {
+	householdID, ok := parseUUIDParam(c, "id", "ID de família inválido")
+	if !ok {
+		return
+	}
+
+	if err := h.householdService.DeleteHousehold(c.Request.Context(), householdID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ChangeMemberRole godoc
+// @Summary      Alterar papel de membro
+// @Description  Altera o papel de um membro da família. Restrito aos proprietários
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string                          true  "ID da família"
+// @Param        userId  path      string                          true  "ID do usuário"
+// @Param        role    body      dto.HouseholdMemberRoleRequest  true  "Novo papel"
+// @Success      200     {object}  dto.HouseholdMemberResponse
+// @Failure      400     {object}  dto.ErrorResponse
+// @Failure      401     {object}  dto.ErrorResponse
+// @Failure      403     {object}  dto.ErrorResponse
+// @Failure      404     {object}  dto.ErrorResponse
+// @Failure      409     {object}  dto.ErrorResponse "A família ficaria sem proprietário"
+// @Failure      500     {object}  dto.ErrorResponse
+// @Router       /households/{id}/members/{userId} [put]
+func (h *HouseholdHandler) ChangeMemberRole(c *gin.Context) {
+	householdID, ok := parseUUIDParam(c, "id", "ID de família inválido")
+	if !ok {
+		return
+	}
+	userID, ok := parseUUIDParam(c, "userId", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	var req dto.HouseholdMemberRoleRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	member, err := h.householdService.ChangeMemberRole(c.Request.Context(), householdID, userID, entity.HouseholdRole(req.Role))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.HouseholdMemberFromEntity(member))
+}
+
+// RemoveMember godoc
+// @Summary      Remover membro
+// @Description  Remove um membro da família. Os proprietários removem qualquer membro; os demais podem apenas sair da família
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string  true  "ID da família"
+// @Param        userId  path      string  true  "ID do usuário"
+// @Success      204     {object}  nil
+// @Failure      400     {object}  dto.ErrorResponse
+// @Failure      401     {object}  dto.ErrorResponse
+// @Failure      403     {object}  dto.ErrorResponse
+// @Failure      404     {object}  dto.ErrorResponse
+// @Failure      409     {object}  dto.ErrorResponse "A família ficaria sem proprietário"
+// @Failure      500     {object}  dto.ErrorResponse
+// @Router       /households/{id}/members/{userId} [delete]
+func (h *HouseholdHandler) RemoveMember(c *gin.Context) {
+	householdID, ok := parseUUIDParam(c, "id", "ID de família inválido")
+	if !ok {
+		return
+	}
+	userID, ok := parseUUIDParam(c, "userId", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	if err := h.householdService.RemoveMember(c.Request.Context(), householdID, userID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Invite godoc
+// @Summary      Convidar para a família
+// @Description  Convida um email para a família com o papel informado e envia o convite por email; o convidado aceita ou recusa o convite a partir da própria conta. Restrito aos proprietários
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id          path      string                          true  "ID da família"
+// @Param        invitation  body      dto.HouseholdInvitationRequest  true  "Dados do convite"
+// @Success      201         {object}  dto.HouseholdInvitationResponse
+// @Failure      400         {object}  dto.ErrorResponse
+// @Failure      401         {object}  dto.ErrorResponse
+// @Failure      403         {object}  dto.ErrorResponse
+// @Failure      404         {object}  dto.ErrorResponse
+// @Failure      409         {object}  dto.ErrorResponse "Convite pendente ou membro já existente"
+// @Failure      500         {object}  dto.ErrorResponse
+// @Router       /households/{id}/invitations [post]
+func (h *HouseholdHandler) Invite(c *gin.Context) {
+	householdID, ok := parseUUIDParam(c, "id", "ID de família inválido")
+	if !ok {
+		return
+	}
+
+	var req dto.HouseholdInvitationRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	invitation, err := h.householdService.InviteMember(c.Request.Context(), householdID, req.Email, entity.HouseholdRole(req.Role))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.HouseholdInvitationFromEntity(invitation))
+}
+
+// ListInvitations godoc
+// @Summary      Listar convites da família
+// @Description  Retorna os convites pendentes da família
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "ID da família"
+// @Success      200  {array}   dto.HouseholdInvitationResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /households/{id}/invitations [get]
+func (h *HouseholdHandler) ListInvitations(c *gin.Context) {
+	householdID, ok := parseUUIDParam(c, "id", "ID de família inválido")
+	if !ok {
+		return
+	}
+
+	invitations, err := h.householdService.ListInvitations(c.Request.Context(), householdID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitationsResponse(invitations))
+}
+
+// RevokeInvitation godoc
+// @Summary      Revogar convite
+// @Description  Cancela um convite pendente da família. Restrito aos proprietários
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id            path      string  true  "ID da família"
+// @Param        invitationId  path      string  true  "ID do convite"
+// @Success      204           {object}  nil
+// @Failure      400           {object}  dto.ErrorResponse
+// @Failure      401           {object}  dto.ErrorResponse
+// @Failure      403           {object}  dto.ErrorResponse
+// @Failure      404           {object}  dto.ErrorResponse
+// @Failure      409           {object}  dto.ErrorResponse "Convite já respondido ou expirado"
+// @Failure      500           {object}  dto.ErrorResponse
+// @Router       /households/{id}/invitations/{invitationId} [delete]
+func (h *HouseholdHandler) RevokeInvitation(c *gin.Context) {
+	householdID, ok := parseUUIDParam(c, "id", "ID de família inválido")
+	if !ok {
+		return
+	}
+	invitationID, ok := parseUUIDParam(c, "invitationId", "ID de convite inválido")
+	if !ok {
+		return
+	}
+
+	if err := h.householdService.RevokeInvitation(c.Request.Context(), householdID, invitationID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListMyInvitations godoc
+// @Summary      Listar meus convites
+// @Description  Retorna os convites pendentes enviados para o email do usuário autenticado
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {array}   dto.HouseholdInvitationResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /household-invitations [get]
+func (h *HouseholdHandler) ListMyInvitations(c *gin.Context) {
+	invitations, err := h.householdService.ListMyInvitations(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitationsResponse(invitations))
+}
+
+// AcceptInvitation godoc
+// @Summary      Aceitar convite
+// @Description  Aceita um convite enviado para o email do usuário autenticado, que passa a participar da família
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "ID do convite"
+// @Success      200  {object}  dto.HouseholdResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      409  {object}  dto.ErrorResponse "Convite já respondido ou expirado"
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /household-invitations/{id}/accept [post]
+func (h *HouseholdHandler) AcceptInvitation(c *gin.Context) {
+	invitationID, ok := parseUUIDParam(c, "id", "ID de convite inválido")
+	if !ok {
+		return
+	}
+
+	household, err := h.householdService.AcceptInvitation(c.Request.Context(), invitationID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.HouseholdFromEntity(household))
+}
+
+// DeclineInvitation godoc
+// @Summary      Recusar convite
+// @Description  Recusa um convite enviado para o email do usuário autenticado
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "ID do convite"
+// @Success      204  {object}  nil
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      409  {object}  dto.ErrorResponse "Convite já respondido ou expirado"
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /household-invitations/{id}/decline [post]
+func (h *HouseholdHandler) DeclineInvitation(c *gin.Context) {
+	invitationID, ok := parseUUIDParam(c, "id", "ID de convite inválido")
+	if !ok {
+		return
+	}
+
+	if err := h.householdService.DeclineInvitation(c.Request.Context(), invitationID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// handleError converte erros do serviço de famílias em respostas HTTP
+func (h *HouseholdHandler) handleError(c *gin.Context, err error) {
+	if respondAccessError(c, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, service.ErrHouseholdNotFound),
+		errors.Is(err, service.ErrHouseholdMemberNotFound),
+		errors.Is(err, service.ErrHouseholdInvitationNotFound),
+		errors.Is(err, service.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrLastHouseholdOwner),
+		errors.Is(err, service.ErrAlreadyHouseholdMember),
+		errors.Is(err, service.ErrDuplicateInvitation),
+		errors.Is(err, entity.ErrHouseholdInvitationClosed):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, entity.ErrInvalidHouseholdName),
+		errors.Is(err, entity.ErrInvalidHouseholdRole),
+		errors.Is(err, entity.ErrInvalidInvitationEmail):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+	}
+}
+
+// parseUUIDParam lê um ID externo da URL, respondendo 400 com message quando inválido
+func parseUUIDParam(c *gin.Context, name, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: message})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func invitationsResponse(invitations []*entity.HouseholdInvitation) []dto.HouseholdInvitationResponse {
+	response := make([]dto.HouseholdInvitationResponse, len(invitations))
+	for i, invitation := range invitations {
+		response[i] = dto.HouseholdInvitationFromEntity(invitation)
+	}
+	return response
+}
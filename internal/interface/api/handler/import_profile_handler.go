@@ -0,0 +1,308 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"finance-assistant/internal/pkg/csvimport"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ImportProfileHandler struct {
+	importProfileService *service.ImportProfileService
+}
+
+func NewImportProfileHandler(importProfileService *service.ImportProfileService) *ImportProfileHandler {
+	return &ImportProfileHandler{
+		importProfileService: importProfileService,
+	}
+}
+
+// Create godoc
+// @Summary      Criar perfil de importação
+// @Description  Cadastra o layout de um arquivo CSV de extrato para o usuário
+// @Tags         import-profiles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id       path      string                    true  "ID do usuário"
+// @Param        profile  body      dto.ImportProfileRequest  true  "Layout do arquivo"
+// @Success      201      {object}  dto.ImportProfileResponse
+// @Failure      400      {object}  map[string]interface{}
+// @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
+// @Failure      404      {object}  map[string]interface{}
+// @Failure      500      {object}  map[string]interface{}
+// @Router       /users/{id}/import-profiles [post]
+func (h *ImportProfileHandler) Create(c *gin.Context) {
+	// Obter ID do usuário a partir do parâmetro da URL
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de usuário inválido"})
+		return
+	}
+
+	var req dto.ImportProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de dados inválido", "details": err.Error()})
+		return
+	}
+
+	profile, err := h.importProfileService.CreateProfile(c.Request.Context(), userID, req.Name, req.ToLayout(), req.Currency)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ImportProfileFromEntity(profile))
+}
+
+// List godoc
+// @Summary      Listar perfis de importação
+// @Description  Retorna uma lista paginada dos perfis de importação do usuário
+// @Tags         import-profiles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id     path      string  true   "ID do usuário"
+// @Param        page   query     int     false  "Página atual (padrão: 1)"
+// @Param        limit  query     int     false  "Limite de itens por página (padrão: 10)"
+// @Success      200    {object}  dto.ImportProfileListResponse
+// @Failure      400    {object}  map[string]interface{}
+// @Failure      401    {object}  map[string]interface{}
+// @Failure      403    {object}  map[string]interface{}
+// @Failure      404    {object}  map[string]interface{}
+// @Failure      500    {object}  map[string]interface{}
+// @Router       /users/{id}/import-profiles [get]
+func (h *ImportProfileHandler) List(c *gin.Context) {
+	// Obter ID do usuário a partir do parâmetro da URL
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de usuário inválido"})
+		return
+	}
+
+	page, limit := parsePagination(c)
+
+	profiles, total, err := h.importProfileService.ListProfiles(c.Request.Context(), userID, page, limit)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	// Converter entidades para DTOs
+	response := make([]dto.ImportProfileResponse, len(profiles))
+	for i, profile := range profiles {
+		response[i] = dto.ImportProfileFromEntity(profile)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"import_profiles": response,
+		"total":           total,
+		"page":            page,
+		"limit":           limit,
+	})
+}
+
+// GetByID godoc
+// @Summary      Buscar perfil de importação
+// @Description  Retorna um perfil de importação do usuário
+// @Tags         import-profiles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id         path      string  true  "ID do usuário"
+// @Param        profileId  path      string  true  "ID do perfil"
+// @Success      200        {object}  dto.ImportProfileResponse
+// @Failure      400        {object}  map[string]interface{}
+// @Failure      401        {object}  map[string]interface{}
+// @Failure      403        {object}  map[string]interface{}
+// @Failure      404        {object}  map[string]interface{}
+// @Failure      500        {object}  map[string]interface{}
+// @Router       /users/{id}/import-profiles/{profileId} [get]
+func (h *ImportProfileHandler) GetByID(c *gin.Context) {
+	userID, profileID, ok := parseProfileParams(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.importProfileService.GetProfile(c.Request.Context(), userID, profileID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ImportProfileFromEntity(profile))
+}
+
+// Update godoc
+// @Summary      Atualizar perfil de importação
+// @Description  Substitui o layout de um perfil de importação do usuário
+// @Tags         import-profiles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id         path      string                    true  "ID do usuário"
+// @Param        profileId  path      string                    true  "ID do perfil"
+// @Param        profile    body      dto.ImportProfileRequest  true  "Layout do arquivo"
+// @Success      200        {object}  dto.ImportProfileResponse
+// @Failure      400        {object}  map[string]interface{}
+// @Failure      401        {object}  map[string]interface{}
+// @Failure      403        {object}  map[string]interface{}
+// @Failure      404        {object}  map[string]interface{}
+// @Failure      500        {object}  map[string]interface{}
+// @Router       /users/{id}/import-profiles/{profileId} [put]
+func (h *ImportProfileHandler) Update(c *gin.Context) {
+	userID, profileID, ok := parseProfileParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ImportProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Formato de dados inválido", "details": err.Error()})
+		return
+	}
+
+	profile, err := h.importProfileService.UpdateProfile(c.Request.Context(), userID, profileID, req.Name, req.ToLayout(), req.Currency)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ImportProfileFromEntity(profile))
+}
+
+// Delete godoc
+// @Summary      Excluir perfil de importação
+// @Description  Remove um perfil de importação do usuário
+// @Tags         import-profiles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id         path      string  true  "ID do usuário"
+// @Param        profileId  path      string  true  "ID do perfil"
+// @Success      204        {object}  nil
+// @Failure      400        {object}  map[string]interface{}
+// @Failure      401        {object}  map[string]interface{}
+// @Failure      403        {object}  map[string]interface{}
+// @Failure      404        {object}  map[string]interface{}
+// @Failure      500        {object}  map[string]interface{}
+// @Router       /users/{id}/import-profiles/{profileId} [delete]
+func (h *ImportProfileHandler) Delete(c *gin.Context) {
+	userID, profileID, ok := parseProfileParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.importProfileService.DeleteProfile(c.Request.Context(), userID, profileID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Detect godoc
+// @Summary      Detectar layout de CSV
+// @Description  Analisa um arquivo CSV de exemplo e sugere um perfil de importação (não salvo)
+// @Tags         import-profiles
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id    path      string  true   "ID do usuário"
+// @Param        name  formData  string  false  "Nome sugerido para o perfil"
+// @Param        file  formData  file    true   "Arquivo CSV de exemplo"
+// @Success      200   {object}  dto.ImportProfileResponse
+// @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      403   {object}  map[string]interface{}
+// @Failure      404   {object}  map[string]interface{}
+// @Failure      422   {object}  map[string]interface{}
+// @Failure      500   {object}  map[string]interface{}
+// @Router       /users/{id}/import-profiles/detect [post]
+func (h *ImportProfileHandler) Detect(c *gin.Context) {
+	// Obter ID do usuário a partir do parâmetro da URL
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de usuário inválido"})
+		return
+	}
+
+	// Obter arquivo enviado
+	file, _, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Arquivo não encontrado ou inválido"})
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Erro ao ler arquivo"})
+		return
+	}
+
+	profile, err := h.importProfileService.DetectProfile(c.Request.Context(), userID, c.PostForm("name"), content)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ImportProfileFromEntity(profile))
+}
+
+// parseProfileParams valida os IDs de usuário e perfil da URL
+func parseProfileParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de usuário inválido"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	profileID, err := uuid.Parse(c.Param("profileId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de perfil de importação inválido"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, profileID, true
+}
+
+func (h *ImportProfileHandler) handleError(c *gin.Context, err error) {
+	if respondAccessError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Usuário não encontrado"})
+	case errors.Is(err, service.ErrImportProfileNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Perfil de importação não encontrado"})
+	case errors.Is(err, csvimport.ErrLayoutNotDetected), errors.Is(err, csvimport.ErrEmptyFile):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	case errors.Is(err, csvimport.ErrInvalidDelimiter),
+		errors.Is(err, csvimport.ErrInvalidEncoding),
+		errors.Is(err, csvimport.ErrInvalidDateFormat),
+		errors.Is(err, csvimport.ErrInvalidDecimalSeparator),
+		errors.Is(err, csvimport.ErrMissingDateColumn),
+		errors.Is(err, csvimport.ErrMissingDescription),
+		errors.Is(err, csvimport.ErrMissingAmountColumn),
+		errors.Is(err, entity.ErrInvalidImportProfileName),
+		errors.Is(err, entity.ErrInvalidTransactionCurrency):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+)
+
+type InstallmentHandler struct {
+	installmentService *service.InstallmentService
+}
+
+func NewInstallmentHandler(installmentService *service.InstallmentService) *InstallmentHandler {
+	return &InstallmentHandler{
+		installmentService: installmentService,
+	}
+}
+
+// List godoc
+// @Summary      Listar compras parceladas
+// @Description  Retorna as compras parceladas no cartão identificadas nas transações do usuário ("LOJA X 03/10"), com o saldo restante, a data prevista da última parcela e as parcelas futuras, uma por mês a partir da última importada. Com currency, o saldo restante de cada compra também é informado convertido para a moeda informada pela cotação da data da última parcela importada.
+// @Tags         installments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id                path      string  true   "ID do usuário"
+// @Param        include_finished  query     bool    false  "Incluir as compras já quitadas (padrão: false)"
+// @Param        currency          query     string  false  "Moeda do relatório (código ISO 4217)"
+// @Success      200               {array}   dto.InstallmentPlanResponse
+// @Failure      400               {object}  dto.ErrorResponse
+// @Failure      401               {object}  dto.ErrorResponse
+// @Failure      403               {object}  dto.ErrorResponse
+// @Failure      404               {object}  dto.ErrorResponse
+// @Failure      422               {object}  dto.ErrorResponse
+// @Failure      500               {object}  dto.ErrorResponse
+// @Router       /users/{id}/installments [get]
+func (h *InstallmentHandler) List(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	includeFinished, err := strconv.ParseBool(c.DefaultQuery("include_finished", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Valor de include_finished inválido"})
+		return
+	}
+
+	plans, err := h.installmentService.ListPlans(c.Request.Context(), userID, includeFinished, c.Query("currency"))
+	if err != nil {
+		if respondAccessError(c, err) || respondCurrencyError(c, err) {
+			return
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Usuário não encontrado"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	response := make([]dto.InstallmentPlanResponse, len(plans.Plans))
+	for i, plan := range plans.Plans {
+		response[i] = dto.InstallmentPlanFromEntity(plan)
+		if converted, ok := plans.ConvertedBalance[plan.ID]; ok {
+			balance := dto.MoneyFromEntity(converted)
+			response[i].ConvertedBalance = &balance
+		}
+	}
+	c.JSON(http.StatusOK, response)
+}
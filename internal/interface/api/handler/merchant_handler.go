@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+)
+
+type MerchantHandler struct {
+	merchantService *service.MerchantService
+}
+
+func NewMerchantHandler(merchantService *service.MerchantService) *MerchantHandler {
+	return &MerchantHandler{
+		merchantService: merchantService,
+	}
+}
+
+// ListSpending godoc
+// @Summary      Listar gastos por estabelecimento
+// @Description  Soma as transações do usuário por estabelecimento e moeda, do maior para o menor gasto, opcionalmente em um período. Com currency, os totais de cada estabelecimento são convertidos para a moeda informada pela cotação do dia de cada transação.
+// @Tags         merchants
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id        path      string  true   "ID do usuário"
+// @Param        from      query     string  false  "Data inicial, inclusiva (AAAA-MM-DD)"
+// @Param        to        query     string  false  "Data final, inclusiva (AAAA-MM-DD)"
+// @Param        currency  query     string  false  "Moeda do relatório (código ISO 4217)"
+// @Success      200       {array}   dto.MerchantSpendingResponse
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      401       {object}  dto.ErrorResponse
+// @Failure      403       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      422       {object}  dto.ErrorResponse
+// @Failure      500       {object}  dto.ErrorResponse
+// @Router       /users/{id}/merchants [get]
+func (h *MerchantHandler) ListSpending(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	var query dto.MerchantSpendingQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Período inválido, use datas no formato AAAA-MM-DD"})
+		return
+	}
+
+	spending, err := h.merchantService.ListSpending(c.Request.Context(), userID, query.From, query.To, query.Currency)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MerchantSpendingFromEntities(spending))
+}
+
+// Merge godoc
+// @Summary      Mesclar estabelecimentos
+// @Description  Mescla os estabelecimentos informados no estabelecimento da URL, que passa a reconhecer os seus nomes e recebe as suas transações
+// @Tags         merchants
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id          path      string                    true  "ID do usuário"
+// @Param        merchantId  path      string                    true  "ID do estabelecimento de destino"
+// @Param        merge       body      dto.MerchantMergeRequest  true  "Estabelecimentos mesclados"
+// @Success      200         {object}  dto.MerchantResponse
+// @Failure      400         {object}  dto.ErrorResponse
+// @Failure      401         {object}  dto.ErrorResponse
+// @Failure      403         {object}  dto.ErrorResponse
+// @Failure      404         {object}  dto.ErrorResponse
+// @Failure      500         {object}  dto.ErrorResponse
+// @Router       /users/{id}/merchants/{merchantId}/merge [post]
+func (h *MerchantHandler) Merge(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+	merchantID, ok := parseUUIDParam(c, "merchantId", "ID de estabelecimento inválido")
+	if !ok {
+		return
+	}
+
+	var req dto.MerchantMergeRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	merchant, err := h.merchantService.MergeMerchants(c.Request.Context(), userID, merchantID, req.MerchantIDs)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MerchantFromEntity(merchant))
+}
+
+// Apply godoc
+// @Summary      Reconhecer estabelecimentos
+// @Description  Reconhece o estabelecimento das transações do usuário que ainda não têm um, como as importadas antes do diretório de estabelecimentos
+// @Tags         merchants
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID do usuário"
+// @Success      200  {object}  dto.MerchantAssignmentResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /users/{id}/merchants/apply [post]
+func (h *MerchantHandler) Apply(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	assigned, err := h.merchantService.AssignMerchants(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MerchantAssignmentResponse{Assigned: assigned})
+}
+
+func (h *MerchantHandler) handleError(c *gin.Context, err error) {
+	if respondAccessError(c, err) || respondCurrencyError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Usuário não encontrado"})
+	case errors.Is(err, service.ErrMerchantNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrInvalidMerchantMerge),
+		errors.Is(err, service.ErrInvalidSpendingRange):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+	}
+}
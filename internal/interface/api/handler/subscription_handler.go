@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+)
+
+type SubscriptionHandler struct {
+	subscriptionService *service.SubscriptionService
+}
+
+func NewSubscriptionHandler(subscriptionService *service.SubscriptionService) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		subscriptionService: subscriptionService,
+	}
+}
+
+// List godoc
+// @Summary      Listar assinaturas
+// @Description  Retorna as assinaturas e demais cobranças recorrentes (semanais, mensais ou anuais) detectadas nas transações do usuário, com o valor e a data esperados da próxima cobrança, indicando mudanças de preço e cobranças atrasadas, e o custo mensal total por moeda, ou convertido para a moeda informada em currency, o de cada assinatura pela cotação da data da sua última cobrança. A detecção é refeita a cada documento processado.
+// @Tags         subscriptions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id        path      string  true   "ID do usuário"
+// @Param        currency  query     string  false  "Moeda do relatório (código ISO 4217)"
+// @Success      200       {object}  dto.SubscriptionListResponse
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      401       {object}  dto.ErrorResponse
+// @Failure      403       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      422       {object}  dto.ErrorResponse
+// @Failure      500       {object}  dto.ErrorResponse
+// @Router       /users/{id}/subscriptions [get]
+func (h *SubscriptionHandler) List(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	subscriptions, err := h.subscriptionService.ListSubscriptions(c.Request.Context(), userID, c.Query("currency"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, subscriptionListResponse(subscriptions))
+}
+
+// Detect godoc
+// @Summary      Detectar assinaturas
+// @Description  Refaz a detecção das cobranças recorrentes com o histórico atual de transações do usuário, por exemplo após corrigir estabelecimentos, e retorna o resultado
+// @Tags         subscriptions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID do usuário"
+// @Success      200  {object}  dto.SubscriptionListResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /users/{id}/subscriptions/detect [post]
+func (h *SubscriptionHandler) Detect(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	subscriptions, err := h.subscriptionService.DetectSubscriptions(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, subscriptionListResponse(subscriptions))
+}
+
+func subscriptionListResponse(subscriptions *service.Subscriptions) dto.SubscriptionListResponse {
+	now := time.Now()
+	response := dto.SubscriptionListResponse{
+		Subscriptions: make([]dto.SubscriptionResponse, len(subscriptions.Series)),
+		MonthlyTotal:  subscriptions.MonthlyCost,
+	}
+	for i, series := range subscriptions.Series {
+		response.Subscriptions[i] = dto.SubscriptionFromEntity(series, now)
+	}
+	return response
+}
+
+func (h *SubscriptionHandler) handleError(c *gin.Context, err error) {
+	if respondAccessError(c, err) || respondCurrencyError(c, err) {
+		return
+	}
+	if errors.Is(err, service.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Usuário não encontrado"})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+}
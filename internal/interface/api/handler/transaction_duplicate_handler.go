@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+)
+
+type TransactionDuplicateHandler struct {
+	duplicateService *service.TransactionDuplicateService
+}
+
+func NewTransactionDuplicateHandler(duplicateService *service.TransactionDuplicateService) *TransactionDuplicateHandler {
+	return &TransactionDuplicateHandler{
+		duplicateService: duplicateService,
+	}
+}
+
+// List godoc
+// @Summary      Listar possíveis duplicatas
+// @Description  Retorna as transações importadas que podem ser as mesmas que outras já importadas de extratos de períodos sobrepostos, com data ou descrição diferentes demais para serem descartadas automaticamente
+// @Tags         transaction-duplicates
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID do usuário"
+// @Success      200  {array}   dto.TransactionDuplicateResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /users/{id}/transaction-duplicates [get]
+func (h *TransactionDuplicateHandler) List(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	duplicates, err := h.duplicateService.ListDuplicates(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := make([]dto.TransactionDuplicateResponse, len(duplicates))
+	for i, duplicate := range duplicates {
+		response[i] = dto.TransactionDuplicateFromEntity(duplicate)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// Merge godoc
+// @Summary      Unir transações duplicadas
+// @Description  Confirma que as transações são a mesma: a importada por último é excluída e seu documento passa a ser uma origem da mantida, que é retornada
+// @Tags         transaction-duplicates
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID da possível duplicata"
+// @Success      200  {object}  dto.TransactionResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      409  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /transaction-duplicates/{id}/merge [post]
+func (h *TransactionDuplicateHandler) Merge(c *gin.Context) {
+	duplicateID, ok := parseUUIDParam(c, "id", "ID de possível duplicata inválido")
+	if !ok {
+		return
+	}
+
+	transaction, err := h.duplicateService.MergeDuplicate(c.Request.Context(), duplicateID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TransactionFromEntity(transaction))
+}
+
+// Dismiss godoc
+// @Summary      Descartar possível duplicata
+// @Description  Indica que as transações são diferentes; as duas são mantidas e o par sai da fila de revisão
+// @Tags         transaction-duplicates
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID da possível duplicata"
+// @Success      200  {object}  dto.TransactionDuplicateResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      409  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /transaction-duplicates/{id}/dismiss [post]
+func (h *TransactionDuplicateHandler) Dismiss(c *gin.Context) {
+	duplicateID, ok := parseUUIDParam(c, "id", "ID de possível duplicata inválido")
+	if !ok {
+		return
+	}
+
+	duplicate, err := h.duplicateService.DismissDuplicate(c.Request.Context(), duplicateID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TransactionDuplicateFromEntity(duplicate))
+}
+
+func (h *TransactionDuplicateHandler) handleError(c *gin.Context, err error) {
+	if respondAccessError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Usuário não encontrado"})
+	case errors.Is(err, service.ErrTransactionDuplicateNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, entity.ErrDuplicateAlreadyReviewed):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{Error: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+	}
+}
@@ -0,0 +1,272 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultSuggestionLimit é o número de categorias sugeridas quando o limite não é informado
+	defaultSuggestionLimit = 3
+	maxSuggestionLimit     = 10
+)
+
+type TransactionHandler struct {
+	transactionService *service.TransactionService
+}
+
+func NewTransactionHandler(transactionService *service.TransactionService) *TransactionHandler {
+	return &TransactionHandler{
+		transactionService: transactionService,
+	}
+}
+
+// GetByUserID godoc
+// @Summary      Listar transações de um usuário
+// @Description  Retorna uma lista paginada das transações extraídas dos documentos de um usuário
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id     path      string  true   "ID do usuário"
+// @Param        page   query     int     false  "Página atual (padrão: 1)"
+// @Param        limit  query     int     false  "Limite de itens por página (padrão: 10)"
+// @Success      200    {object}  dto.TransactionListResponse
+// @Failure      400    {object}  map[string]interface{}
+// @Failure      401    {object}  map[string]interface{}
+// @Failure      403    {object}  map[string]interface{}
+// @Failure      404    {object}  map[string]interface{}
+// @Failure      500    {object}  map[string]interface{}
+// @Router       /users/{id}/transactions [get]
+func (h *TransactionHandler) GetByUserID(c *gin.Context) {
+	// Obter ID do usuário a partir do parâmetro da URL
+	userIDStr := c.Param("id")
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de usuário inválido"})
+		return
+	}
+
+	page, limit := parsePagination(c)
+
+	// Buscar transações do usuário
+	transactions, total, err := h.transactionService.GetTransactionsByUserExternalID(c.Request.Context(), userID, page, limit)
+	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
+		if err == service.ErrUserNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Usuário não encontrado"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Converter entidades para DTOs
+	response := make([]dto.TransactionResponse, len(transactions))
+	for i, transaction := range transactions {
+		response[i] = dto.TransactionFromEntity(transaction)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"transactions": response,
+		"total":        total,
+		"page":         page,
+		"limit":        limit,
+	})
+}
+
+// GetByDocumentID godoc
+// @Summary      Listar transações de um documento
+// @Description  Retorna uma lista paginada das transações extraídas de um documento
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id     path      string  true   "ID do documento"
+// @Param        page   query     int     false  "Página atual (padrão: 1)"
+// @Param        limit  query     int     false  "Limite de itens por página (padrão: 10)"
+// @Success      200    {object}  dto.TransactionListResponse
+// @Failure      400    {object}  map[string]interface{}
+// @Failure      401    {object}  map[string]interface{}
+// @Failure      403    {object}  map[string]interface{}
+// @Failure      404    {object}  map[string]interface{}
+// @Failure      500    {object}  map[string]interface{}
+// @Router       /documents/{id}/transactions [get]
+func (h *TransactionHandler) GetByDocumentID(c *gin.Context) {
+	// Obter ID do documento a partir do parâmetro da URL
+	documentIDStr := c.Param("id")
+	documentID, err := uuid.Parse(documentIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de documento inválido"})
+		return
+	}
+
+	page, limit := parsePagination(c)
+
+	// Buscar transações do documento
+	transactions, total, err := h.transactionService.GetTransactionsByDocumentExternalID(c.Request.Context(), documentID, page, limit)
+	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
+		if err == service.ErrDocumentNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Documento não encontrado"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Converter entidades para DTOs
+	response := make([]dto.TransactionResponse, len(transactions))
+	for i, transaction := range transactions {
+		response[i] = dto.TransactionFromEntity(transaction)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"transactions": response,
+		"total":        total,
+		"page":         page,
+		"limit":        limit,
+	})
+}
+
+// UpdateCategory godoc
+// @Summary      Corrigir categoria de uma transação
+// @Description  Define a categoria da transação. A correção é usada para sugerir categorias e é mantida ao reaplicar as regras de categorização
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id        path      string                          true  "ID da transação"
+// @Param        category  body      dto.TransactionCategoryRequest  true  "Nova categoria"
+// @Success      200       {object}  dto.TransactionResponse
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      401       {object}  dto.ErrorResponse
+// @Failure      403       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      500       {object}  dto.ErrorResponse
+// @Router       /transactions/{id}/category [put]
+func (h *TransactionHandler) UpdateCategory(c *gin.Context) {
+	transactionID, ok := parseUUIDParam(c, "id", "ID de transação inválido")
+	if !ok {
+		return
+	}
+
+	var req dto.TransactionCategoryRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	transaction, err := h.transactionService.UpdateCategory(c.Request.Context(), transactionID, req.CategoryID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TransactionFromEntity(transaction))
+}
+
+// SuggestCategories godoc
+// @Summary      Sugerir categorias para uma transação
+// @Description  Sugere categorias com um classificador treinado com as categorias que o dono da transação informou nos arquivos importados ou corrigiu. Retorna uma lista vazia enquanto não houver exemplos suficientes
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id     path      string  true   "ID da transação"
+// @Param        limit  query     int     false  "Número máximo de sugestões (padrão: 3, máximo: 10)"
+// @Success      200    {array}   dto.CategorySuggestionResponse
+// @Failure      400    {object}  dto.ErrorResponse
+// @Failure      401    {object}  dto.ErrorResponse
+// @Failure      403    {object}  dto.ErrorResponse
+// @Failure      404    {object}  dto.ErrorResponse
+// @Failure      500    {object}  dto.ErrorResponse
+// @Router       /transactions/{id}/category-suggestions [get]
+func (h *TransactionHandler) SuggestCategories(c *gin.Context) {
+	transactionID, ok := parseUUIDParam(c, "id", "ID de transação inválido")
+	if !ok {
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultSuggestionLimit)))
+	if err != nil || limit < 1 || limit > maxSuggestionLimit {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: fmt.Sprintf("Limite inválido, use um valor entre 1 e %d", maxSuggestionLimit)})
+		return
+	}
+
+	suggestions, err := h.transactionService.SuggestCategories(c.Request.Context(), transactionID, limit)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := make([]dto.CategorySuggestionResponse, len(suggestions))
+	for i, suggestion := range suggestions {
+		response[i] = dto.CategorySuggestionFromDomain(suggestion)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// Sources godoc
+// @Summary      Listar origens de uma transação
+// @Description  Lista os documentos que informaram a transação: o que a criou e os de períodos sobrepostos em que ela se repetiu e foi descartada como duplicada
+// @Tags         transactions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID da transação"
+// @Success      200  {array}   dto.TransactionSourceResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /transactions/{id}/sources [get]
+func (h *TransactionHandler) Sources(c *gin.Context) {
+	transactionID, ok := parseUUIDParam(c, "id", "ID de transação inválido")
+	if !ok {
+		return
+	}
+
+	sources, err := h.transactionService.GetTransactionSources(c.Request.Context(), transactionID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := make([]dto.TransactionSourceResponse, len(sources))
+	for i, source := range sources {
+		response[i] = dto.TransactionSourceFromEntity(source)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TransactionHandler) handleError(c *gin.Context, err error) {
+	if respondAccessError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrTransactionNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrCategoryNotFound):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+	}
+}
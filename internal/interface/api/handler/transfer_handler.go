@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+)
+
+type TransferHandler struct {
+	transferService *service.TransferService
+}
+
+func NewTransferHandler(transferService *service.TransferService) *TransferHandler {
+	return &TransferHandler{
+		transferService: transferService,
+	}
+}
+
+// List godoc
+// @Summary      Listar transferências
+// @Description  Retorna as transferências entre contas do usuário, como o pagamento da fatura do cartão a partir da conta corrente, das mais recentes para as mais antigas. As transferências sugeridas e confirmadas não contam como gastos.
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id      path      string  true   "ID do usuário"
+// @Param        status  query     string  false  "Filtra pelo status (suggested, confirmed ou rejected)"
+// @Success      200     {array}   dto.TransferResponse
+// @Failure      400     {object}  dto.ErrorResponse
+// @Failure      401     {object}  dto.ErrorResponse
+// @Failure      403     {object}  dto.ErrorResponse
+// @Failure      404     {object}  dto.ErrorResponse
+// @Failure      500     {object}  dto.ErrorResponse
+// @Router       /users/{id}/transfers [get]
+func (h *TransferHandler) List(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	status := entity.TransferStatus(c.Query("status"))
+	transfers, err := h.transferService.ListTransfers(c.Request.Context(), userID, status)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, transferResponses(transfers))
+}
+
+// Link godoc
+// @Summary      Ligar transferência
+// @Description  Liga manualmente um débito e um crédito do usuário, de mesmo valor e moeda e em contas diferentes, como uma transferência confirmada
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id        path      string                   true  "ID do usuário"
+// @Param        transfer  body      dto.TransferLinkRequest  true  "Transações da transferência"
+// @Success      201       {object}  dto.TransferResponse
+// @Failure      400       {object}  dto.ErrorResponse
+// @Failure      401       {object}  dto.ErrorResponse
+// @Failure      403       {object}  dto.ErrorResponse
+// @Failure      404       {object}  dto.ErrorResponse
+// @Failure      409       {object}  dto.ErrorResponse
+// @Failure      500       {object}  dto.ErrorResponse
+// @Router       /users/{id}/transfers [post]
+func (h *TransferHandler) Link(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	var req dto.TransferLinkRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	transfer, err := h.transferService.LinkTransfer(c.Request.Context(), userID, req.TransactionIDs[0], req.TransactionIDs[1])
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.TransferFromEntity(transfer))
+}
+
+// Detect godoc
+// @Summary      Detectar transferências
+// @Description  Procura em todo o histórico do usuário débitos e créditos de mesmo valor em contas diferentes, com datas próximas e descrições de Pix, TED, DOC ou pagamento de fatura, e retorna as novas sugestões. A detecção também é feita a cada documento processado.
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID do usuário"
+// @Success      200  {array}   dto.TransferResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /users/{id}/transfers/detect [post]
+func (h *TransferHandler) Detect(c *gin.Context) {
+	userID, ok := parseUUIDParam(c, "id", "ID de usuário inválido")
+	if !ok {
+		return
+	}
+
+	transfers, err := h.transferService.DetectTransfers(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, transferResponses(transfers))
+}
+
+// Confirm godoc
+// @Summary      Confirmar transferência
+// @Description  Confirma uma transferência sugerida ou volta a ligar uma rejeitada
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID da transferência"
+// @Success      200  {object}  dto.TransferResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      409  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /transfers/{id}/confirm [post]
+func (h *TransferHandler) Confirm(c *gin.Context) {
+	h.updateStatus(c, entity.TransferStatusConfirmed)
+}
+
+// Reject godoc
+// @Summary      Rejeitar transferência
+// @Description  Desfaz a ligação entre as transações, que voltam a contar como gastos e receitas; o par não volta a ser sugerido
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
+// @Param        id   path      string  true  "ID da transferência"
+// @Success      200  {object}  dto.TransferResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
+// @Router       /transfers/{id}/reject [post]
+func (h *TransferHandler) Reject(c *gin.Context) {
+	h.updateStatus(c, entity.TransferStatusRejected)
+}
+
+func (h *TransferHandler) updateStatus(c *gin.Context, status entity.TransferStatus) {
+	transferID, ok := parseUUIDParam(c, "id", "ID de transferência inválido")
+	if !ok {
+		return
+	}
+
+	transfer, err := h.transferService.UpdateTransferStatus(c.Request.Context(), transferID, status)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TransferFromEntity(transfer))
+}
+
+func transferResponses(transfers []*entity.Transfer) []dto.TransferResponse {
+	response := make([]dto.TransferResponse, len(transfers))
+	for i, transfer := range transfers {
+		response[i] = dto.TransferFromEntity(transfer)
+	}
+	return response
+}
+
+func (h *TransferHandler) handleError(c *gin.Context, err error) {
+	if respondAccessError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Usuário não encontrado"})
+	case errors.Is(err, service.ErrTransferNotFound),
+		errors.Is(err, service.ErrTransactionNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrTransactionAlreadyTransferred):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, entity.ErrInvalidTransferStatus),
+		errors.Is(err, entity.ErrInvalidTransferTransactions),
+		errors.Is(err, entity.ErrInvalidTransferAccounts):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+	}
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"finance-assistant/internal/domain/entity"
 	"finance-assistant/internal/domain/service"
 	"finance-assistant/internal/interface/api/dto"
 	"finance-assistant/internal/pkg/validation"
@@ -54,12 +55,16 @@ func (h *UserHandler) Create(c *gin.Context) {
 		return
 	}
 
-	user, err := h.userService.CreateUser(c.Request.Context(), req.Name, req.Email, req.Phone)
+	user, err := h.userService.CreateUser(c.Request.Context(), req.Name, req.Email, req.Phone, req.Password)
 	if err != nil {
 		if err == service.ErrEmailAlreadyUsed {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
+		if err == entity.ErrInvalidUserPassword {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -73,9 +78,13 @@ func (h *UserHandler) Create(c *gin.Context) {
 // @Tags         users
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
+// @Security     ApiKeyAuth
 // @Param        id   path      string  true  "ID do usuário"
 // @Success      200  {object}  dto.UserResponse
 // @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
 // @Failure      404  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Router       /users/{id} [get]
@@ -89,6 +98,9 @@ func (h *UserHandler) GetByID(c *gin.Context) {
 
 	user, err := h.userService.GetUserByExternalID(c.Request.Context(), externalID)
 	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
 		if err == service.ErrUserNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Usuário não encontrado"})
 			return
@@ -106,10 +118,13 @@ func (h *UserHandler) GetByID(c *gin.Context) {
 // @Tags         users
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        id    path      string                true  "ID do usuário"
 // @Param        user  body      dto.UpdateUserRequest  true  "Dados para atualização"
 // @Success      200   {object}  dto.UserResponse
 // @Failure      400   {object}  map[string]interface{}
+// @Failure      401   {object}  map[string]interface{}
+// @Failure      403   {object}  map[string]interface{}
 // @Failure      404   {object}  map[string]interface{}
 // @Failure      409   {object}  map[string]interface{}
 // @Failure      500   {object}  map[string]interface{}
@@ -130,6 +145,9 @@ func (h *UserHandler) Update(c *gin.Context) {
 
 	user, err := h.userService.UpdateUser(c.Request.Context(), externalID, req.Name, req.Email, req.Phone)
 	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
 		if err == service.ErrUserNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Usuário não encontrado"})
 			return
@@ -151,9 +169,12 @@ func (h *UserHandler) Update(c *gin.Context) {
 // @Tags         users
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        id   path      string  true  "ID do usuário"
 // @Success      204  {object}  nil
 // @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
 // @Failure      404  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Router       /users/{id} [delete]
@@ -166,6 +187,9 @@ func (h *UserHandler) Delete(c *gin.Context) {
 	}
 
 	if err := h.userService.DeleteUser(c.Request.Context(), externalID); err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
 		if err == service.ErrUserNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Usuário não encontrado"})
 			return
@@ -177,15 +201,65 @@ func (h *UserHandler) Delete(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// ChangeRole godoc
+// @Summary      Alterar papel do usuário
+// @Description  Define o papel de um usuário (user, support ou admin). Restrito a administradores, que não podem alterar o próprio papel.
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      string                 true  "ID do usuário"
+// @Param        role  body      dto.ChangeRoleRequest  true  "Novo papel"
+// @Success      200   {object}  dto.UserResponse
+// @Failure      400   {object}  dto.ErrorResponse
+// @Failure      401   {object}  dto.ErrorResponse
+// @Failure      403   {object}  dto.ErrorResponse
+// @Failure      404   {object}  dto.ErrorResponse
+// @Failure      500   {object}  dto.ErrorResponse
+// @Router       /users/{id}/role [put]
+func (h *UserHandler) ChangeRole(c *gin.Context) {
+	externalID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID inválido"})
+		return
+	}
+
+	var req dto.ChangeRoleRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	user, err := h.userService.ChangeUserRole(c.Request.Context(), externalID, entity.UserRole(req.Role))
+	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
+		switch err {
+		case service.ErrUserNotFound:
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Usuário não encontrado"})
+		case service.ErrOwnRoleChange, entity.ErrInvalidUserRole:
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromEntity(user))
+}
+
 // List godoc
 // @Summary      Listar usuários
-// @Description  Retorna uma lista paginada de usuários
+// @Description  Retorna uma lista paginada de todos os usuários. Restrito a suporte e administradores.
 // @Tags         users
 // @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        page   query     int  false  "Página atual (padrão: 1)"
 // @Param        limit  query     int  false  "Limite de itens por página (padrão: 10)"
 // @Success      200    {object}  dto.UserListResponse
+// @Failure      401    {object}  map[string]interface{}
+// @Failure      403    {object}  map[string]interface{}
 // @Failure      500    {object}  map[string]interface{}
 // @Router       /users [get]
 func (h *UserHandler) List(c *gin.Context) {
@@ -204,6 +278,9 @@ func (h *UserHandler) List(c *gin.Context) {
 
 	users, err := h.userService.ListUsers(c.Request.Context(), page, limit)
 	if err != nil {
+		if respondAccessError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/service"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// Authenticate exige um access token (esquema Bearer) ou uma chave de API
+// (esquema ApiKey) no cabeçalho Authorization e coloca o principal autenticado
+// no contexto da requisição, onde os serviços o usam para verificar a posse dos
+// recursos
+func Authenticate(authService *service.AuthService, apiKeyService *service.APIKeyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scheme, credentials, ok := authorizationHeader(c.GetHeader("Authorization"))
+		if !ok {
+			unauthorized(c, auth.ErrUnauthenticated.Error())
+			return
+		}
+
+		var principal *auth.Principal
+		var err error
+		switch {
+		case strings.EqualFold(scheme, "Bearer"):
+			principal, err = authService.Authenticate(c.Request.Context(), credentials)
+		case strings.EqualFold(scheme, "ApiKey"):
+			principal, err = apiKeyService.Authenticate(c.Request.Context(), credentials)
+		default:
+			unauthorized(c, auth.ErrUnauthenticated.Error())
+			return
+		}
+		if err != nil {
+			if errors.Is(err, service.ErrInvalidAccessToken) || errors.Is(err, service.ErrInvalidAPIKey) {
+				unauthorized(c, err.Error())
+				return
+			}
+			log.Printf("Erro ao autenticar requisição: %v", err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Erro ao autenticar requisição"})
+			return
+		}
+
+		c.Request = c.Request.WithContext(auth.WithPrincipal(c.Request.Context(), principal))
+		c.Next()
+	}
+}
+
+// authorizationHeader separa o esquema e as credenciais de um cabeçalho "<esquema> <credenciais>"
+func authorizationHeader(header string) (string, string, bool) {
+	scheme, credentials, found := strings.Cut(header, " ")
+	credentials = strings.TrimSpace(credentials)
+	if !found || credentials == "" {
+		return "", "", false
+	}
+	return scheme, credentials, true
+}
+
+func unauthorized(c *gin.Context, message string) {
+	c.Header("WWW-Authenticate", `Bearer realm="finance-assistant", ApiKey realm="finance-assistant"`)
+	c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{Error: message})
+}
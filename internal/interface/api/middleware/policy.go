@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/interface/api/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// Policy relaciona rotas (método e caminho registrado no Gin) aos papéis que
+// podem acessá-las. Rotas sem regra ficam disponíveis a qualquer usuário
+// autenticado, exceto para papéis somente leitura, que ficam limitados a GET e
+// HEAD; a posse de cada recurso é verificada pelos serviços. Chaves de API só
+// acessam as rotas que exigem um escopo concedido à chave.
+type Policy struct {
+	rules    map[string][]entity.UserRole
+	readOnly map[entity.UserRole]bool
+	scopes   map[string]string
+}
+
+func NewPolicy() *Policy {
+	return &Policy{
+		rules:    make(map[string][]entity.UserRole),
+		readOnly: make(map[entity.UserRole]bool),
+		scopes:   make(map[string]string),
+	}
+}
+
+// Allow restringe a rota aos papéis informados; a regra prevalece sobre ReadOnly
+func (p *Policy) Allow(method, path string, roles ...entity.UserRole) *Policy {
+	p.rules[method+" "+path] = roles
+	return p
+}
+
+// ReadOnly limita o papel a requisições de leitura nas rotas sem regra própria
+func (p *Policy) ReadOnly(role entity.UserRole) *Policy {
+	p.readOnly[role] = true
+	return p
+}
+
+// Scope define o escopo que uma chave de API precisa ter para acessar a rota
+func (p *Policy) Scope(method, path, scope string) *Policy {
+	p.scopes[method+" "+path] = scope
+	return p
+}
+
+// Permits indica se o principal pode acessar a rota
+func (p *Policy) Permits(method, path string, principal *auth.Principal) bool {
+	route := method + " " + path
+
+	if principal.IsAPIKey() {
+		scope, ok := p.scopes[route]
+		if !ok || !principal.HasScope(scope) {
+			return false
+		}
+	}
+
+	if roles, ok := p.rules[route]; ok {
+		return slices.Contains(roles, principal.Role)
+	}
+	if p.readOnly[principal.Role] {
+		return method == http.MethodGet || method == http.MethodHead
+	}
+	return true
+}
+
+// Authorize aplica a política à rota da requisição; deve ser usado após Authenticate
+func Authorize(policy *Policy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.PrincipalFromContext(c.Request.Context())
+		if !ok {
+			unauthorized(c, auth.ErrUnauthenticated.Error())
+			return
+		}
+
+		if !policy.Permits(c.Request.Method, c.FullPath(), principal) {
+			c.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{Error: auth.ErrForbidden.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"finance-assistant/internal/domain/auth"
+	"finance-assistant/internal/domain/entity"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// testPolicy reproduz as regras da política da API usadas nos testes
+func testPolicy() *Policy {
+	return NewPolicy().
+		ReadOnly(entity.RoleSupport).
+		Allow(http.MethodGet, "/api/v1/users", entity.RoleSupport, entity.RoleAdmin).
+		Allow(http.MethodPut, "/api/v1/documents/:id/status", entity.RoleAdmin).
+		Allow(http.MethodGet, "/api/v1/documents/:id/download", entity.RoleUser, entity.RoleAdmin).
+		Allow(http.MethodPut, "/api/v1/auth/password", entity.RoleUser, entity.RoleSupport, entity.RoleAdmin).
+		Scope(http.MethodGet, "/api/v1/documents/:id", entity.ScopeDocumentsRead).
+		Scope(http.MethodGet, "/api/v1/documents/:id/download", entity.ScopeDocumentsRead).
+		Scope(http.MethodDelete, "/api/v1/documents/:id", entity.ScopeDocumentsWrite)
+}
+
+func TestPolicyPermits(t *testing.T) {
+	user := &auth.Principal{UserID: 1, Role: entity.RoleUser}
+	support := &auth.Principal{UserID: 2, Role: entity.RoleSupport}
+	admin := &auth.Principal{UserID: 3, Role: entity.RoleAdmin}
+	readKey := &auth.Principal{UserID: 1, Role: entity.RoleUser, APIKeyID: uuid.New(), Scopes: []string{entity.ScopeDocumentsRead}}
+	supportKey := &auth.Principal{UserID: 2, Role: entity.RoleSupport, APIKeyID: uuid.New(), Scopes: []string{entity.ScopeDocumentsRead, entity.ScopeDocumentsWrite}}
+
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		principal *auth.Principal
+		want      bool
+	}{
+		{name: "usuário em rota sem regra", method: http.MethodDelete, path: "/api/v1/documents/:id", principal: user, want: true},
+		{name: "usuário em rota de operação", method: http.MethodGet, path: "/api/v1/users", principal: user, want: false},
+		{name: "usuário baixa documento", method: http.MethodGet, path: "/api/v1/documents/:id/download", principal: user, want: true},
+		{name: "suporte lê rota sem regra", method: http.MethodGet, path: "/api/v1/documents/:id", principal: support, want: true},
+		{name: "suporte não altera rota sem regra", method: http.MethodDelete, path: "/api/v1/documents/:id", principal: support, want: false},
+		{name: "suporte lista usuários", method: http.MethodGet, path: "/api/v1/users", principal: support, want: true},
+		{name: "suporte altera a própria senha", method: http.MethodPut, path: "/api/v1/auth/password", principal: support, want: true},
+		{name: "suporte não baixa documento", method: http.MethodGet, path: "/api/v1/documents/:id/download", principal: support, want: false},
+		{name: "suporte não altera status", method: http.MethodPut, path: "/api/v1/documents/:id/status", principal: support, want: false},
+		{name: "administrador altera status", method: http.MethodPut, path: "/api/v1/documents/:id/status", principal: admin, want: true},
+		{name: "chave com escopo", method: http.MethodGet, path: "/api/v1/documents/:id", principal: readKey, want: true},
+		{name: "chave sem escopo", method: http.MethodDelete, path: "/api/v1/documents/:id", principal: readKey, want: false},
+		{name: "chave em rota sem escopo", method: http.MethodPut, path: "/api/v1/auth/password", principal: readKey, want: false},
+		{name: "chave de usuário baixa documento", method: http.MethodGet, path: "/api/v1/documents/:id/download", principal: readKey, want: true},
+		{name: "chave de suporte não baixa documento", method: http.MethodGet, path: "/api/v1/documents/:id/download", principal: supportKey, want: false},
+		{name: "chave de suporte continua somente leitura", method: http.MethodDelete, path: "/api/v1/documents/:id", principal: supportKey, want: false},
+	}
+
+	policy := testPolicy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.Permits(tt.method, tt.path, tt.principal); got != tt.want {
+				t.Errorf("Permits(%s %s, %s) = %v, esperado %v", tt.method, tt.path, tt.principal.Role, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		want      int
+	}{
+		{name: "sem principal", want: http.StatusUnauthorized},
+		{name: "permitido", principal: &auth.Principal{UserID: 1, Role: entity.RoleUser}, want: http.StatusOK},
+		{name: "negado", principal: &auth.Principal{UserID: 2, Role: entity.RoleSupport}, want: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/api/v1/documents/:id/download", func(c *gin.Context) {
+				if tt.principal != nil {
+					c.Request = c.Request.WithContext(auth.WithPrincipal(c.Request.Context(), tt.principal))
+				}
+				c.Next()
+			}, Authorize(testPolicy()), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/documents/42/download", nil))
+			if recorder.Code != tt.want {
+				t.Errorf("status = %d, esperado %d", recorder.Code, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package inhttp
 
 import (
+	"net/http"
+
+	"finance-assistant/internal/domain/entity"
+	"finance-assistant/internal/domain/service"
 	"finance-assistant/internal/interface/api/dto"
 	"finance-assistant/internal/interface/api/handler"
 	"finance-assistant/internal/interface/api/middleware"
@@ -12,7 +16,26 @@ import (
 	_ "finance-assistant/docs"
 )
 
-func SetupRouter(userHandler *handler.UserHandler, documentHandler *handler.DocumentHandler, systemHandler *handler.SystemHandler) *gin.Engine {
+func SetupRouter(
+	authService *service.AuthService,
+	apiKeyService *service.APIKeyService,
+	authHandler *handler.AuthHandler,
+	userHandler *handler.UserHandler,
+	documentHandler *handler.DocumentHandler,
+	transactionHandler *handler.TransactionHandler,
+	importProfileHandler *handler.ImportProfileHandler,
+	apiKeyHandler *handler.APIKeyHandler,
+	householdHandler *handler.HouseholdHandler,
+	categoryHandler *handler.CategoryHandler,
+	categoryRuleHandler *handler.CategoryRuleHandler,
+	merchantHandler *handler.MerchantHandler,
+	installmentHandler *handler.InstallmentHandler,
+	subscriptionHandler *handler.SubscriptionHandler,
+	accountHandler *handler.AccountHandler,
+	transferHandler *handler.TransferHandler,
+	transactionDuplicateHandler *handler.TransactionDuplicateHandler,
+	systemHandler *handler.SystemHandler,
+) *gin.Engine {
 	router := gin.Default()
 
 	// Configurar tamanho máximo de upload (10MB)
@@ -48,8 +71,11 @@ func SetupRouter(userHandler *handler.UserHandler, documentHandler *handler.Docu
 		c.JSON(200, dto.HealthResponse{Status: "ok"})
 	})
 
+	authenticate := middleware.Authenticate(authService, apiKeyService)
+	authorize := middleware.Authorize(accessPolicy())
+
 	// Endpoints de sistema
-	system := router.Group("/system")
+	system := router.Group("/system", authenticate, authorize)
 	{
 		system.GET("/kafka", systemHandler.KafkaStatus)
 	}
@@ -57,25 +83,140 @@ func SetupRouter(userHandler *handler.UserHandler, documentHandler *handler.Docu
 	// API v1
 	v1 := router.Group("/api/v1")
 	{
+		// Autenticação e cadastro (públicos)
+		authRoutes := v1.Group("/auth")
+		{
+			authRoutes.POST("/login", authHandler.Login)
+			authRoutes.POST("/refresh", authHandler.Refresh)
+			authRoutes.POST("/logout", authHandler.Logout)
+		}
+		v1.POST("/users", userHandler.Create)
+
+		// Demais rotas exigem um access token ou uma chave de API e são liberadas
+		// conforme o papel do usuário e os escopos da chave; o acesso aos recursos de outros usuários é verificado pelos serviços
+		protected := v1.Group("", authenticate, authorize)
+
+		protected.PUT("/auth/password", authHandler.ChangePassword)
+
 		// Usuários
-		users := v1.Group("/users")
+		users := protected.Group("/users")
 		{
-			users.POST("", userHandler.Create)
 			users.GET("", userHandler.List)
 			users.GET("/:id", userHandler.GetByID)
 			users.PUT("/:id", userHandler.Update)
 			users.DELETE("/:id", userHandler.Delete)
+			users.PUT("/:id/role", userHandler.ChangeRole)
 			// Documentos por usuário
-			users.POST("/:id/documents", middleware.ProcessArrayFields(), documentHandler.Create)
+			users.POST("/:id/documents", documentHandler.Create)
 			users.GET("/:id/documents", documentHandler.GetByUserID)
+			// Transações por usuário
+			users.GET("/:id/transactions", transactionHandler.GetByUserID)
+			// Perfis de importação CSV por usuário
+			users.POST("/:id/import-profiles", importProfileHandler.Create)
+			users.GET("/:id/import-profiles", importProfileHandler.List)
+			users.POST("/:id/import-profiles/detect", importProfileHandler.Detect)
+			users.GET("/:id/import-profiles/:profileId", importProfileHandler.GetByID)
+			users.PUT("/:id/import-profiles/:profileId", importProfileHandler.Update)
+			users.DELETE("/:id/import-profiles/:profileId", importProfileHandler.Delete)
+
+			// Categorias padrão e do usuário
+			users.POST("/:id/categories", categoryHandler.Create)
+			users.GET("/:id/categories", categoryHandler.List)
+			users.GET("/:id/categories/:categoryId", categoryHandler.GetByID)
+			users.PUT("/:id/categories/:categoryId", categoryHandler.Update)
+			users.DELETE("/:id/categories/:categoryId", categoryHandler.Delete)
+
+			// Regras de categorização do usuário
+			users.POST("/:id/category-rules", categoryRuleHandler.Create)
+			users.GET("/:id/category-rules", categoryRuleHandler.List)
+			users.POST("/:id/category-rules/test", categoryRuleHandler.Test)
+			users.POST("/:id/category-rules/apply", categoryRuleHandler.Apply)
+			users.PUT("/:id/category-rules/:ruleId", categoryRuleHandler.Update)
+			users.DELETE("/:id/category-rules/:ruleId", categoryRuleHandler.Delete)
+			// Estabelecimentos do usuário
+			users.GET("/:id/merchants", merchantHandler.ListSpending)
+			users.POST("/:id/merchants/apply", merchantHandler.Apply)
+			users.POST("/:id/merchants/:merchantId/merge", merchantHandler.Merge)
+			// Compras parceladas do usuário
+			users.GET("/:id/installments", installmentHandler.List)
+			// Assinaturas e demais transações recorrentes do usuário
+			users.GET("/:id/subscriptions", subscriptionHandler.List)
+			users.POST("/:id/subscriptions/detect", subscriptionHandler.Detect)
+			// Transferências entre contas do usuário
+			users.GET("/:id/transfers", transferHandler.List)
+			users.POST("/:id/transfers", transferHandler.Link)
+			users.POST("/:id/transfers/detect", transferHandler.Detect)
+			// Possíveis transações duplicadas aguardando revisão
+			users.GET("/:id/transaction-duplicates", transactionDuplicateHandler.List)
+			// Contas bancárias e cartões do usuário
+			users.POST("/:id/accounts", accountHandler.Create)
+			users.GET("/:id/accounts", accountHandler.List)
+			users.GET("/:id/accounts/:accountId", accountHandler.GetByID)
+			users.PUT("/:id/accounts/:accountId", accountHandler.Update)
+			users.DELETE("/:id/accounts/:accountId", accountHandler.Delete)
+			// Chaves de API por usuário
+			users.POST("/:id/api-keys", apiKeyHandler.Create)
+			users.GET("/:id/api-keys", apiKeyHandler.List)
+			users.DELETE("/:id/api-keys/:keyId", apiKeyHandler.Revoke)
+		}
+
+		// Famílias
+		households := protected.Group("/households")
+		{
+			households.POST("", householdHandler.Create)
+			households.GET("", householdHandler.List)
+			households.GET("/:id", householdHandler.GetByID)
+			households.DELETE("/:id", householdHandler.Delete)
+			households.PUT("/:id/members/:userId", householdHandler.ChangeMemberRole)
+			households.DELETE("/:id/members/:userId", householdHandler.RemoveMember)
+			households.POST("/:id/invitations", householdHandler.Invite)
+			households.GET("/:id/invitations", householdHandler.ListInvitations)
+			households.DELETE("/:id/invitations/:invitationId", householdHandler.RevokeInvitation)
+		}
+
+		// Convites recebidos pelo usuário autenticado
+		invitations := protected.Group("/household-invitations")
+		{
+			invitations.GET("", householdHandler.ListMyInvitations)
+			invitations.POST("/:id/accept", householdHandler.AcceptInvitation)
+			invitations.POST("/:id/decline", householdHandler.DeclineInvitation)
+		}
+
+		// Transações
+		transactions := protected.Group("/transactions")
+		{
+			transactions.PUT("/:id/category", transactionHandler.UpdateCategory)
+			transactions.GET("/:id/category-suggestions", transactionHandler.SuggestCategories)
+			transactions.GET("/:id/sources", transactionHandler.Sources)
+		}
+
+		// Contas
+		accounts := protected.Group("/accounts")
+		{
+			accounts.GET("/:id/reconciliation", accountHandler.Reconciliation)
+		}
+
+		// Transferências
+		transfers := protected.Group("/transfers")
+		{
+			transfers.POST("/:id/confirm", transferHandler.Confirm)
+			transfers.POST("/:id/reject", transferHandler.Reject)
+		}
+
+		// Possíveis transações duplicadas
+		transactionDuplicates := protected.Group("/transaction-duplicates")
+		{
+			transactionDuplicates.POST("/:id/merge", transactionDuplicateHandler.Merge)
+			transactionDuplicates.POST("/:id/dismiss", transactionDuplicateHandler.Dismiss)
 		}
 
 		// Documentos
-		documents := v1.Group("/documents")
+		documents := protected.Group("/documents")
 		{
 			documents.GET("", documentHandler.List)
 			documents.GET("/:id", documentHandler.GetByID)
 			documents.GET("/:id/download", documentHandler.DownloadDocument)
+			documents.GET("/:id/transactions", transactionHandler.GetByDocumentID)
 			documents.PUT("/:id/status", documentHandler.UpdateStatus)
 			documents.DELETE("/:id", documentHandler.Delete)
 		}
@@ -83,3 +224,67 @@ func SetupRouter(userHandler *handler.UserHandler, documentHandler *handler.Docu
 
 	return router
 }
+
+// accessPolicy define os papéis exigidos pelas funções de operação e os escopos
+// exigidos das chaves de API. O suporte tem acesso somente leitura e nunca ao
+// conteúdo dos arquivos; chaves de API não acessam as rotas sem escopo, como as
+// de gerenciamento de conta e das próprias chaves.
+func accessPolicy() *middleware.Policy {
+	return middleware.NewPolicy().
+		ReadOnly(entity.RoleSupport).
+		Allow(http.MethodGet, "/system/kafka", entity.RoleAdmin).
+		Allow(http.MethodGet, "/api/v1/users", entity.RoleSupport, entity.RoleAdmin).
+		Allow(http.MethodPut, "/api/v1/users/:id/role", entity.RoleAdmin).
+		Allow(http.MethodGet, "/api/v1/documents", entity.RoleSupport, entity.RoleAdmin).
+		Allow(http.MethodPut, "/api/v1/documents/:id/status", entity.RoleAdmin).
+		Allow(http.MethodGet, "/api/v1/documents/:id/download", entity.RoleUser, entity.RoleAdmin).
+		Allow(http.MethodPut, "/api/v1/auth/password", entity.RoleUser, entity.RoleSupport, entity.RoleAdmin).
+		Scope(http.MethodGet, "/api/v1/users/:id", entity.ScopeUsersRead).
+		Scope(http.MethodPost, "/api/v1/users/:id/documents", entity.ScopeDocumentsWrite).
+		Scope(http.MethodGet, "/api/v1/users/:id/documents", entity.ScopeDocumentsRead).
+		Scope(http.MethodGet, "/api/v1/documents/:id", entity.ScopeDocumentsRead).
+		Scope(http.MethodGet, "/api/v1/documents/:id/download", entity.ScopeDocumentsRead).
+		Scope(http.MethodDelete, "/api/v1/documents/:id", entity.ScopeDocumentsWrite).
+		Scope(http.MethodGet, "/api/v1/users/:id/transactions", entity.ScopeTransactionsRead).
+		Scope(http.MethodGet, "/api/v1/documents/:id/transactions", entity.ScopeTransactionsRead).
+		Scope(http.MethodGet, "/api/v1/transactions/:id/category-suggestions", entity.ScopeTransactionsRead).
+		Scope(http.MethodGet, "/api/v1/transactions/:id/sources", entity.ScopeTransactionsRead).
+		Scope(http.MethodPut, "/api/v1/transactions/:id/category", entity.ScopeTransactionsWrite).
+		Scope(http.MethodGet, "/api/v1/users/:id/import-profiles", entity.ScopeImportProfilesRead).
+		Scope(http.MethodGet, "/api/v1/users/:id/import-profiles/:profileId", entity.ScopeImportProfilesRead).
+		Scope(http.MethodPost, "/api/v1/users/:id/import-profiles", entity.ScopeImportProfilesWrite).
+		Scope(http.MethodPost, "/api/v1/users/:id/import-profiles/detect", entity.ScopeImportProfilesWrite).
+		Scope(http.MethodPut, "/api/v1/users/:id/import-profiles/:profileId", entity.ScopeImportProfilesWrite).
+		Scope(http.MethodDelete, "/api/v1/users/:id/import-profiles/:profileId", entity.ScopeImportProfilesWrite).
+		Scope(http.MethodGet, "/api/v1/users/:id/categories", entity.ScopeCategoriesRead).
+		Scope(http.MethodGet, "/api/v1/users/:id/categories/:categoryId", entity.ScopeCategoriesRead).
+		Scope(http.MethodPost, "/api/v1/users/:id/categories", entity.ScopeCategoriesWrite).
+		Scope(http.MethodPut, "/api/v1/users/:id/categories/:categoryId", entity.ScopeCategoriesWrite).
+		Scope(http.MethodDelete, "/api/v1/users/:id/categories/:categoryId", entity.ScopeCategoriesWrite).
+		Scope(http.MethodGet, "/api/v1/users/:id/category-rules", entity.ScopeCategoryRulesRead).
+		Scope(http.MethodPost, "/api/v1/users/:id/category-rules/test", entity.ScopeCategoryRulesRead).
+		Scope(http.MethodPost, "/api/v1/users/:id/category-rules", entity.ScopeCategoryRulesWrite).
+		Scope(http.MethodPost, "/api/v1/users/:id/category-rules/apply", entity.ScopeCategoryRulesWrite).
+		Scope(http.MethodPut, "/api/v1/users/:id/category-rules/:ruleId", entity.ScopeCategoryRulesWrite).
+		Scope(http.MethodDelete, "/api/v1/users/:id/category-rules/:ruleId", entity.ScopeCategoryRulesWrite).
+		Scope(http.MethodGet, "/api/v1/users/:id/merchants", entity.ScopeMerchantsRead).
+		Scope(http.MethodPost, "/api/v1/users/:id/merchants/apply", entity.ScopeMerchantsWrite).
+		Scope(http.MethodPost, "/api/v1/users/:id/merchants/:merchantId/merge", entity.ScopeMerchantsWrite).
+		Scope(http.MethodGet, "/api/v1/users/:id/installments", entity.ScopeInstallmentsRead).
+		Scope(http.MethodGet, "/api/v1/users/:id/subscriptions", entity.ScopeSubscriptionsRead).
+		Scope(http.MethodPost, "/api/v1/users/:id/subscriptions/detect", entity.ScopeSubscriptionsWrite).
+		Scope(http.MethodGet, "/api/v1/users/:id/accounts", entity.ScopeAccountsRead).
+		Scope(http.MethodGet, "/api/v1/users/:id/accounts/:accountId", entity.ScopeAccountsRead).
+		Scope(http.MethodPost, "/api/v1/users/:id/accounts", entity.ScopeAccountsWrite).
+		Scope(http.MethodPut, "/api/v1/users/:id/accounts/:accountId", entity.ScopeAccountsWrite).
+		Scope(http.MethodDelete, "/api/v1/users/:id/accounts/:accountId", entity.ScopeAccountsWrite).
+		Scope(http.MethodGet, "/api/v1/accounts/:id/reconciliation", entity.ScopeAccountsRead).
+		Scope(http.MethodGet, "/api/v1/users/:id/transfers", entity.ScopeTransfersRead).
+		Scope(http.MethodPost, "/api/v1/users/:id/transfers", entity.ScopeTransfersWrite).
+		Scope(http.MethodPost, "/api/v1/users/:id/transfers/detect", entity.ScopeTransfersWrite).
+		Scope(http.MethodPost, "/api/v1/transfers/:id/confirm", entity.ScopeTransfersWrite).
+		Scope(http.MethodPost, "/api/v1/transfers/:id/reject", entity.ScopeTransfersWrite).
+		Scope(http.MethodGet, "/api/v1/users/:id/transaction-duplicates", entity.ScopeTransactionsRead).
+		Scope(http.MethodPost, "/api/v1/transaction-duplicates/:id/merge", entity.ScopeTransactionsWrite).
+		Scope(http.MethodPost, "/api/v1/transaction-duplicates/:id/dismiss", entity.ScopeTransactionsWrite)
+}
@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"finance-assistant/internal/domain/messaging"
+	"finance-assistant/internal/domain/service"
+)
+
+// DocumentWorker traduz mensagens do tópico de documentos em chamadas ao serviço de processamento
+type DocumentWorker struct {
+	processingService *service.DocumentProcessingService
+}
+
+func NewDocumentWorker(processingService *service.DocumentProcessingService) *DocumentWorker {
+	return &DocumentWorker{
+		processingService: processingService,
+	}
+}
+
+// Handle processa uma mensagem de documento recebida do broker
+func (w *DocumentWorker) Handle(ctx context.Context, message *messaging.DocumentMessage) error {
+	documentID, err := strconv.ParseInt(message.ID, 10, 64)
+	if err != nil {
+		// Mensagem sem ID válido nunca poderá ser processada; apenas descartar
+		log.Printf("Mensagem com ID de documento inválido (%q), ignorando", message.ID)
+		return nil
+	}
+
+	log.Printf("Mensagem recebida para o documento %s", message.ExternalID)
+	return w.processingService.ProcessDocument(ctx, documentID)
+}
+
+// Fail marca como failed o documento de uma mensagem que esgotou as tentativas de processamento
+func (w *DocumentWorker) Fail(ctx context.Context, message *messaging.DocumentMessage, cause error) error {
+	documentID, err := strconv.ParseInt(message.ID, 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	log.Printf("Marcando documento %s como failed: %v", message.ExternalID, cause)
+	return w.processingService.MarkFailed(ctx, documentID)
+}
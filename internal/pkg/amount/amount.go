@@ -0,0 +1,122 @@
+package amount
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidAmount = errors.New("valor monetário inválido")
+)
+
+// currencySymbols remove símbolos de moeda comuns em extratos (ex: "R$ 1.234,56")
+var currencySymbols = strings.NewReplacer("R$", "", "US$", "", "$", "", "€", "", "£", "", "\u00a0", "")
+
+// minorUnits são as casas decimais das moedas ISO 4217 que não usam centavos
+var minorUnits = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// MinorUnits retorna as casas decimais da moeda segundo a ISO 4217, ou seja,
+// quantas unidades mínimas formam uma unidade (2 para BRL, 0 para JPY, 3 para
+// KWD). Moedas desconhecidas ou não informadas usam 2.
+func MinorUnits(currency string) int {
+	if units, ok := minorUnits[strings.ToUpper(currency)]; ok {
+		return units
+	}
+	return 2
+}
+
+// Parse converte um valor textual (ex: "-1.234,56" ou "1234.56") em unidades
+// mínimas da moeda informada (centavos para BRL, ienes para JPY, ver
+// MinorUnits). decimalSeparator indica o separador decimal usado no texto; o
+// outro separador ('.' ou ',') é tratado como separador de milhar e ignorado.
+// Casas decimais além das da moeda só são aceitas quando são zeros.
+func Parse(value string, decimalSeparator byte, currency string) (int64, error) {
+	value = currencySymbols.Replace(strings.TrimSpace(value))
+	value = strings.ReplaceAll(value, " ", "")
+	if value == "" {
+		return 0, ErrInvalidAmount
+	}
+
+	negative := false
+	switch {
+	case strings.HasPrefix(value, "-"):
+		negative = true
+		value = value[1:]
+	case strings.HasPrefix(value, "+"):
+		value = value[1:]
+	case strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")"):
+		// Formato contábil para valores negativos
+		negative = true
+		value = value[1 : len(value)-1]
+	}
+	if strings.HasSuffix(value, "-") {
+		negative = true
+		value = value[:len(value)-1]
+	}
+
+	thousandsSeparator := ","
+	if decimalSeparator == ',' {
+		thousandsSeparator = "."
+	}
+	value = strings.ReplaceAll(value, thousandsSeparator, "")
+
+	integerPart, fractionPart, _ := strings.Cut(value, string(decimalSeparator))
+	if integerPart == "" {
+		integerPart = "0"
+	}
+	digits := MinorUnits(currency)
+	if len(fractionPart) > digits {
+		if strings.TrimRight(fractionPart[digits:], "0") != "" {
+			return 0, ErrInvalidAmount
+		}
+		fractionPart = fractionPart[:digits]
+	}
+	for len(fractionPart) < digits {
+		fractionPart += "0"
+	}
+
+	units, err := strconv.ParseInt(integerPart, 10, 64)
+	if err != nil || units < 0 {
+		return 0, ErrInvalidAmount
+	}
+	result := units
+	for range digits {
+		result *= 10
+	}
+	if fractionPart != "" {
+		fraction, err := strconv.ParseInt(fractionPart, 10, 64)
+		if err != nil || fraction < 0 {
+			return 0, ErrInvalidAmount
+		}
+		result += fraction
+	}
+
+	if negative {
+		result = -result
+	}
+	return result, nil
+}
+
+// DetectDecimalSeparator infere o separador decimal de um valor isolado:
+// o último separador encontrado é considerado o decimal quando seguido de
+// até duas casas.
+func DetectDecimalSeparator(value string) byte {
+	index := strings.LastIndexAny(value, ".,")
+	if index < 0 {
+		return '.'
+	}
+	if len(strings.TrimRight(value[index+1:], ")- ")) <= 2 {
+		return value[index]
+	}
+	// Separador seguido de três dígitos é de milhar (ex: "1.234")
+	if value[index] == '.' {
+		return ','
+	}
+	return '.'
+}
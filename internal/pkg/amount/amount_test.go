@@ -0,0 +1,50 @@
+package amount
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value     string
+		separator byte
+		currency  string
+		want      int64
+		wantErr   bool
+	}{
+		{value: "-1.234,56", separator: ',', currency: "BRL", want: -123456},
+		{value: "1,234.56", separator: '.', currency: "USD", want: 123456},
+		{value: "R$ 10", separator: ',', currency: "BRL", want: 1000},
+		{value: "(45,90)", separator: ',', currency: "BRL", want: -4590},
+		{value: "45,90-", separator: ',', currency: "BRL", want: -4590},
+		{value: ",5", separator: ',', currency: "BRL", want: 50},
+		{value: "12,340", separator: ',', currency: "BRL", want: 1234},
+		{value: "12,345", separator: ',', currency: "BRL", wantErr: true},
+		{value: "10", separator: ',', currency: "", want: 1000},
+		{value: "1.000", separator: ',', currency: "JPY", want: 1000},
+		{value: "-1500,00", separator: ',', currency: "JPY", want: -1500},
+		{value: "1500,5", separator: ',', currency: "JPY", wantErr: true},
+		{value: "1,234", separator: ',', currency: "KWD", want: 1234},
+		{value: "-2.5", separator: '.', currency: "bhd", want: -2500},
+		{value: "", separator: ',', currency: "BRL", wantErr: true},
+		{value: "abc", separator: ',', currency: "BRL", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value+" "+tt.currency, func(t *testing.T) {
+			got, err := Parse(tt.value, tt.separator, tt.currency)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) erro = %v, esperado erro: %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %d, esperado %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinorUnits(t *testing.T) {
+	for currency, want := range map[string]int{"BRL": 2, "USD": 2, "JPY": 0, "KWD": 3, "CLF": 4, "": 2, "jpy": 0} {
+		if got := MinorUnits(currency); got != want {
+			t.Errorf("MinorUnits(%q) = %d, esperado %d", currency, got, want)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+package csvimport
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrLayoutNotDetected = errors.New("não foi possível identificar o layout do arquivo")
+)
+
+// sampleRows limita quantas linhas são analisadas na detecção
+const sampleRows = 50
+
+// candidateDateFormats em ordem de preferência: o formato brasileiro vem antes
+// do americano para desempatar datas ambíguas como 01/02/2023
+var candidateDateFormats = []string{
+	"dd/mm/yyyy",
+	"yyyy-mm-dd",
+	"dd-mm-yyyy",
+	"dd.mm.yyyy",
+	"mm/dd/yyyy",
+	"dd/mm/yy",
+	"yyyy/mm/dd",
+}
+
+var (
+	commaDecimalPattern = regexp.MustCompile(`^[-+(]?(R\$|US\$|\$)?\s*[-+]?\d{1,3}(\.?\d{3})*,\d{1,2}\)?-?$`)
+	dotDecimalPattern   = regexp.MustCompile(`^[-+(]?(R\$|US\$|\$)?\s*[-+]?\d{1,3}(,?\d{3})*\.\d{1,2}\)?-?$`)
+	integerPattern      = regexp.MustCompile(`^[-+]?\d+$`)
+)
+
+// Palavras-chave de cabeçalho usadas por bancos brasileiros e estrangeiros
+var headerKeywords = map[string][]string{
+	"date":         {"data", "date", "dt", "dia"},
+	"description":  {"descri", "histórico", "historico", "lançamento", "lancamento", "memo", "detalhe", "estabelecimento"},
+	"amount":       {"valor", "value", "amount", "quantia", "montante"},
+	"debit":        {"débito", "debito", "debit", "saída", "saida"},
+	"credit":       {"crédito", "credito", "credit", "entrada"},
+	"counterparty": {"favorecido", "beneficiário", "beneficiario", "payee", "contraparte"},
+	"category":     {"categoria", "category"},
+	"balance":      {"saldo", "balance"},
+}
+
+// Detect sugere um layout a partir do conteúdo do arquivo, usando heurísticas
+// de codificação, delimitador, cabeçalho, formato de data e separador decimal
+func Detect(content []byte) (*Layout, error) {
+	layout := &Layout{Encoding: EncodingUTF8}
+	if !utf8.Valid(content) {
+		layout.Encoding = EncodingWindows1252
+	}
+
+	layout.Delimiter = detectDelimiter(content, layout.Encoding)
+
+	records, err := readRecords(content, layout.Encoding, layout.Delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ignorar linhas de título antes da tabela (ex: "Extrato conta corrente")
+	width := dominantWidth(records)
+	for layout.SkipRows < len(records) && len(records[layout.SkipRows]) < width {
+		layout.SkipRows++
+	}
+	records = records[layout.SkipRows:]
+	if len(records) < 2 {
+		return nil, ErrLayoutNotDetected
+	}
+
+	layout.HasHeader = looksLikeHeader(records[0])
+	data := records
+	if layout.HasHeader {
+		data = records[1:]
+	}
+	if len(data) > sampleRows {
+		data = data[:sampleRows]
+	}
+
+	var header []string
+	if layout.HasHeader {
+		header = records[0]
+		layout.Columns = columnsFromHeader(header)
+	}
+	fillColumnsFromValues(&layout.Columns, header, data, width)
+
+	dateColumn := columnIndex(layout.Columns.Date, records[0], layout.HasHeader)
+	layout.DateFormat = detectDateFormat(columnValues(data, dateColumn))
+
+	amountColumn := layout.Columns.Amount
+	if amountColumn == "" {
+		amountColumn = layout.Columns.Debit
+		if amountColumn == "" {
+			amountColumn = layout.Columns.Credit
+		}
+	}
+	layout.DecimalSeparator = detectDecimalSeparator(columnValues(data, columnIndex(amountColumn, records[0], layout.HasHeader)))
+
+	if err := layout.Validate(); err != nil {
+		return nil, ErrLayoutNotDetected
+	}
+	return layout, nil
+}
+
+// detectDelimiter escolhe o delimitador que divide as linhas de forma mais consistente
+func detectDelimiter(content []byte, encoding string) rune {
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	if len(lines) > sampleRows {
+		lines = lines[:sampleRows]
+	}
+
+	best, bestScore := ';', 0
+	for _, delimiter := range []rune{';', ',', '\t', '|'} {
+		records, err := readRecords([]byte(strings.Join(lines, "\n")), encoding, delimiter)
+		if err != nil || len(records) == 0 {
+			continue
+		}
+		width := dominantWidth(records)
+		if width < 2 {
+			continue
+		}
+		// Pontuação: linhas com a largura dominante, ponderadas pelo número de colunas
+		score := 0
+		for _, record := range records {
+			if len(record) == width {
+				score += width
+			}
+		}
+		if score > bestScore {
+			best, bestScore = delimiter, score
+		}
+	}
+	return best
+}
+
+// dominantWidth retorna o número de colunas mais frequente
+func dominantWidth(records [][]string) int {
+	counts := make(map[int]int)
+	width, maxCount := 0, 0
+	for _, record := range records {
+		counts[len(record)]++
+		if counts[len(record)] > maxCount || (counts[len(record)] == maxCount && len(record) > width) {
+			width, maxCount = len(record), counts[len(record)]
+		}
+	}
+	return width
+}
+
+// looksLikeHeader considera cabeçalho uma linha sem datas nem valores
+func looksLikeHeader(record []string) bool {
+	for _, field := range record {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if isDate(field) || isAmount(field) {
+			return false
+		}
+	}
+	return true
+}
+
+func columnsFromHeader(header []string) Columns {
+	var columns Columns
+	targets := map[string]*string{
+		"date":         &columns.Date,
+		"description":  &columns.Description,
+		"amount":       &columns.Amount,
+		"debit":        &columns.Debit,
+		"credit":       &columns.Credit,
+		"counterparty": &columns.Counterparty,
+		"category":     &columns.Category,
+		"balance":      &columns.Balance,
+	}
+
+	// Ordem fixa para que "valor" não seja confundido com "saldo" etc.
+	for _, field := range []string{"date", "balance", "debit", "credit", "amount", "description", "counterparty", "category"} {
+		for _, name := range header {
+			normalized := strings.ToLower(strings.TrimSpace(name))
+			if normalized == "" || isAssigned(columns, name) {
+				continue
+			}
+			if matchesAny(normalized, headerKeywords[field]) {
+				*targets[field] = strings.TrimSpace(name)
+				break
+			}
+		}
+	}
+
+	// Débito/crédito só fazem sentido juntos quando não há coluna de valor única
+	if columns.Amount != "" {
+		columns.Debit, columns.Credit = "", ""
+	}
+	return columns
+}
+
+// fillColumnsFromValues completa o mapeamento analisando os valores das colunas
+func fillColumnsFromValues(columns *Columns, header []string, data [][]string, width int) {
+	position := func(i int) string {
+		return strconv.Itoa(i + 1)
+	}
+
+	var dateCandidates, amountCandidates, textCandidates []int
+	textLength := make(map[int]int)
+	for i := 0; i < width; i++ {
+		// Colunas já identificadas pelo cabeçalho não são reavaliadas
+		if i < len(header) && isAssigned(*columns, header[i]) {
+			continue
+		}
+		values := columnValues(data, i)
+		if len(values) == 0 {
+			continue
+		}
+		dates, amounts := 0, 0
+		for _, value := range values {
+			if isDate(value) {
+				dates++
+			} else if isAmount(value) {
+				amounts++
+			} else {
+				textLength[i] += len(value)
+			}
+		}
+		switch {
+		case dates*2 > len(values):
+			dateCandidates = append(dateCandidates, i)
+		case amounts*2 > len(values):
+			amountCandidates = append(amountCandidates, i)
+		default:
+			textCandidates = append(textCandidates, i)
+		}
+	}
+
+	if columns.Date == "" && len(dateCandidates) > 0 {
+		columns.Date = position(dateCandidates[0])
+	}
+	if columns.Amount == "" && columns.Debit == "" && columns.Credit == "" && len(amountCandidates) > 0 {
+		columns.Amount = position(amountCandidates[0])
+	}
+	if columns.Description == "" && len(textCandidates) > 0 {
+		// A descrição costuma ser a coluna de texto mais longa
+		best := textCandidates[0]
+		for _, i := range textCandidates[1:] {
+			if textLength[i] > textLength[best] {
+				best = i
+			}
+		}
+		columns.Description = position(best)
+	}
+}
+
+func detectDateFormat(values []string) string {
+	for _, format := range candidateDateFormats {
+		layout, _ := GoDateLayout(format)
+		matches := 0
+		for _, value := range values {
+			if _, err := time.Parse(layout, value); err == nil {
+				matches++
+			}
+		}
+		if len(values) > 0 && matches == len(values) {
+			return format
+		}
+	}
+	return ""
+}
+
+func detectDecimalSeparator(values []string) byte {
+	comma, dot := 0, 0
+	for _, value := range values {
+		switch {
+		case commaDecimalPattern.MatchString(value):
+			comma++
+		case dotDecimalPattern.MatchString(value):
+			dot++
+		}
+	}
+	if dot > comma {
+		return '.'
+	}
+	return ','
+}
+
+func isDate(value string) bool {
+	for _, format := range candidateDateFormats {
+		layout, _ := GoDateLayout(format)
+		if _, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isAmount(value string) bool {
+	value = strings.TrimSpace(value)
+	return commaDecimalPattern.MatchString(value) || dotDecimalPattern.MatchString(value) || integerPattern.MatchString(value)
+}
+
+func isAssigned(columns Columns, name string) bool {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return false
+	}
+	for _, assigned := range []string{columns.Date, columns.Description, columns.Amount, columns.Debit, columns.Credit, columns.Counterparty, columns.Category, columns.Balance} {
+		if assigned == name {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAny(value string, keywords []string) bool {
+	for _, keyword := range keywords {
+		if value == keyword || strings.HasPrefix(value, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// columnIndex resolve a referência de coluna (nome ou posição) para um índice base 0
+func columnIndex(ref string, header []string, hasHeader bool) int {
+	var h []string
+	if hasHeader {
+		h = header
+	}
+	indexes, err := resolveColumns(Columns{Date: ref}, h)
+	if err != nil {
+		return -1
+	}
+	return indexes.date
+}
+
+func columnValues(data [][]string, index int) []string {
+	if index < 0 {
+		return nil
+	}
+	var values []string
+	for _, record := range data {
+		if index < len(record) {
+			if value := strings.TrimSpace(record[index]); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
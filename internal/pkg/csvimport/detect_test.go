@@ -0,0 +1,86 @@
+package csvimport
+
+import "testing"
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    Layout
+	}{
+		{
+			name:    "ponto e vírgula com decimal vírgula",
+			content: "Data;Descrição;Valor\n01/03/2024;PADARIA;-12,50\n02/03/2024;SALARIO;3.500,00\n",
+			want: Layout{
+				Delimiter: ';', Encoding: EncodingUTF8, DateFormat: "dd/mm/yyyy", DecimalSeparator: ',', HasHeader: true,
+				Columns: Columns{Date: "Data", Description: "Descrição", Amount: "Valor"},
+			},
+		},
+		{
+			name:    "vírgula com decimal ponto",
+			content: "Date,Description,Amount\n2024-03-01,BAKERY,-12.50\n2024-03-02,\"SALARY, MARCH\",3500.00\n",
+			want: Layout{
+				Delimiter: ',', Encoding: EncodingUTF8, DateFormat: "yyyy-mm-dd", DecimalSeparator: '.', HasHeader: true,
+				Columns: Columns{Date: "Date", Description: "Description", Amount: "Amount"},
+			},
+		},
+		{
+			name:    "tabulação",
+			content: "Data\tHistórico\tValor\n01/03/2024\tPADARIA\t-12,50\n02/03/2024\tMERCADO\t-80,00\n",
+			want: Layout{
+				Delimiter: '\t', Encoding: EncodingUTF8, DateFormat: "dd/mm/yyyy", DecimalSeparator: ',', HasHeader: true,
+				Columns: Columns{Date: "Data", Description: "Histórico", Amount: "Valor"},
+			},
+		},
+		{
+			name:    "colunas de débito e crédito",
+			content: "Data;Lançamento;Débito;Crédito;Saldo\n01/03/2024;PADARIA;12,50;;987,50\n02/03/2024;SALARIO;;3.500,00;4.487,50\n",
+			want: Layout{
+				Delimiter: ';', Encoding: EncodingUTF8, DateFormat: "dd/mm/yyyy", DecimalSeparator: ',', HasHeader: true,
+				Columns: Columns{Date: "Data", Description: "Lançamento", Debit: "Débito", Credit: "Crédito", Balance: "Saldo"},
+			},
+		},
+		{
+			name:    "linhas de título antes do cabeçalho",
+			content: "Extrato conta corrente\nData;Descrição;Valor\n01/03/2024;PADARIA;-12,50\n02/03/2024;MERCADO;-80,00\n",
+			want: Layout{
+				Delimiter: ';', Encoding: EncodingUTF8, DateFormat: "dd/mm/yyyy", DecimalSeparator: ',', HasHeader: true, SkipRows: 1,
+				Columns: Columns{Date: "Data", Description: "Descrição", Amount: "Valor"},
+			},
+		},
+		{
+			name:    "sem cabeçalho",
+			content: "01/03/2024;PADARIA PAO QUENTE;-12,50\n02/03/2024;MERCADO;-80,00\n",
+			want: Layout{
+				Delimiter: ';', Encoding: EncodingUTF8, DateFormat: "dd/mm/yyyy", DecimalSeparator: ',',
+				Columns: Columns{Date: "1", Description: "2", Amount: "3"},
+			},
+		},
+		{
+			name:    "windows-1252",
+			content: "Data;Hist\xf3rico;Valor\n01/03/2024;PADARIA;-12,50\n02/03/2024;MERCADO;-80,00\n",
+			want: Layout{
+				Delimiter: ';', Encoding: EncodingWindows1252, DateFormat: "dd/mm/yyyy", DecimalSeparator: ',', HasHeader: true,
+				Columns: Columns{Date: "Data", Description: "Histórico", Amount: "Valor"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Detect([]byte(tt.content))
+			if err != nil {
+				t.Fatalf("Detect() erro = %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("Detect() = %+v, esperado %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectUnrecognized(t *testing.T) {
+	if _, err := Detect([]byte("apenas uma linha\n")); err != ErrLayoutNotDetected {
+		t.Errorf("Detect() erro = %v, esperado %v", err, ErrLayoutNotDetected)
+	}
+}
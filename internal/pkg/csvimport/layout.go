@@ -0,0 +1,103 @@
+package csvimport
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	EncodingUTF8        = "utf-8"
+	EncodingWindows1252 = "windows-1252"
+	EncodingISO88591    = "iso-8859-1"
+)
+
+var (
+	ErrInvalidDelimiter        = errors.New("delimitador inválido")
+	ErrInvalidEncoding         = errors.New("codificação inválida")
+	ErrInvalidDateFormat       = errors.New("formato de data inválido")
+	ErrInvalidDecimalSeparator = errors.New("separador decimal inválido")
+	ErrMissingDateColumn       = errors.New("coluna de data não mapeada")
+	ErrMissingDescription      = errors.New("coluna de descrição não mapeada")
+	ErrMissingAmountColumn     = errors.New("coluna de valor (ou débito/crédito) não mapeada")
+)
+
+// Columns mapeia os campos da transação para colunas do arquivo. Cada valor
+// pode ser o nome da coluna no cabeçalho ou sua posição (começando em 1).
+type Columns struct {
+	Date         string `json:"date"`
+	Description  string `json:"description"`
+	Amount       string `json:"amount,omitempty"`
+	Debit        string `json:"debit,omitempty"`
+	Credit       string `json:"credit,omitempty"`
+	Counterparty string `json:"counterparty,omitempty"`
+	Category     string `json:"category,omitempty"`
+	Balance      string `json:"balance,omitempty"`
+}
+
+// Layout descreve como ler um arquivo CSV de extrato
+type Layout struct {
+	Delimiter        rune
+	Encoding         string
+	DateFormat       string // Padrão legível, ex: "dd/mm/yyyy"
+	DecimalSeparator byte
+	HasHeader        bool
+	SkipRows         int // Linhas ignoradas antes do cabeçalho (ou dos dados)
+	Columns          Columns
+}
+
+// Validate verifica se o layout é utilizável
+func (l *Layout) Validate() error {
+	switch l.Delimiter {
+	case ',', ';', '\t', '|':
+	default:
+		return ErrInvalidDelimiter
+	}
+	switch l.Encoding {
+	case EncodingUTF8, EncodingWindows1252, EncodingISO88591:
+	default:
+		return ErrInvalidEncoding
+	}
+	if _, err := GoDateLayout(l.DateFormat); err != nil {
+		return err
+	}
+	if l.DecimalSeparator != ',' && l.DecimalSeparator != '.' {
+		return ErrInvalidDecimalSeparator
+	}
+	if l.Columns.Date == "" {
+		return ErrMissingDateColumn
+	}
+	if l.Columns.Description == "" {
+		return ErrMissingDescription
+	}
+	if l.Columns.Amount == "" && l.Columns.Debit == "" && l.Columns.Credit == "" {
+		return ErrMissingAmountColumn
+	}
+	return nil
+}
+
+// GoDateLayout converte um padrão como "dd/mm/yyyy" para o layout de time.Parse
+func GoDateLayout(format string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		return "", ErrInvalidDateFormat
+	}
+
+	replacer := strings.NewReplacer(
+		"yyyy", "2006",
+		"yy", "06",
+		"mm", "01",
+		"dd", "02",
+	)
+	layout := replacer.Replace(format)
+
+	// O padrão precisa conter dia, mês e ano, e nenhuma outra letra
+	if !strings.Contains(layout, "02") || !strings.Contains(layout, "01") || !strings.Contains(layout, "06") {
+		return "", ErrInvalidDateFormat
+	}
+	for _, r := range layout {
+		if r >= 'a' && r <= 'z' {
+			return "", ErrInvalidDateFormat
+		}
+	}
+	return layout, nil
+}
@@ -0,0 +1,234 @@
+package csvimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"finance-assistant/internal/pkg/amount"
+	"golang.org/x/text/encoding/charmap"
+)
+
+var (
+	ErrColumnNotFound = errors.New("coluna não encontrada no arquivo")
+	ErrEmptyFile      = errors.New("arquivo CSV sem linhas de dados")
+)
+
+// Row representa uma linha de transação lida do arquivo
+type Row struct {
+	Line         int
+	Date         time.Time
+	Amount       int64 // Valor em unidades mínimas da moeda; negativo para débitos
+	Description  string
+	Counterparty string
+	Category     string
+	Balance      *int64
+}
+
+// Read lê o conteúdo CSV conforme o layout informado; os valores são
+// convertidos para as unidades mínimas de currency (ver amount.MinorUnits)
+func Read(content []byte, layout *Layout, currency string) ([]*Row, error) {
+	if err := layout.Validate(); err != nil {
+		return nil, err
+	}
+
+	records, err := readRecords(content, layout.Encoding, layout.Delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	if layout.SkipRows >= len(records) {
+		return nil, ErrEmptyFile
+	}
+	records = records[layout.SkipRows:]
+	firstLine := layout.SkipRows + 1
+
+	var header []string
+	if layout.HasHeader {
+		header = records[0]
+		records = records[1:]
+		firstLine++
+	}
+
+	columns, err := resolveColumns(layout.Columns, header)
+	if err != nil {
+		return nil, err
+	}
+
+	dateLayout, _ := GoDateLayout(layout.DateFormat)
+
+	var rows []*Row
+	for i, record := range records {
+		line := firstLine + i
+		if isBlank(record) {
+			continue
+		}
+
+		row, err := parseRow(record, columns, dateLayout, layout.DecimalSeparator, currency)
+		if err != nil {
+			return nil, fmt.Errorf("linha %d: %w", line, err)
+		}
+		row.Line = line
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrEmptyFile
+	}
+	return rows, nil
+}
+
+// readRecords decodifica o conteúdo e separa as linhas em campos
+func readRecords(content []byte, encoding string, delimiter rune) ([][]string, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	switch encoding {
+	case EncodingWindows1252:
+		decoded, err := charmap.Windows1252.NewDecoder().Bytes(content)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao decodificar arquivo: %w", err)
+		}
+		content = decoded
+	case EncodingISO88591:
+		decoded, err := charmap.ISO8859_1.NewDecoder().Bytes(content)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao decodificar arquivo: %w", err)
+		}
+		content = decoded
+	}
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	var records [][]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("erro ao ler CSV: %w", err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// columnIndexes guarda a posição (base 0) de cada campo; -1 indica ausente
+type columnIndexes struct {
+	date, description, amount, debit, credit, counterparty, category, balance int
+}
+
+func resolveColumns(columns Columns, header []string) (*columnIndexes, error) {
+	resolve := func(ref string) (int, error) {
+		if ref == "" {
+			return -1, nil
+		}
+		if position, err := strconv.Atoi(ref); err == nil && position > 0 {
+			return position - 1, nil
+		}
+		for i, name := range header {
+			if strings.EqualFold(strings.TrimSpace(name), strings.TrimSpace(ref)) {
+				return i, nil
+			}
+		}
+		return -1, fmt.Errorf("%w: %s", ErrColumnNotFound, ref)
+	}
+
+	var indexes columnIndexes
+	var err error
+	for _, field := range []struct {
+		ref    string
+		target *int
+	}{
+		{columns.Date, &indexes.date},
+		{columns.Description, &indexes.description},
+		{columns.Amount, &indexes.amount},
+		{columns.Debit, &indexes.debit},
+		{columns.Credit, &indexes.credit},
+		{columns.Counterparty, &indexes.counterparty},
+		{columns.Category, &indexes.category},
+		{columns.Balance, &indexes.balance},
+	} {
+		if *field.target, err = resolve(field.ref); err != nil {
+			return nil, err
+		}
+	}
+	return &indexes, nil
+}
+
+func parseRow(record []string, columns *columnIndexes, dateLayout string, decimalSeparator byte, currency string) (*Row, error) {
+	field := func(index int) string {
+		if index < 0 || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	date, err := time.Parse(dateLayout, field(columns.date))
+	if err != nil {
+		return nil, fmt.Errorf("data inválida %q", field(columns.date))
+	}
+
+	row := &Row{
+		Date:         date,
+		Description:  field(columns.description),
+		Counterparty: field(columns.counterparty),
+		Category:     field(columns.category),
+	}
+
+	if columns.amount >= 0 {
+		value, err := amount.Parse(field(columns.amount), decimalSeparator, currency)
+		if err != nil {
+			return nil, fmt.Errorf("valor inválido %q", field(columns.amount))
+		}
+		row.Amount = value
+	} else {
+		// Colunas separadas de débito e crédito: débitos sempre negativos
+		if debit := field(columns.debit); debit != "" {
+			value, err := amount.Parse(debit, decimalSeparator, currency)
+			if err != nil {
+				return nil, fmt.Errorf("débito inválido %q", debit)
+			}
+			if value > 0 {
+				value = -value
+			}
+			row.Amount += value
+		}
+		if credit := field(columns.credit); credit != "" {
+			value, err := amount.Parse(credit, decimalSeparator, currency)
+			if err != nil {
+				return nil, fmt.Errorf("crédito inválido %q", credit)
+			}
+			if value < 0 {
+				value = -value
+			}
+			row.Amount += value
+		}
+	}
+
+	if balance := field(columns.balance); balance != "" {
+		if value, err := amount.Parse(balance, decimalSeparator, currency); err == nil {
+			row.Balance = &value
+		}
+	}
+
+	return row, nil
+}
+
+func isBlank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
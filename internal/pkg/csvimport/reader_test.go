@@ -0,0 +1,105 @@
+package csvimport
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	balance := func(value int64) *int64 { return &value }
+
+	tests := []struct {
+		name     string
+		content  string
+		layout   Layout
+		currency string
+		want     []Row
+	}{
+		{
+			name:    "coluna de valor única",
+			content: "Data;Descrição;Valor\n01/03/2024;PADARIA;-12,50\n02/03/2024;SALARIO;3.500,00\n",
+			layout: Layout{
+				Delimiter: ';', Encoding: EncodingUTF8, DateFormat: "dd/mm/yyyy", DecimalSeparator: ',', HasHeader: true,
+				Columns: Columns{Date: "Data", Description: "Descrição", Amount: "Valor"},
+			},
+			currency: "BRL",
+			want: []Row{
+				{Line: 2, Amount: -1250, Description: "PADARIA"},
+				{Line: 3, Amount: 350000, Description: "SALARIO"},
+			},
+		},
+		{
+			name:    "débito e crédito separados",
+			content: "Data;Lançamento;Débito;Crédito;Saldo\n01/03/2024;PADARIA;12,50;;987,50\n02/03/2024;ESTORNO;-5,00;;982,50\n03/03/2024;SALARIO;;3.500,00;4.482,50\n",
+			layout: Layout{
+				Delimiter: ';', Encoding: EncodingUTF8, DateFormat: "dd/mm/yyyy", DecimalSeparator: ',', HasHeader: true,
+				Columns: Columns{Date: "Data", Description: "Lançamento", Debit: "Débito", Credit: "Crédito", Balance: "Saldo"},
+			},
+			currency: "BRL",
+			want: []Row{
+				{Line: 2, Amount: -1250, Description: "PADARIA", Balance: balance(98750)},
+				{Line: 3, Amount: -500, Description: "ESTORNO", Balance: balance(98250)},
+				{Line: 4, Amount: 350000, Description: "SALARIO", Balance: balance(448250)},
+			},
+		},
+		{
+			name:    "colunas por posição e linha em branco",
+			content: "Extrato\n2024-03-01,BAKERY,-1200\n,,\n2024-03-02,SALARY,1500\n",
+			layout: Layout{
+				Delimiter: ',', Encoding: EncodingUTF8, DateFormat: "yyyy-mm-dd", DecimalSeparator: '.', SkipRows: 1,
+				Columns: Columns{Date: "1", Description: "2", Amount: "3"},
+			},
+			currency: "JPY",
+			want: []Row{
+				{Line: 2, Amount: -1200, Description: "BAKERY"},
+				{Line: 4, Amount: 1500, Description: "SALARY"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := Read([]byte(tt.content), &tt.layout, tt.currency)
+			if err != nil {
+				t.Fatalf("Read() erro = %v", err)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("Read() retornou %d linhas, esperado %d", len(rows), len(tt.want))
+			}
+			for i, want := range tt.want {
+				got := rows[i]
+				if got.Line != want.Line || got.Amount != want.Amount || got.Description != want.Description {
+					t.Errorf("linha %d = {Line:%d Amount:%d Description:%q}, esperado {Line:%d Amount:%d Description:%q}",
+						i, got.Line, got.Amount, got.Description, want.Line, want.Amount, want.Description)
+				}
+				if (got.Balance == nil) != (want.Balance == nil) || (got.Balance != nil && *got.Balance != *want.Balance) {
+					t.Errorf("linha %d: saldo = %v, esperado %v", i, got.Balance, want.Balance)
+				}
+			}
+		})
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	layout := Layout{
+		Delimiter: ';', Encoding: EncodingUTF8, DateFormat: "dd/mm/yyyy", DecimalSeparator: ',', HasHeader: true,
+		Columns: Columns{Date: "Data", Description: "Descrição", Amount: "Valor"},
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{name: "sem dados", content: "Data;Descrição;Valor\n", wantErr: ErrEmptyFile},
+		{name: "coluna ausente", content: "Data;Histórico;Valor\n01/03/2024;PADARIA;-12,50\n", wantErr: ErrColumnNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Read([]byte(tt.content), &layout, "BRL"); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Read() erro = %v, esperado %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package merchant
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// processorPrefix remove o prefixo das subadquirentes e carteiras digitais
+	// que intermediam o pagamento (ex: "PAG*JoseDaSilva", "IFD*IFOOD.COM")
+	processorPrefix = regexp.MustCompile(`(?i)^(PAG|PAGSEGURO|IFD|MP|MERCPAGO|MERCADOPAGO|PICPAY|PP|PAYPAL|SUMUP|EC|PG|EBN|EBANX|DL|HTM|STONE|SQ|CIELO|REDE)\s*\*\s*`)
+	// installment remove a parcela no fim da descrição (ex: "11/12", "PARC 03/10", "03 DE 10")
+	// ou, quando indicada, no início (ex: "PARC 03/10 LOJA")
+	installment       = regexp.MustCompile(`(?i)\s+(PARC(?:ELA)?\.?\s*)?(\d{1,2})\s*(/|DE)\s*(\d{1,2})\s*$`)
+	installmentPrefix = regexp.MustCompile(`(?i)^(PARC(?:ELA)?\.?\s*)(\d{1,2})\s*(/|DE)\s*(\d{1,2})\s+`)
+	// dateContext identifica a palavra que antecede uma data no fim da
+	// descrição (ex: "PIX ENVIADO EM 05/11", "COMPRA 05/11")
+	dateContext = regexp.MustCompile(`(?i)(?:^|\s)(?:EM|DIA|DATA|DT|VENC(?:TO)?|COMPRA|SAQUE|PIX|TED|DOC|TRANSF\w*|PAGTO|PAGAMENTO|DEB(?:ITO)?|CRED(?:ITO)?)\.?$`)
+	// columnGap separa as colunas de descrições de cartão de largura fixa
+	// (ex: "PADARIA PAO QUENTE      SAO PAULO    SP")
+	columnGap = regexp.MustCompile(`\s{2,}`)
+	// cardMask remove números de cartão mascarados (ex: "****1234", "XXXX1234", "FINAL 1234")
+	cardMask = regexp.MustCompile(`(?i)(\s|^)([*X]{2,}[\s*X-]*\d{2,4}|FINAL\s+\d{4})(\s|$)`)
+	// domain identifica endereços como "HELP.UBER.COM" e "IFOOD.COM.BR"
+	domain = regexp.MustCompile(`(?i)^[a-z0-9-]+(\.[a-z0-9-]+)*\.(COM|NET|ORG|BR|IO|APP)(\.BR)?$`)
+)
+
+// states são as siglas das unidades federativas e do país que os extratos de
+// cartão acrescentam ao fim da descrição
+var states = map[string]bool{
+	"AC": true, "AL": true, "AP": true, "AM": true, "BA": true, "CE": true, "DF": true,
+	"ES": true, "GO": true, "MA": true, "MT": true, "MS": true, "MG": true, "PA": true,
+	"PB": true, "PR": true, "PE": true, "PI": true, "RJ": true, "RN": true, "RS": true,
+	"RO": true, "RR": true, "SC": true, "SP": true, "SE": true, "TO": true,
+	"BR": true, "BRA": true, "BRASIL": true,
+}
+
+// cities são as cidades mais frequentes no fim das descrições de cartão,
+// comparadas sem acentos
+var cities = []string{
+	"SAO PAULO", "RIO DE JANEIRO", "BELO HORIZONTE", "BRASILIA", "CURITIBA",
+	"PORTO ALEGRE", "SALVADOR", "RECIFE", "FORTALEZA", "GOIANIA", "FLORIANOPOLIS",
+	"MANAUS", "BELEM", "VITORIA", "CAMPINAS", "OSASCO", "BARUERI", "SANTOS",
+	"NITEROI", "GUARULHOS", "SAO BERNARDO", "SANTO ANDRE", "CUIABA", "NATAL",
+	"JOAO PESSOA", "MACEIO", "ARACAJU", "TERESINA", "SAO LUIS", "CAMPO GRANDE",
+}
+
+// Normalize extrai o nome do estabelecimento de uma descrição de extrato,
+// removendo o prefixo da subadquirente, a parcela, o cartão mascarado, os
+// endereços web e a cidade e o estado no fim. Nomes todos em maiúsculas são
+// convertidos para iniciais maiúsculas. Retorna "" quando não sobra um nome.
+func Normalize(description string) string {
+	name := strings.TrimSpace(description)
+	name = processorPrefix.ReplaceAllString(name, "")
+	name = installment.ReplaceAllString(name, "")
+	name = installmentPrefix.ReplaceAllString(name, "")
+	name = trimLocationColumn(name)
+	name = strings.Join(strings.Fields(name), " ")
+	for cardMask.MatchString(name) {
+		name = cardMask.ReplaceAllString(name, " ")
+	}
+
+	// O texto após o "*" restante detalha a compra (ex: "UBER *TRIP")
+	if before, _, ok := strings.Cut(name, "*"); ok && strings.TrimSpace(before) != "" {
+		name = before
+	}
+
+	fields := strings.Fields(name)
+	var words []string
+	for i, field := range fields {
+		if domain.MatchString(field) {
+			// Um endereço no início é o próprio estabelecimento (ex: "NETFLIX.COM")
+			if i == 0 {
+				words = append(words, strings.Split(field, ".")[0])
+				break
+			}
+			continue
+		}
+		words = append(words, field)
+	}
+
+	words = trimLocation(words)
+	for len(words) > 0 && isNumber(words[len(words)-1]) {
+		words = words[:len(words)-1]
+	}
+
+	name = strings.Trim(strings.Join(words, " "), " -*./")
+	if !strings.ContainsFunc(name, unicode.IsLetter) {
+		return ""
+	}
+	if name == strings.ToUpper(name) {
+		name = titleCase(name)
+	}
+	return name
+}
+
+const (
+	// maxInstallments é o maior número de parcelas aceito quando a descrição
+	// indica a parcela ("PARC 03/10", "03 DE 10")
+	maxInstallments = 48
+	// maxBareInstallments é o maior total aceito em um "NN/MM" sem indicação;
+	// valores maiores são tratados como datas ou outros números
+	maxBareInstallments = 24
+)
+
+// ParseInstallment identifica a parcela de uma compra parcelada na descrição
+// (ex: "LOJA X 03/10" ou "PARC 03/10 LOJA X" é a parcela 3 de 10). Um "NN/MM"
+// sem "PARC" ou "DE" só é uma parcela até 24 vezes e quando não é precedido de
+// uma palavra que indica data (ex: "PIX ENVIADO EM 05/11").
+func ParseInstallment(description string) (number, total int, ok bool) {
+	description = strings.Join(strings.Fields(description), " ")
+	match := installment.FindStringSubmatchIndex(description)
+	if match == nil {
+		match = installmentPrefix.FindStringSubmatchIndex(description)
+	}
+	if match == nil {
+		return 0, 0, false
+	}
+
+	group := func(i int) string {
+		if match[2*i] < 0 {
+			return ""
+		}
+		return description[match[2*i]:match[2*i+1]]
+	}
+	bare := group(1) == "" && group(3) == "/"
+	number, _ = strconv.Atoi(group(2))
+	total, _ = strconv.Atoi(group(4))
+
+	limit := maxInstallments
+	if bare {
+		if dateContext.MatchString(description[:match[0]]) {
+			return 0, 0, false
+		}
+		limit = maxBareInstallments
+	}
+	if total < 2 || total > limit || number < 1 || number > total {
+		return 0, 0, false
+	}
+	return number, total, true
+}
+
+// Key normaliza o nome de um estabelecimento para comparação: minúsculas, sem
+// acentos, sem pontuação e com espaços simples
+func Key(name string) string {
+	var builder strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(name)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			builder.WriteRune(r)
+		default:
+			builder.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(builder.String()), " ")
+}
+
+// trimLocationColumn remove as últimas colunas de uma descrição de largura
+// fixa enquanto elas forem a cidade ou o estado do estabelecimento
+func trimLocationColumn(description string) string {
+	for {
+		gaps := columnGap.FindAllStringIndex(description, -1)
+		if len(gaps) == 0 {
+			return description
+		}
+		last := gaps[len(gaps)-1]
+		if !isLocation(strings.Fields(description[last[1]:])) {
+			return description
+		}
+		description = description[:last[0]]
+	}
+}
+
+// isLocation indica se as palavras são um estado ou uma cidade, seguida ou
+// não do estado
+func isLocation(words []string) bool {
+	if len(words) == 0 {
+		return false
+	}
+	if states[strings.ToUpper(words[len(words)-1])] {
+		return true
+	}
+	return slices.Contains(cities, strings.ToUpper(Key(strings.Join(words, " "))))
+}
+
+// trimLocation remove o estado do fim da descrição e, antes dele, a cidade,
+// mantendo ao menos uma palavra. Uma cidade sem o estado depois faz parte do
+// nome (ex: "DROGARIA SAO PAULO").
+func trimLocation(words []string) []string {
+	state := false
+	for len(words) > 1 && states[strings.ToUpper(words[len(words)-1])] {
+		words = words[:len(words)-1]
+		state = true
+	}
+	if !state {
+		return words
+	}
+
+	tail := strings.ToUpper(Key(strings.Join(words, " ")))
+	for _, city := range cities {
+		if !strings.HasSuffix(tail, " "+city) {
+			continue
+		}
+		size := len(strings.Fields(city))
+		if len(words) > size {
+			words = words[:len(words)-size]
+		}
+		break
+	}
+	return words
+}
+
+func isNumber(word string) bool {
+	return strings.IndexFunc(word, func(r rune) bool { return !unicode.IsDigit(r) }) < 0
+}
+
+// titleCase converte cada palavra para inicial maiúscula (ex: "PADARIA PAO" para "Padaria Pao")
+func titleCase(name string) string {
+	words := strings.Fields(strings.ToLower(name))
+	for i, word := range words {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,90 @@
+package merchant
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		description string
+		want        string
+	}{
+		{description: "PAG*JoseDaSilva", want: "JoseDaSilva"},
+		{description: "IFD*IFOOD.COM", want: "Ifood"},
+		{description: "UBER *TRIP HELP.UBER.COM", want: "Uber"},
+		{description: "NETFLIX.COM", want: "Netflix"},
+		{description: "LOJA X 03/10", want: "Loja X"},
+		{description: "PARC 03/10 LOJA X", want: "Loja X"},
+		{description: "MERCADO CENTRAL ****1234", want: "Mercado Central"},
+		{description: "PADARIA PAO QUENTE SAO PAULO SP", want: "Padaria Pao Quente"},
+		{description: "PADARIA PAO QUENTE      SAO PAULO    SP", want: "Padaria Pao Quente"},
+		{description: "PADARIA PAO QUENTE      CURITIBA", want: "Padaria Pao Quente"},
+		{description: "PADARIA PAO QUENTE BR", want: "Padaria Pao Quente"},
+		{description: "DROGARIA SAO PAULO", want: "Drogaria Sao Paulo"},
+		{description: "DROGARIA SAO PAULO      SAO PAULO    BR", want: "Drogaria Sao Paulo"},
+		{description: "RESTAURANTE RIO DE JANEIRO", want: "Restaurante Rio De Janeiro"},
+		{description: "Padaria do Zé", want: "Padaria do Zé"},
+		{description: "SAO PAULO SP", want: "Sao Paulo"},
+		{description: "1234 5678", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := Normalize(tt.description); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, esperado %q", tt.description, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInstallment(t *testing.T) {
+	tests := []struct {
+		description string
+		number      int
+		total       int
+		ok          bool
+	}{
+		{description: "LOJA X 03/10", number: 3, total: 10, ok: true},
+		{description: "LOJA X PARC 03/10", number: 3, total: 10, ok: true},
+		{description: "LOJA X PARCELA 3 DE 10", number: 3, total: 10, ok: true},
+		{description: "PARC 03/10 LOJA X", number: 3, total: 10, ok: true},
+		{description: "LOJA X 12/24", number: 12, total: 24, ok: true},
+		{description: "LOJA X PARC 12/36", number: 12, total: 36, ok: true},
+		{description: "LOJA X 12/36", ok: false},
+		{description: "LOJA X PARC 12/60", ok: false},
+		{description: "PIX ENVIADO EM 05/11", ok: false},
+		{description: "COMPRA 05/11", ok: false},
+		{description: "SAQUE 24H DIA 05/11", ok: false},
+		{description: "LOJA X 11/05", ok: false},
+		{description: "LOJA X 01/01", ok: false},
+		{description: "LOJA X 00/10", ok: false},
+		{description: "LOJA X", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			number, total, ok := ParseInstallment(tt.description)
+			if number != tt.number || total != tt.total || ok != tt.ok {
+				t.Errorf("ParseInstallment(%q) = %d, %d, %v, esperado %d, %d, %v",
+					tt.description, number, total, ok, tt.number, tt.total, tt.ok)
+			}
+		})
+	}
+}
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Padaria Pão-Quente", want: "padaria pao quente"},
+		{name: "  MERCADO   CENTRAL ", want: "mercado central"},
+		{name: "A.B.C", want: "a b c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Key(tt.name); got != tt.want {
+				t.Errorf("Key(%q) = %q, esperado %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
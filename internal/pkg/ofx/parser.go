@@ -0,0 +1,255 @@
+package ofx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+var (
+	ErrInvalidOFX  = errors.New("arquivo OFX inválido")
+	ErrNoStatement = errors.New("arquivo OFX sem extratos")
+)
+
+var xmlEncodingPattern = regexp.MustCompile(`(?i)encoding\s*=\s*["']([^"']+)["']`)
+
+// aggregates são os agregados OFX conhecidos. No SGML uma folha pode vir vazia
+// e sem fechamento, então só estes elementos abrem um nível na árvore.
+var aggregates = map[string]bool{
+	"OFX":                true,
+	"SIGNONMSGSRSV1":     true,
+	"SONRS":              true,
+	"STATUS":             true,
+	"FI":                 true,
+	"BANKMSGSRSV1":       true,
+	"STMTTRNRS":          true,
+	"STMTRS":             true,
+	"BANKACCTFROM":       true,
+	"BANKACCTTO":         true,
+	"BANKTRANLIST":       true,
+	"STMTTRN":            true,
+	"PAYEE":              true,
+	"CURRENCY":           true,
+	"ORIGCURRENCY":       true,
+	"LEDGERBAL":          true,
+	"AVAILBAL":           true,
+	"BALLIST":            true,
+	"BAL":                true,
+	"CREDITCARDMSGSRSV1": true,
+	"CCSTMTTRNRS":        true,
+	"CCSTMTRS":           true,
+	"CCACCTFROM":         true,
+	"CCACCTTO":           true,
+}
+
+// node representa um elemento OFX; agregados têm filhos e elementos folha têm valor
+type node struct {
+	name     string
+	value    string
+	children []*node
+}
+
+// Parse lê um arquivo OFX 1.x (SGML) ou OFX 2.x (XML) e retorna os extratos encontrados
+func Parse(r io.Reader) (*File, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler arquivo OFX: %w", err)
+	}
+
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	content, err = decode(content)
+	if err != nil {
+		return nil, err
+	}
+
+	body := string(content)
+	start := strings.Index(strings.ToUpper(body), "<OFX>")
+	if start < 0 {
+		return nil, ErrInvalidOFX
+	}
+
+	root := tokenize(body[start:])
+	ofx := root.child("OFX")
+	if ofx == nil {
+		return nil, ErrInvalidOFX
+	}
+
+	file := &File{}
+	for _, stmt := range ofx.findAll("STMTRS") {
+		statement, err := parseStatement(stmt, false)
+		if err != nil {
+			return nil, err
+		}
+		file.Statements = append(file.Statements, statement)
+	}
+	for _, stmt := range ofx.findAll("CCSTMTRS") {
+		statement, err := parseStatement(stmt, true)
+		if err != nil {
+			return nil, err
+		}
+		file.Statements = append(file.Statements, statement)
+	}
+
+	if len(file.Statements) == 0 {
+		return nil, ErrNoStatement
+	}
+	return file, nil
+}
+
+// decode converte o conteúdo para UTF-8 com base no cabeçalho SGML (CHARSET)
+// ou na declaração XML (encoding)
+func decode(content []byte) ([]byte, error) {
+	head := content
+	if len(head) > 512 {
+		head = head[:512]
+	}
+	header := strings.ToUpper(string(head))
+
+	var windows1252, latin1 bool
+	switch {
+	case strings.HasPrefix(strings.TrimSpace(header), "OFXHEADER:"):
+		if strings.Contains(header, "ENCODING:UTF-8") {
+			return content, nil
+		}
+		windows1252 = strings.Contains(header, "CHARSET:1252")
+		latin1 = strings.Contains(header, "CHARSET:ISO-8859-1") || strings.Contains(header, "CHARSET:8859-1")
+	default:
+		if match := xmlEncodingPattern.FindStringSubmatch(header); match != nil {
+			windows1252 = match[1] == "WINDOWS-1252" || match[1] == "CP1252"
+			latin1 = match[1] == "ISO-8859-1" || match[1] == "LATIN1"
+		}
+	}
+
+	switch {
+	case windows1252:
+		decoded, err := charmap.Windows1252.NewDecoder().Bytes(content)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao decodificar arquivo OFX: %w", err)
+		}
+		return decoded, nil
+	case latin1:
+		decoded, err := charmap.ISO8859_1.NewDecoder().Bytes(content)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao decodificar arquivo OFX: %w", err)
+		}
+		return decoded, nil
+	}
+	return content, nil
+}
+
+// tokenize monta a árvore de elementos. Funciona tanto para SGML, em que os
+// elementos folha não são fechados, quanto para XML: apenas os agregados
+// conhecidos abrem um nível; qualquer outro elemento é uma folha, mesmo vazia.
+func tokenize(body string) *node {
+	root := &node{}
+	stack := []*node{root}
+
+	for i := 0; i < len(body); {
+		open := strings.IndexByte(body[i:], '<')
+		if open < 0 {
+			break
+		}
+		i += open
+		end := strings.IndexByte(body[i:], '>')
+		if end < 0 {
+			break
+		}
+		tag := strings.TrimSpace(body[i+1 : i+end])
+		i += end + 1
+
+		if tag == "" || tag[0] == '?' || tag[0] == '!' {
+			continue
+		}
+
+		if tag[0] == '/' {
+			name := strings.ToUpper(strings.TrimSpace(tag[1:]))
+			// Fechar o agregado correspondente; fechamentos de folhas não estão na pilha
+			for j := len(stack) - 1; j > 0; j-- {
+				if stack[j].name == name {
+					stack = stack[:j]
+					break
+				}
+			}
+			continue
+		}
+
+		selfClosing := strings.HasSuffix(tag, "/")
+		name := strings.ToUpper(strings.Fields(strings.TrimSuffix(tag, "/"))[0])
+		element := &node{name: name}
+		parent := stack[len(stack)-1]
+		parent.children = append(parent.children, element)
+		if selfClosing {
+			continue
+		}
+
+		if aggregates[name] {
+			stack = append(stack, element)
+			continue
+		}
+
+		next := strings.IndexByte(body[i:], '<')
+		if next < 0 {
+			next = len(body) - i
+		}
+		element.value = html.UnescapeString(strings.TrimSpace(body[i : i+next]))
+		i += next
+	}
+
+	return root
+}
+
+func (n *node) child(name string) *node {
+	if n == nil {
+		return nil
+	}
+	for _, c := range n.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func (n *node) childrenNamed(name string) []*node {
+	if n == nil {
+		return nil
+	}
+	var result []*node
+	for _, c := range n.children {
+		if c.name == name {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// get percorre o caminho de elementos e retorna o valor da folha encontrada
+func (n *node) get(path ...string) string {
+	current := n
+	for _, name := range path {
+		current = current.child(name)
+		if current == nil {
+			return ""
+		}
+	}
+	return current.value
+}
+
+// findAll busca recursivamente todos os elementos com o nome informado
+func (n *node) findAll(name string) []*node {
+	var result []*node
+	for _, c := range n.children {
+		if c.name == name {
+			result = append(result, c)
+			continue
+		}
+		result = append(result, c.findAll(name)...)
+	}
+	return result
+}
@@ -0,0 +1,231 @@
+package ofx
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var brt = time.FixedZone("", -3*3600)
+
+type wantTransaction struct {
+	fitid    string
+	typ      string
+	posted   time.Time
+	amount   int64
+	name     string
+	memo     string
+	currency string
+}
+
+type wantStatement struct {
+	creditCard    bool
+	accountKey    string
+	accountType   string
+	currency      string
+	start, end    time.Time
+	ledgerBalance int64
+	ledgerDate    time.Time
+	transactions  []wantTransaction
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		fixture    string
+		statements []wantStatement
+	}{
+		{
+			name:    "SGML 1.x em Windows-1252 com folhas sem fechamento",
+			fixture: "bank_sgml_1252.ofx",
+			statements: []wantStatement{{
+				accountKey:    "0341:1234:56789-0",
+				accountType:   "CHECKING",
+				currency:      "BRL",
+				start:         time.Date(2024, 3, 1, 0, 0, 0, 0, brt),
+				end:           time.Date(2024, 3, 31, 23, 59, 59, 0, brt),
+				ledgerBalance: 221954,
+				ledgerDate:    time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+				transactions: []wantTransaction{
+					{fitid: "202403100001", typ: "DEBIT", posted: time.Date(2024, 3, 10, 23, 0, 0, 0, brt), amount: -4590, name: "PAG*PÃO DE AÇÚCAR", memo: "Compra no débito", currency: "BRL"},
+					{fitid: "202403150002", typ: "CREDIT", posted: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), amount: 150000, name: "PIX RECEBIDO", memo: "PIX RECEBIDO", currency: "BRL"},
+					{fitid: "202403200003", typ: "DEBIT", posted: time.Date(2024, 3, 20, 10, 0, 0, 0, brt), amount: -123456, memo: "PAGTO FATURA CARTAO", currency: "BRL"},
+				},
+			}},
+		},
+		{
+			name:    "SGML 1.x com folhas vazias sem fechamento",
+			fixture: "bank_sgml_empty_leaf.ofx",
+			statements: []wantStatement{{
+				accountKey:    "0001:4321:98765-4",
+				accountType:   "SAVINGS",
+				currency:      "BRL",
+				start:         time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+				end:           time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+				ledgerBalance: 124000,
+				ledgerDate:    time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+				transactions: []wantTransaction{
+					{fitid: "202404050001", typ: "DEBIT", posted: time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC), amount: -1000, name: "TARIFA", currency: "BRL"},
+					{fitid: "202404100002", typ: "CREDIT", posted: time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), amount: 25000, currency: "BRL"},
+				},
+			}},
+		},
+		{
+			name:    "XML 2.x de cartão de crédito",
+			fixture: "creditcard_xml.ofx",
+			statements: []wantStatement{{
+				creditCard:    true,
+				accountKey:    "5502********1234",
+				accountType:   "CREDITCARD",
+				currency:      "BRL",
+				start:         time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+				end:           time.Date(2024, 4, 4, 0, 0, 0, 0, time.UTC),
+				ledgerBalance: -17980,
+				ledgerDate:    time.Date(2024, 4, 4, 0, 0, 0, 0, time.UTC),
+				transactions: []wantTransaction{
+					{fitid: "CC-0001", typ: "DEBIT", posted: time.Date(2024, 3, 6, 0, 0, 0, 0, brt), amount: -15990, name: "LOJA X 03/10", currency: "BRL"},
+					{fitid: "CC-0002", typ: "DEBIT", posted: time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC), amount: -3990, name: "NETFLIX.COM", memo: "Assinatura & streaming", currency: "BRL"},
+					{fitid: "CC-0003", typ: "CREDIT", posted: time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), amount: 2000, name: "ESTORNO LOJA X", currency: "USD"},
+				},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			file, err := Parse(strings.NewReader(string(content)))
+			if err != nil {
+				t.Fatalf("Parse() erro = %v", err)
+			}
+			if len(file.Statements) != len(tt.statements) {
+				t.Fatalf("Parse() retornou %d extratos, esperados %d", len(file.Statements), len(tt.statements))
+			}
+
+			for i, want := range tt.statements {
+				got := file.Statements[i]
+				if got.CreditCard != want.creditCard || got.AccountKey() != want.accountKey ||
+					got.AccountType != want.accountType || got.Currency != want.currency {
+					t.Errorf("extrato %d = {cartão %v, conta %q, tipo %q, moeda %q}, esperado {%v, %q, %q, %q}",
+						i, got.CreditCard, got.AccountKey(), got.AccountType, got.Currency,
+						want.creditCard, want.accountKey, want.accountType, want.currency)
+				}
+				if !got.StartDate.Equal(want.start) || !got.EndDate.Equal(want.end) {
+					t.Errorf("período = %v a %v, esperado %v a %v", got.StartDate, got.EndDate, want.start, want.end)
+				}
+				if !got.HasLedgerBalance || got.LedgerBalance != want.ledgerBalance || !got.LedgerBalanceDate.Equal(want.ledgerDate) {
+					t.Errorf("LEDGERBAL = %d em %v (informado: %v), esperado %d em %v",
+						got.LedgerBalance, got.LedgerBalanceDate, got.HasLedgerBalance, want.ledgerBalance, want.ledgerDate)
+				}
+
+				if len(got.Transactions) != len(want.transactions) {
+					t.Fatalf("extrato %d com %d transações, esperadas %d", i, len(got.Transactions), len(want.transactions))
+				}
+				for j, wantTrn := range want.transactions {
+					trn := got.Transactions[j]
+					if trn.FITID != wantTrn.fitid || trn.Type != wantTrn.typ || trn.Amount != wantTrn.amount ||
+						trn.Name != wantTrn.name || trn.Memo != wantTrn.memo || trn.Currency != wantTrn.currency {
+						t.Errorf("transação %d = %+v, esperada %+v", j, *trn, wantTrn)
+					}
+					if !trn.Posted.Equal(wantTrn.posted) {
+						t.Errorf("transação %s DTPOSTED = %v, esperado %v", trn.FITID, trn.Posted, wantTrn.posted)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{name: "sem elemento OFX", content: "OFXHEADER:100\n\n<FOO>", wantErr: ErrInvalidOFX},
+		{name: "sem extratos", content: "<OFX><SIGNONMSGSRSV1></SIGNONMSGSRSV1></OFX>", wantErr: ErrNoStatement},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(tt.content)); err != tt.wantErr {
+				t.Errorf("Parse() erro = %v, esperado %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{value: "20240310", want: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{value: "202403101530", want: time.Date(2024, 3, 10, 15, 30, 0, 0, time.UTC)},
+		{value: "20240310120000[-3:BRT]", want: time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)},
+		{value: "20240310120000.000[-03:BRT]", want: time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)},
+		{value: "20240310120000[+5.5:IST]", want: time.Date(2024, 3, 10, 6, 30, 0, 0, time.UTC)},
+		{value: "20240310120000[0]", want: time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseDate(tt.value)
+			if err != nil {
+				t.Fatalf("parseDate(%q) erro = %v", tt.value, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseDate(%q) = %v, esperado %v", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	for _, value := range []string{"", "2024031", "data"} {
+		if _, err := parseDate(value); err == nil {
+			t.Errorf("parseDate(%q) deveria falhar", value)
+		}
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value    string
+		currency string
+		want     int64
+		wantErr  bool
+	}{
+		{value: "-45.90", currency: "BRL", want: -4590},
+		{value: "-45,90", currency: "BRL", want: -4590},
+		{value: "1500", currency: "BRL", want: 150000},
+		{value: "+12.5", currency: "USD", want: 1250},
+		{value: "-39.900", currency: "BRL", want: -3990},
+		{value: "0.00", currency: "BRL", want: 0},
+		{value: "-12.34", currency: "", want: -1234},
+		{value: "-39.901", currency: "BRL", wantErr: true},
+		{value: "", currency: "BRL", wantErr: true},
+		{value: "1500", currency: "JPY", want: 1500},
+		{value: "-1500.00", currency: "JPY", want: -1500},
+		{value: "1500.5", currency: "JPY", wantErr: true},
+		{value: "-1,234", currency: "KWD", want: -1234},
+		{value: "12.5", currency: "KWD", want: 12500},
+		{value: "1.2345", currency: "KWD", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value+" "+tt.currency, func(t *testing.T) {
+			got, err := parseAmount(tt.value, tt.currency)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAmount(%q, %q) erro = %v, esperado erro: %v", tt.value, tt.currency, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseAmount(%q, %q) = %d, esperado %d", tt.value, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package ofx
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"finance-assistant/internal/pkg/amount"
+)
+
+var timezonePattern = regexp.MustCompile(`\[([+-]?\d+(?:\.\d+)?)(?::[^\]]*)?\]`)
+
+// File representa o conteúdo de um arquivo OFX
+type File struct {
+	Statements []*Statement
+}
+
+// Statement representa um extrato de conta corrente/poupança (STMTRS) ou de cartão de crédito (CCSTMTRS)
+type Statement struct {
+	CreditCard        bool
+	Currency          string
+	BankID            string
+	BranchID          string
+	AccountID         string
+	AccountType       string
+	StartDate         time.Time
+	EndDate           time.Time
+	LedgerBalance     int64
+	LedgerBalanceDate time.Time
+	HasLedgerBalance  bool
+	Transactions      []*Transaction
+}
+
+// Transaction representa um lançamento STMTTRN
+type Transaction struct {
+	Type     string
+	Posted   time.Time
+	Amount   int64 // Valor em unidades mínimas da moeda; negativo para débitos
+	FITID    string
+	Name     string
+	Memo     string
+	CheckNum string
+	RefNum   string
+	Currency string
+}
+
+func parseStatement(stmt *node, creditCard bool) (*Statement, error) {
+	statement := &Statement{
+		CreditCard: creditCard,
+		Currency:   strings.ToUpper(stmt.get("CURDEF")),
+	}
+
+	if creditCard {
+		statement.AccountID = stmt.get("CCACCTFROM", "ACCTID")
+		statement.AccountType = "CREDITCARD"
+	} else {
+		statement.BankID = stmt.get("BANKACCTFROM", "BANKID")
+		statement.BranchID = stmt.get("BANKACCTFROM", "BRANCHID")
+		statement.AccountID = stmt.get("BANKACCTFROM", "ACCTID")
+		statement.AccountType = strings.ToUpper(stmt.get("BANKACCTFROM", "ACCTTYPE"))
+	}
+
+	if balance := stmt.child("LEDGERBAL"); balance != nil {
+		value, err := parseAmount(balance.get("BALAMT"), statement.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("saldo LEDGERBAL inválido: %w", err)
+		}
+		statement.LedgerBalance = value
+		statement.HasLedgerBalance = true
+		statement.LedgerBalanceDate, _ = parseDate(balance.get("DTASOF"))
+	}
+
+	list := stmt.child("BANKTRANLIST")
+	statement.StartDate, _ = parseDate(list.get("DTSTART"))
+	statement.EndDate, _ = parseDate(list.get("DTEND"))
+
+	for _, trn := range list.childrenNamed("STMTTRN") {
+		transaction, err := parseTransaction(trn, statement.Currency)
+		if err != nil {
+			return nil, err
+		}
+		statement.Transactions = append(statement.Transactions, transaction)
+	}
+
+	return statement, nil
+}
+
+// parseTransaction lê um STMTTRN; sem moeda própria, vale a do extrato
+func parseTransaction(trn *node, statementCurrency string) (*Transaction, error) {
+	currency := strings.ToUpper(trn.get("CURRENCY", "CURSYM"))
+	if currency == "" {
+		currency = statementCurrency
+	}
+
+	posted, err := parseDate(trn.get("DTPOSTED"))
+	if err != nil {
+		return nil, fmt.Errorf("DTPOSTED inválido na transação %s: %w", trn.get("FITID"), err)
+	}
+
+	value, err := parseAmount(trn.get("TRNAMT"), currency)
+	if err != nil {
+		return nil, fmt.Errorf("TRNAMT inválido na transação %s: %w", trn.get("FITID"), err)
+	}
+
+	name := trn.get("NAME")
+	if name == "" {
+		name = trn.get("PAYEE", "NAME")
+	}
+
+	return &Transaction{
+		Type:     strings.ToUpper(trn.get("TRNTYPE")),
+		Posted:   posted,
+		Amount:   value,
+		FITID:    trn.get("FITID"),
+		Name:     name,
+		Memo:     trn.get("MEMO"),
+		CheckNum: trn.get("CHECKNUM"),
+		RefNum:   trn.get("REFNUM"),
+		Currency: currency,
+	}, nil
+}
+
+// AccountKey identifica a conta de origem do extrato, combinando banco, agência e conta
+func (s *Statement) AccountKey() string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{s.BankID, s.BranchID, s.AccountID} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ":")
+}
+
+// parseDate interpreta datas OFX no formato AAAAMMDD[HHMMSS[.XXX]][[gmt offset[:tz name]]]
+func parseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("data vazia")
+	}
+
+	location := time.UTC
+	if match := timezonePattern.FindStringSubmatch(value); match != nil {
+		offset, err := strconv.ParseFloat(match[1], 64)
+		if err == nil {
+			location = time.FixedZone("", int(offset*3600))
+		}
+	}
+
+	digits := value
+	if i := strings.IndexAny(digits, ".["); i >= 0 {
+		digits = digits[:i]
+	}
+
+	var layout string
+	switch len(digits) {
+	case 8:
+		layout = "20060102"
+	case 12:
+		layout = "200601021504"
+	case 14:
+		layout = "20060102150405"
+	default:
+		return time.Time{}, fmt.Errorf("formato de data desconhecido: %s", value)
+	}
+
+	return time.ParseInLocation(layout, digits, location)
+}
+
+// parseAmount interpreta valores OFX, que usam ponto ou vírgula como separador
+// decimal e podem trazer mais casas decimais que o necessário (ex: "-45.900"),
+// em unidades mínimas da moeda
+func parseAmount(value, currency string) (int64, error) {
+	value = strings.TrimSpace(value)
+	separator := byte('.')
+	if strings.Contains(value, ",") && !strings.Contains(value, ".") {
+		separator = ','
+	}
+	return amount.Parse(value, separator, currency)
+}
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS transactions (
+                                            id BIGSERIAL PRIMARY KEY,
+                                            external_id UUID NOT NULL DEFAULT gen_random_uuid(),
+                                            user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                            document_id BIGINT NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+                                            transaction_date DATE NOT NULL,
+                                            amount BIGINT NOT NULL, -- Valor em centavos (unidade mínima da moeda); negativo para débitos
+                                            currency CHAR(3) NOT NULL DEFAULT 'BRL',
+                                            description VARCHAR(255) NOT NULL,
+                                            counterparty VARCHAR(255) NOT NULL DEFAULT '',
+                                            category VARCHAR(100) NOT NULL DEFAULT '',
+                                            created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+                                            updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_transactions_external_id ON transactions(external_id);
+CREATE INDEX idx_transactions_user_id_date ON transactions(user_id, transaction_date DESC);
+CREATE INDEX idx_transactions_document_id ON transactions(document_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_transactions_user_account_fitid;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS source_account,
+    DROP COLUMN IF EXISTS fitid;
@@ -0,0 +1,6 @@
+ALTER TABLE transactions
+    ADD COLUMN fitid VARCHAR(255), -- Identificador da transação na instituição financeira (OFX FITID)
+    ADD COLUMN source_account VARCHAR(100) NOT NULL DEFAULT ''; -- Conta de origem conforme informada no extrato
+
+-- Evita importar duas vezes a mesma transação de extratos sobrepostos
+CREATE UNIQUE INDEX idx_transactions_user_account_fitid ON transactions(user_id, source_account, fitid) WHERE fitid IS NOT NULL;
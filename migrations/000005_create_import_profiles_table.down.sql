@@ -0,0 +1,3 @@
+ALTER TABLE documents DROP COLUMN IF EXISTS import_profile_id;
+
+DROP TABLE IF EXISTS import_profiles;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS import_profiles (
+                                               id BIGSERIAL PRIMARY KEY,
+                                               external_id UUID NOT NULL DEFAULT gen_random_uuid(),
+                                               user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                               name VARCHAR(100) NOT NULL,
+                                               delimiter VARCHAR(1) NOT NULL DEFAULT ';',
+                                               encoding VARCHAR(20) NOT NULL DEFAULT 'utf-8', -- utf-8, windows-1252, iso-8859-1
+                                               date_format VARCHAR(20) NOT NULL DEFAULT 'dd/mm/yyyy',
+                                               decimal_separator VARCHAR(1) NOT NULL DEFAULT ',',
+                                               has_header BOOLEAN NOT NULL DEFAULT TRUE,
+                                               skip_rows INTEGER NOT NULL DEFAULT 0,
+                                               columns JSONB NOT NULL DEFAULT '{}', -- Mapeamento de campos para colunas do arquivo
+                                               currency CHAR(3) NOT NULL DEFAULT 'BRL',
+                                               created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+                                               updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_import_profiles_external_id ON import_profiles(external_id);
+CREATE INDEX idx_import_profiles_user_id ON import_profiles(user_id);
+
+-- Perfil escolhido no upload de arquivos CSV (opcional)
+ALTER TABLE documents
+    ADD COLUMN import_profile_id BIGINT REFERENCES import_profiles(id) ON DELETE SET NULL;
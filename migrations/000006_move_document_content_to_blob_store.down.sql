@@ -0,0 +1,8 @@
+-- Documentos já migrados não têm mais o conteúdo em file_content, por isso a
+-- coluna continua anulável após o rollback
+DROP INDEX IF EXISTS idx_documents_pending_blob;
+
+ALTER TABLE documents
+    DROP COLUMN IF EXISTS sha256,
+    DROP COLUMN IF EXISTS size_bytes,
+    DROP COLUMN IF EXISTS storage_key;
@@ -0,0 +1,9 @@
+-- O conteúdo dos arquivos passa a ficar no BlobStore; a tabela guarda apenas a referência.
+-- file_content permanece (anulável) até que o comando backfill-blobs mova os dados existentes.
+ALTER TABLE documents
+    ADD COLUMN IF NOT EXISTS storage_key VARCHAR(512),
+    ADD COLUMN IF NOT EXISTS size_bytes BIGINT,
+    ADD COLUMN IF NOT EXISTS sha256 CHAR(64),
+    ALTER COLUMN file_content DROP NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_documents_pending_blob ON documents(id) WHERE storage_key IS NULL;
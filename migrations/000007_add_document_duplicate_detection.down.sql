@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_documents_user_sha256;
+DROP INDEX IF EXISTS idx_documents_user_sha256_unique;
+
+ALTER TABLE documents
+    DROP COLUMN IF EXISTS duplicate_allowed;
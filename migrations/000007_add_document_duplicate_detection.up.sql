@@ -0,0 +1,19 @@
+-- Documentos duplicados (mesmo conteúdo para o mesmo usuário) só são aceitos quando
+-- enviados explicitamente com force=true, marcados em duplicate_allowed.
+ALTER TABLE documents
+    ADD COLUMN IF NOT EXISTS duplicate_allowed BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Duplicatas já existentes são mantidas; apenas o documento mais antigo fica sob o índice único
+UPDATE documents d
+SET duplicate_allowed = TRUE
+WHERE d.sha256 IS NOT NULL
+  AND EXISTS (
+    SELECT 1 FROM documents o
+    WHERE o.user_id = d.user_id AND o.sha256 = d.sha256 AND o.id < d.id
+  );
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_documents_user_sha256_unique
+    ON documents(user_id, sha256)
+    WHERE sha256 IS NOT NULL AND NOT duplicate_allowed;
+
+CREATE INDEX IF NOT EXISTS idx_documents_user_sha256 ON documents(user_id, sha256);
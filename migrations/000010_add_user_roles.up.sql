@@ -0,0 +1,5 @@
+-- Papel do usuário: user acessa apenas os próprios recursos, support tem acesso
+-- somente leitura aos metadados de todos os usuários e admin tem acesso total
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'user'
+        CONSTRAINT users_role_check CHECK (role IN ('user', 'support', 'admin'));
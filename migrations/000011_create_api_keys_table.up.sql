@@ -0,0 +1,16 @@
+-- Chaves de API pessoais para scripts e integrações; apenas o hash da chave é armazenado
+CREATE TABLE IF NOT EXISTS api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    external_id UUID NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(20) NOT NULL, -- Início da chave, exibido para identificá-la
+    key_hash CHAR(64) NOT NULL UNIQUE, -- SHA-256 em hexadecimal
+    scopes JSONB NOT NULL DEFAULT '[]', -- Permissões da chave (ex: ["documents:write"])
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys(user_id);
@@ -0,0 +1,6 @@
+ALTER TABLE transactions DROP COLUMN IF EXISTS household_id;
+ALTER TABLE documents DROP COLUMN IF EXISTS household_id;
+
+DROP TABLE IF EXISTS household_invitations;
+DROP TABLE IF EXISTS household_members;
+DROP TABLE IF EXISTS households;
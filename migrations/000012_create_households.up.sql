@@ -0,0 +1,51 @@
+-- Famílias que compartilham documentos e transações entre vários usuários
+CREATE TABLE IF NOT EXISTS households (
+    id BIGSERIAL PRIMARY KEY,
+    external_id UUID NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Papel do membro: owner administra a família, member envia documentos e viewer apenas consulta
+CREATE TABLE IF NOT EXISTS household_members (
+    household_id BIGINT NOT NULL REFERENCES households(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL
+        CONSTRAINT household_members_role_check CHECK (role IN ('owner', 'member', 'viewer')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (household_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_household_members_user_id ON household_members(user_id);
+
+-- Convites por email; o convidado aceita ou recusa a partir da própria conta
+CREATE TABLE IF NOT EXISTS household_invitations (
+    id BIGSERIAL PRIMARY KEY,
+    external_id UUID NOT NULL UNIQUE,
+    household_id BIGINT NOT NULL REFERENCES households(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL
+        CONSTRAINT household_invitations_role_check CHECK (role IN ('owner', 'member', 'viewer')),
+    invited_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CONSTRAINT household_invitations_status_check CHECK (status IN ('pending', 'accepted', 'declined', 'revoked')),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    responded_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Apenas um convite pendente por email em cada família
+CREATE UNIQUE INDEX IF NOT EXISTS idx_household_invitations_pending
+    ON household_invitations(household_id, LOWER(email)) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_household_invitations_email ON household_invitations(LOWER(email));
+
+-- Documentos e as transações extraídas deles podem pertencer a uma família
+ALTER TABLE documents
+    ADD COLUMN IF NOT EXISTS household_id BIGINT REFERENCES households(id) ON DELETE SET NULL;
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS household_id BIGINT REFERENCES households(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_documents_household_id ON documents(household_id) WHERE household_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_household_id_date
+    ON transactions(household_id, transaction_date DESC) WHERE household_id IS NOT NULL;
@@ -0,0 +1,24 @@
+-- Regras de categorização de transações definidas pelo usuário; todas as
+-- condições preenchidas precisam ser atendidas e as regras de menor prioridade
+-- são avaliadas primeiro
+CREATE TABLE IF NOT EXISTS category_rules (
+    id BIGSERIAL PRIMARY KEY,
+    external_id UUID NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    category VARCHAR(100) NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    description_contains VARCHAR(255) NOT NULL DEFAULT '',
+    description_regex VARCHAR(255) NOT NULL DEFAULT '',
+    counterparty VARCHAR(255) NOT NULL DEFAULT '',
+    source_account VARCHAR(255) NOT NULL DEFAULT '',
+    min_amount BIGINT, -- Em centavos, inclusivo
+    max_amount BIGINT, -- Em centavos, inclusivo
+    day_of_month SMALLINT NOT NULL DEFAULT 0 -- 0 para qualquer dia
+        CONSTRAINT category_rules_day_of_month_check CHECK (day_of_month BETWEEN 0 AND 31),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_category_rules_user_priority ON category_rules(user_id, priority, id);
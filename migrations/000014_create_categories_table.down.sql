@@ -0,0 +1,19 @@
+ALTER TABLE category_rules ADD COLUMN IF NOT EXISTS category VARCHAR(100) NOT NULL DEFAULT '';
+UPDATE category_rules r SET category = c.name FROM categories c WHERE c.id = r.category_id;
+ALTER TABLE category_rules DROP COLUMN IF EXISTS category_id;
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS category VARCHAR(100) NOT NULL DEFAULT '';
+UPDATE transactions t SET category = c.name FROM categories c WHERE c.id = t.category_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS category_id;
+
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS categories JSONB DEFAULT '[]';
+UPDATE documents d
+SET categories = (
+    SELECT COALESCE(jsonb_agg(c.name ORDER BY c.name), '[]')
+    FROM document_categories dc
+    JOIN categories c ON c.id = dc.category_id
+    WHERE dc.document_id = d.id
+);
+
+DROP TABLE IF EXISTS document_categories;
+DROP TABLE IF EXISTS categories;
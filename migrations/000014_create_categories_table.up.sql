@@ -0,0 +1,124 @@
+-- Categorias de documentos e transações: as padrão do sistema (sem usuário) e as
+-- criadas por cada usuário, em até dois níveis. slug é o nome normalizado
+-- (ver entity.CategoryKey) e impede variações como "Banco", "banco" e "bancos".
+CREATE TABLE IF NOT EXISTS categories (
+    id BIGSERIAL PRIMARY KEY,
+    external_id UUID NOT NULL UNIQUE,
+    user_id BIGINT REFERENCES users(id) ON DELETE CASCADE,
+    parent_id BIGINT REFERENCES categories(id),
+    name VARCHAR(60) NOT NULL,
+    slug VARCHAR(60) NOT NULL,
+    icon VARCHAR(50) NOT NULL DEFAULT '',
+    color VARCHAR(7) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_owner_slug ON categories(COALESCE(user_id, 0), slug);
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories(parent_id) WHERE parent_id IS NOT NULL;
+
+-- Mesma normalização de entity.CategoryKey, usada apenas nesta migração; os
+-- nomes antigos, de até 100 caracteres, são truncados como os das categorias
+CREATE FUNCTION pg_temp.category_key(name TEXT) RETURNS TEXT AS $$
+    SELECT regexp_replace(
+        translate(lower(left(btrim(regexp_replace(name, '\s+', ' ', 'g')), 60)), 'áàâãäéèêëíìîïóòôõöúùûüç', 'aaaaaeeeeiiiiooooouuuuc'),
+        's$', '')
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- Categorias padrão; os nomes são os usados pelas regras de categorização padrão
+INSERT INTO categories (external_id, name, slug, icon, color)
+SELECT gen_random_uuid(), name, pg_temp.category_key(name), icon, color
+FROM (VALUES
+    ('Receitas', 'banknote', '#16A34A'),
+    ('Alimentação', 'utensils', '#F97316'),
+    ('Transporte', 'car', '#2563EB'),
+    ('Moradia', 'home', '#7C3AED'),
+    ('Assinaturas', 'repeat', '#DB2777'),
+    ('Compras', 'shopping-bag', '#E11D48'),
+    ('Saúde', 'heart-pulse', '#DC2626'),
+    ('Educação', 'graduation-cap', '#0891B2'),
+    ('Lazer', 'party-popper', '#CA8A04'),
+    ('Tarifas e impostos', 'receipt', '#475569'),
+    ('Transferências', 'arrow-left-right', '#64748B')
+) AS defaults(name, icon, color)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO categories (external_id, parent_id, name, slug, icon, color)
+SELECT gen_random_uuid(), parent.id, child.name, pg_temp.category_key(child.name), child.icon, child.color
+FROM (VALUES
+    ('Receitas', 'Salário', 'briefcase', '#15803D'),
+    ('Alimentação', 'Mercado', 'shopping-cart', '#EA580C'),
+    ('Transporte', 'Combustível', 'fuel', '#1D4ED8'),
+    ('Moradia', 'Telefone e internet', 'wifi', '#6D28D9')
+) AS child(parent, name, icon, color)
+JOIN categories parent ON parent.user_id IS NULL AND parent.name = child.parent
+ON CONFLICT DO NOTHING;
+
+-- Os textos livres já usados viram categorias do usuário, a menos que
+-- correspondam a uma categoria padrão
+INSERT INTO categories (external_id, user_id, name, slug)
+SELECT DISTINCT ON (used.user_id, pg_temp.category_key(used.name))
+    gen_random_uuid(), used.user_id, left(btrim(regexp_replace(used.name, '\s+', ' ', 'g')), 60), pg_temp.category_key(used.name)
+FROM (
+    SELECT d.user_id, value AS name
+    FROM documents d, jsonb_array_elements_text(d.categories) AS value
+    WHERE jsonb_typeof(d.categories) = 'array'
+    UNION ALL
+    SELECT user_id, category FROM transactions
+    UNION ALL
+    SELECT user_id, category FROM category_rules
+) AS used
+WHERE btrim(used.name) <> ''
+    AND NOT EXISTS (
+        SELECT 1 FROM categories c WHERE c.user_id IS NULL AND c.slug = pg_temp.category_key(used.name)
+    )
+ORDER BY used.user_id, pg_temp.category_key(used.name), used.name
+ON CONFLICT DO NOTHING;
+
+-- Documentos passam a referenciar as categorias pelo ID
+CREATE TABLE IF NOT EXISTS document_categories (
+    document_id BIGINT NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    category_id BIGINT NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (document_id, category_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_categories_category_id ON document_categories(category_id);
+
+INSERT INTO document_categories (document_id, category_id)
+SELECT DISTINCT d.id, c.id
+FROM documents d
+CROSS JOIN LATERAL jsonb_array_elements_text(d.categories) AS value
+JOIN categories c ON c.slug = pg_temp.category_key(value) AND (c.user_id = d.user_id OR c.user_id IS NULL)
+WHERE jsonb_typeof(d.categories) = 'array'
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE documents DROP COLUMN IF EXISTS categories;
+
+-- Transações ficam sem categoria quando ela é excluída
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS category_id BIGINT REFERENCES categories(id) ON DELETE SET NULL;
+
+UPDATE transactions t
+SET category_id = c.id
+FROM categories c
+WHERE t.category <> ''
+    AND c.slug = pg_temp.category_key(t.category)
+    AND (c.user_id = t.user_id OR c.user_id IS NULL);
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS category;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_category_id ON transactions(category_id) WHERE category_id IS NOT NULL;
+
+-- Categorias usadas por regras não podem ser excluídas
+ALTER TABLE category_rules
+    ADD COLUMN IF NOT EXISTS category_id BIGINT REFERENCES categories(id);
+
+UPDATE category_rules r
+SET category_id = c.id
+FROM categories c
+WHERE c.slug = pg_temp.category_key(r.category)
+    AND (c.user_id = r.user_id OR c.user_id IS NULL);
+
+ALTER TABLE category_rules
+    ALTER COLUMN category_id SET NOT NULL,
+    DROP COLUMN IF EXISTS category;
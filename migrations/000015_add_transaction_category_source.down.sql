@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_transactions_user_category_source;
+ALTER TABLE transactions DROP COLUMN IF EXISTS category_source;
@@ -0,0 +1,10 @@
+-- Origem da categoria da transação: file (informada no arquivo), rule (regra de
+-- categorização), suggestion (classificador) ou user (corrigida pelo usuário).
+-- As categorias do arquivo e as corrigidas pelo usuário treinam o classificador.
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS category_source VARCHAR(20) NOT NULL DEFAULT '';
+
+-- A origem das categorias existentes não foi registrada; são tratadas como informadas no arquivo
+UPDATE transactions SET category_source = 'file' WHERE category_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_user_category_source
+    ON transactions(user_id, category_source) WHERE category_id IS NOT NULL;
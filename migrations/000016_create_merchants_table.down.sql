@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_transactions_merchant_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS merchant_id;
+DROP TABLE IF EXISTS merchant_aliases;
+DROP TABLE IF EXISTS merchants;
@@ -0,0 +1,25 @@
+-- Estabelecimentos de cada usuário, reconhecidos nas descrições dos extratos
+CREATE TABLE IF NOT EXISTS merchants (
+    id BIGSERIAL PRIMARY KEY,
+    external_id UUID NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_merchants_user_id ON merchants(user_id);
+
+-- Nomes normalizados (ver merchant.Key) que identificam cada estabelecimento;
+-- um apelido pertence a um único estabelecimento do usuário
+CREATE TABLE IF NOT EXISTS merchant_aliases (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    alias VARCHAR(100) NOT NULL,
+    merchant_id BIGINT NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, alias)
+);
+
+CREATE INDEX IF NOT EXISTS idx_merchant_aliases_merchant_id ON merchant_aliases(merchant_id);
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS merchant_id BIGINT REFERENCES merchants(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_merchant_id ON transactions(merchant_id) WHERE merchant_id IS NOT NULL;
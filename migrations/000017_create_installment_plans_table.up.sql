@@ -0,0 +1,21 @@
+-- Compras parceladas no cartão, com as parcelas identificadas pelo "NN/MM" da
+-- descrição das transações; a última parcela importada é calculada a partir delas
+CREATE TABLE IF NOT EXISTS installment_plans (
+    id BIGSERIAL PRIMARY KEY,
+    external_id UUID NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    description VARCHAR(255) NOT NULL,
+    description_key VARCHAR(255) NOT NULL, -- Nome normalizado (ver merchant.Key)
+    source_account VARCHAR(100) NOT NULL DEFAULT '',
+    currency CHAR(3) NOT NULL,
+    installment_amount BIGINT NOT NULL, -- Valor de cada parcela em centavos
+    installments INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_installment_plans_purchase
+    ON installment_plans(user_id, source_account, description_key, installments, installment_amount);
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS installment_plan_id BIGINT REFERENCES installment_plans(id) ON DELETE SET NULL;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS installment_number INT;
+CREATE INDEX IF NOT EXISTS idx_transactions_installment_plan_id ON transactions(installment_plan_id) WHERE installment_plan_id IS NOT NULL;
@@ -0,0 +1,21 @@
+-- Séries de débitos periódicos (assinaturas, contas mensais) detectadas no
+-- histórico de transações de cada usuário; recalculadas a cada importação
+CREATE TABLE IF NOT EXISTS recurring_series (
+    id BIGSERIAL PRIMARY KEY,
+    external_id UUID NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    series_key VARCHAR(255) NOT NULL, -- Moeda e estabelecimento ou nome normalizado (ver merchant.Key)
+    merchant_id BIGINT REFERENCES merchants(id) ON DELETE SET NULL,
+    description VARCHAR(255) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    frequency VARCHAR(20) NOT NULL, -- weekly, monthly, yearly
+    amount BIGINT NOT NULL, -- Valor esperado em centavos
+    previous_amount BIGINT NOT NULL,
+    occurrences INT NOT NULL,
+    first_date DATE NOT NULL,
+    last_date DATE NOT NULL,
+    next_date DATE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (user_id, series_key)
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_transactions_account_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS account_id;
+ALTER TABLE documents DROP COLUMN IF EXISTS account_id;
+DROP TABLE IF EXISTS accounts;
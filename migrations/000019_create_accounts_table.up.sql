@@ -0,0 +1,22 @@
+-- Contas bancárias, cartões e carteiras de cada usuário, às quais os documentos
+-- e as transações importados pertencem
+CREATE TABLE IF NOT EXISTS accounts (
+    id BIGSERIAL PRIMARY KEY,
+    external_id UUID NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(60) NOT NULL,
+    institution VARCHAR(100) NOT NULL DEFAULT '',
+    account_type VARCHAR(20) NOT NULL, -- checking, savings, credit_card, investment, wallet
+    currency CHAR(3) NOT NULL,
+    masked_number VARCHAR(20) NOT NULL DEFAULT '', -- Apenas os últimos dígitos (ex: ****1234)
+    opening_balance BIGINT NOT NULL DEFAULT 0, -- Saldo inicial em centavos
+    source_account VARCHAR(100) NOT NULL DEFAULT '', -- Conta nos extratos (ver transactions.source_account), aprendida na importação
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_accounts_user_id ON accounts(user_id);
+
+ALTER TABLE documents ADD COLUMN IF NOT EXISTS account_id BIGINT REFERENCES accounts(id) ON DELETE SET NULL;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS account_id BIGINT REFERENCES accounts(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_account_id ON transactions(account_id) WHERE account_id IS NOT NULL;
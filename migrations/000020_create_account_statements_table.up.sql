@@ -0,0 +1,19 @@
+-- Período e saldo final dos extratos importados para cada conta, com o
+-- resultado da conciliação com as transações da conta
+CREATE TABLE IF NOT EXISTS account_statements (
+    id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    document_id BIGINT NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    source_account VARCHAR(100) NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    closing_balance BIGINT, -- Saldo final em centavos, quando informado pelo extrato
+    balance_date DATE NOT NULL,
+    expected_balance BIGINT NOT NULL DEFAULT 0, -- Saldo calculado a partir das transações
+    difference BIGINT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'unverified', -- matched, amount_mismatch, overlap, gap, unverified
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (document_id, source_account)
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_statements_account_id ON account_statements(account_id, start_date);
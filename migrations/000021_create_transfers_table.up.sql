@@ -0,0 +1,22 @@
+-- Transferências entre contas do próprio usuário: o débito em uma conta e o
+-- crédito correspondente em outra, que não são gastos nem receitas
+CREATE TABLE IF NOT EXISTS transfers (
+    id BIGSERIAL PRIMARY KEY,
+    external_id UUID NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    outgoing_transaction_id BIGINT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    incoming_transaction_id BIGINT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL, -- suggested, confirmed, rejected
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (outgoing_transaction_id, incoming_transaction_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfers_user_id ON transfers(user_id);
+
+-- Uma transação pertence a no máximo uma transferência não rejeitada; as
+-- rejeitadas são mantidas para que o par não volte a ser sugerido
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transfers_outgoing_active
+    ON transfers(outgoing_transaction_id) WHERE status <> 'rejected';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transfers_incoming_active
+    ON transfers(incoming_transaction_id) WHERE status <> 'rejected';
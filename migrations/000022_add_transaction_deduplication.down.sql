@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS transaction_duplicates;
+DROP TABLE IF EXISTS transaction_sources;
+DROP INDEX IF EXISTS idx_transactions_user_fingerprint;
+ALTER TABLE transactions DROP COLUMN IF EXISTS fingerprint;
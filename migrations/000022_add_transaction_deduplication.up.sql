@@ -0,0 +1,41 @@
+-- Impressão digital da transação (conta, data, valor, descrição normalizada e
+-- ordem entre as idênticas do mesmo documento), usada para descartar a mesma
+-- transação informada por extratos de períodos sobrepostos. As transações
+-- importadas antes desta migração não têm impressão digital.
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS fingerprint VARCHAR(64);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_user_fingerprint
+    ON transactions(user_id, fingerprint) WHERE fingerprint IS NOT NULL;
+
+-- Documentos que informaram cada transação: o que a criou e os que a
+-- repetiram e foram descartados como duplicados
+CREATE TABLE IF NOT EXISTS transaction_sources (
+    transaction_id BIGINT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    document_id BIGINT NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    fitid VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (transaction_id, document_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_sources_document_id ON transaction_sources(document_id);
+
+INSERT INTO transaction_sources (transaction_id, document_id, fitid, created_at)
+SELECT id, document_id, fitid, created_at FROM transactions
+ON CONFLICT DO NOTHING;
+
+-- Possíveis duplicatas que a importação não tem certeza de serem a mesma
+-- transação, aguardando a revisão do usuário
+CREATE TABLE IF NOT EXISTS transaction_duplicates (
+    id BIGSERIAL PRIMARY KEY,
+    external_id UUID NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    transaction_id BIGINT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE, -- Importada por último
+    duplicate_of_id BIGINT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE, -- Importada antes, mantida na fusão
+    score NUMERIC(4, 3) NOT NULL,
+    status VARCHAR(20) NOT NULL, -- pending, dismissed
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (transaction_id, duplicate_of_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_duplicates_user_id ON transaction_duplicates(user_id);
@@ -0,0 +1 @@
+ALTER TABLE account_statements DROP COLUMN IF EXISTS opening_balance;
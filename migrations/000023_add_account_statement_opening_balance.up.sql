@@ -0,0 +1,4 @@
+-- Saldo inicial informado pelo extrato (o saldo anterior ao primeiro
+-- lançamento de um CSV com saldo corrente), conferido com o saldo final do
+-- extrato anterior da conta
+ALTER TABLE account_statements ADD COLUMN IF NOT EXISTS opening_balance BIGINT;